@@ -46,6 +46,10 @@ func (vc *Bucket) Power(id crypto.Address) (*big.Int, error) {
 	return vc.Previous.Power(id)
 }
 
+func (vc *Bucket) IterateValidators(iter func(id crypto.Addressable, power *big.Int) error) error {
+	return vc.Previous.IterateValidators(iter)
+}
+
 // SetPower ensures that validator power would not change too quickly in a single block
 func (vc *Bucket) SetPower(id crypto.PublicKey, power *big.Int) (*big.Int, error) {
 	const errHeader = "Bucket.SetPower():"
@@ -9,12 +9,54 @@ import (
 // Cache is just a Ring with no memory
 type Cache struct {
 	*Bucket
+	policy *Policy
 }
 
-func NewCache(backend Iterable) *Cache {
-	return &Cache{
+var _ Checker = &Cache{}
+
+type CacheOption func(*Cache) *Cache
+
+func NewCache(backend Iterable, options ...CacheOption) *Cache {
+	cache := &Cache{
 		Bucket: NewBucket(backend),
 	}
+	for _, option := range options {
+		option(cache)
+	}
+	return cache
+}
+
+// WithPolicy has the Cache check power changes against policy in CheckPower
+func WithPolicy(policy Policy) CacheOption {
+	return func(cache *Cache) *Cache {
+		cache.policy = &policy
+		return cache
+	}
+}
+
+// CheckPower returns a coded error if changing the power of id to power would breach the Cache's Policy given the
+// changes already made since the cache was last reset
+func (vc *Cache) CheckPower(id crypto.Address, power *big.Int) error {
+	return vc.policy.Check(vc.Next, vc.Delta, id, power)
+}
+
+// Pending returns a view of the cache whose Power and IterateValidators include the changes made since the cache was
+// last reset, rather than reading the validators as they were at the start of the block, so that successive changes
+// to the same validator within a block accumulate
+func (vc *Cache) Pending() IterableReaderWriter {
+	return pendingCache{vc}
+}
+
+type pendingCache struct {
+	*Cache
+}
+
+func (pc pendingCache) Power(id crypto.Address) (*big.Int, error) {
+	return pc.Next.Power(id)
+}
+
+func (pc pendingCache) IterateValidators(iter func(id crypto.Addressable, power *big.Int) error) error {
+	return pc.Next.IterateValidators(iter)
 }
 
 func (vc *Cache) Reset(backend Iterable) {
@@ -0,0 +1,52 @@
+package validator
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCache_Pending(t *testing.T) {
+	base := NewSet()
+	base.ChangePower(pubA, big.NewInt(100))
+	base.ChangePower(pubB, big.NewInt(100))
+	cache := NewCache(base)
+	pending := cache.Pending()
+
+	require.NoError(t, AddPower(pending, pubA, big.NewInt(10)))
+	require.NoError(t, AddPower(pending, pubA, big.NewInt(10)))
+	require.NoError(t, AddPower(pending, pubC, big.NewInt(5)))
+
+	// Changes made through the pending view accumulate
+	power, err := pending.Power(pubA.GetAddress())
+	require.NoError(t, err)
+	require.Equal(t, int64(120), power.Int64())
+	count := 0
+	require.NoError(t, pending.IterateValidators(func(id crypto.Addressable, power *big.Int) error {
+		count++
+		return nil
+	}))
+	require.Equal(t, 3, count)
+
+	// While the cache itself still reads the validators as they were when it was reset
+	power, err = cache.Power(pubA.GetAddress())
+	require.NoError(t, err)
+	require.Equal(t, int64(100), power.Int64())
+	count = 0
+	require.NoError(t, cache.IterateValidators(func(id crypto.Addressable, power *big.Int) error {
+		count++
+		return nil
+	}))
+	require.Equal(t, 2, count)
+
+	// The pending view follows the cache when it is reset
+	require.NoError(t, cache.Flush(base, base))
+	power, err = pending.Power(pubA.GetAddress())
+	require.NoError(t, err)
+	require.Equal(t, int64(120), power.Int64())
+	power, err = cache.Power(pubA.GetAddress())
+	require.NoError(t, err)
+	require.Equal(t, int64(120), power.Int64())
+}
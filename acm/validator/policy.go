@@ -0,0 +1,71 @@
+package validator
+
+import (
+	"math/big"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/errors"
+)
+
+// Policy limits the validator set according to the chain's own rules, over and above the limits imposed for Tendermint
+// that are enforced by Bucket. The zero Policy imposes no limits.
+type Policy struct {
+	// The maximum number of validators with non-zero power, zero for no limit
+	MaxValidators uint64
+	// The minimum non-zero power a validator may bond, zero for no minimum
+	MinBond uint64
+	// The maximum fraction of the total power any one validator may be raised to, nil or zero for no cap
+	MaxPowerFraction *big.Rat
+	// The maximum number of validators whose power may change in a single block, zero for no limit
+	MaxChangesPerBlock uint64
+}
+
+// Checker checks a proposed change to a validator's power before it is made
+type Checker interface {
+	CheckPower(id crypto.Address, power *big.Int) error
+}
+
+// Check returns a coded error if changing the power of id to power would breach the policy given the validator set
+// next as it stands after the changes made so far this block, delta
+func (p *Policy) Check(next, delta *Set, id crypto.Address, power *big.Int) error {
+	if p == nil {
+		return nil
+	}
+	current := next.GetPower(id)
+	if power.Cmp(current) == 0 {
+		return nil
+	}
+	if p.MaxChangesPerBlock > 0 && delta.MaybePower(id) == nil && uint64(delta.Size()) >= p.MaxChangesPerBlock {
+		return errors.ErrorCodef(errors.ErrorCodeTooManyValidatorChanges,
+			"cannot change power of validator %v since the validator set has already changed %d times this block",
+			id, delta.Size())
+	}
+	if power.Sign() == 0 {
+		// A validator may always leave
+		return nil
+	}
+	if p.MinBond > 0 && power.Cmp(new(big.Int).SetUint64(p.MinBond)) < 0 {
+		return errors.ErrorCodef(errors.ErrorCodeInsufficientBond,
+			"cannot set power of validator %v to %v since it is less than the minimum bond of %d",
+			id, power, p.MinBond)
+	}
+	if p.MaxValidators > 0 && current.Sign() == 0 && uint64(next.CountNonZero()) >= p.MaxValidators {
+		return errors.ErrorCodef(errors.ErrorCodeValidatorSetFull,
+			"cannot add validator %v since the validator set already has the maximum of %d validators",
+			id, p.MaxValidators)
+	}
+	// Only raising a validator's power is capped, the share of others may grow as validators leave
+	if p.MaxPowerFraction != nil && p.MaxPowerFraction.Sign() > 0 && power.Cmp(current) > 0 {
+		totalPower := next.TotalPower()
+		totalPower.Sub(totalPower, current)
+		totalPower.Add(totalPower, power)
+		fraction := new(big.Rat).SetFrac(power, totalPower)
+		if fraction.Cmp(p.MaxPowerFraction) > 0 {
+			return errors.ErrorCodef(errors.ErrorCodeValidatorPowerCapped,
+				"cannot raise power of validator %v to %v since that would be %s of the total power %v, more than "+
+					"the maximum of %s", id, power, fraction.FloatString(4), totalPower,
+				p.MaxPowerFraction.FloatString(4))
+		}
+	}
+	return nil
+}
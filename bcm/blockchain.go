@@ -248,7 +248,7 @@ func (bc *Blockchain) BlockHash(height uint64) []byte {
 
 func (bc *Blockchain) GetBlockHeader(height uint64) (*types.Header, error) {
 	const errHeader = "GetBlockHeader():"
-	if bc == nil {
+	if bc == nil || bc.blockStore == nil {
 		return nil, fmt.Errorf("%s could not get block hash because Blockchain has not been given access to "+
 			"tendermint BlockStore", errHeader)
 	}
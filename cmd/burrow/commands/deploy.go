@@ -44,7 +44,7 @@ func Deploy(output Output) func(cmd *cli.Cmd) {
 			"path to the bin directory jobs should use when saving binaries after the compile process defaults to --dir + /bin")
 
 		defaultGasOpt := cmd.StringOpt("g gas", "1111111111",
-			"default gas to use; can be overridden for any single job")
+			"default gas to use when a job sets none and its gas cannot be estimated; can be overridden for any single job")
 
 		jobsOpt := cmd.IntOpt("j jobs", 1,
 			"default number of concurrent playbooks to run if multiple are specified")
@@ -73,12 +73,16 @@ func Deploy(output Output) func(cmd *cli.Cmd) {
 
 		timeoutSecondsOpt := cmd.IntOpt("t timeout", int(defaultChainTimeout/time.Second), "Timeout to talk to the chain in seconds")
 
+		dryRunOpt := cmd.BoolOpt("dry-run", false,
+			"Run the playbooks against a throwaway copy of the chain's state, reporting the result of each job "+
+				"without sending any transactions to the chain")
+
 		proposalList := cmd.StringOpt("list-proposals state", "", "List proposals, either all, executed, expired, or current")
 
 		cmd.Spec = "[--chain=<host:port>] [--keys=<host:port>] [--mempool-signing] [--dir=<root directory>] " +
 			"[--output=<output file>] [--wasm] [--set=<KEY=VALUE>]... [--bin-path=<path>] [--gas=<gas>] " +
 			"[--jobs=<concurrent playbooks>] [--address=<address>] [--fee=<fee>] [--amount=<amount>] [--local-abi] " +
-			"[--verbose] [--debug] [--timeout=<timeout>] " +
+			"[--verbose] [--debug] [--timeout=<timeout>] [--dry-run] " +
 			"[--list-proposals=<state> | --proposal-create| --proposal-verify | --proposal-vote] [FILE...]"
 
 		cmd.Action = func() {
@@ -115,6 +119,7 @@ func Deploy(output Output) func(cmd *cli.Cmd) {
 			args.ProposeVerify = *proposalVerify
 			args.ProposeVote = *proposalVote
 			args.ProposeCreate = *proposalCreate
+			args.DryRun = *dryRunOpt
 			stderrLogger := log.NewLogfmtLogger(os.Stderr)
 			logger := logging.NewLogger(stderrLogger)
 			handleTerm()
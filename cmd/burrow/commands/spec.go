@@ -26,9 +26,13 @@ func Spec(output Output) func(cmd *cli.Cmd) {
 		participantsOpt := cmd.IntOpt("p participant-accounts", 0, "Number of preset Participant type accounts")
 		chainNameOpt := cmd.StringOpt("n chain-name", "", "Default chain name")
 		proposalThresholdOpt := cmd.IntOpt("param-proposalthreshold", 3, "Number of votes required for a proposal to pass")
+		gasScheduleOpt := cmd.StringOpt("param-gasschedule", "", "EVM gas schedule to use, one of: legacy (default), ethereum")
+		hardforkOpt := cmd.StringOpt("param-hardfork", "", "Ethereum fork the EVM follows, one of: byzantium, "+
+			"constantinople, petersburg, istanbul (default is Burrow's own opcode set)")
 
 		cmd.Spec = "[--name-prefix=<prefix for account names>][--full-accounts] [--validator-accounts] [--root-accounts] " +
-			"[--developer-accounts] [--participant-accounts] [--chain-name] [--toml] [BASE...]"
+			"[--developer-accounts] [--participant-accounts] [--chain-name] [--param-proposalthreshold] " +
+			"[--param-gasschedule] [--param-hardfork] [--toml] [BASE...]"
 
 		cmd.Action = func() {
 			specs := make([]spec.GenesisSpec, 0, *participantsOpt+*fullOpt)
@@ -60,6 +64,8 @@ func Spec(output Output) func(cmd *cli.Cmd) {
 				genesisSpec.ChainName = *chainNameOpt
 			}
 			genesisSpec.Params.ProposalThreshold = uint64(*proposalThresholdOpt)
+			genesisSpec.Params.GasSchedule = *gasScheduleOpt
+			genesisSpec.Params.Hardfork = *hardforkOpt
 			if *tomlOpt {
 				output.Printf(source.TOMLString(genesisSpec))
 			} else {
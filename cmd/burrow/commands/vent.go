@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"os"
 	"os/signal"
+	"strings"
 	"sync"
 	"syscall"
 	"time"
@@ -12,6 +13,7 @@ import (
 	"github.com/hyperledger/burrow/config/source"
 	"github.com/hyperledger/burrow/execution/evm/abi"
 	"github.com/hyperledger/burrow/logging/logconfig"
+	"github.com/hyperledger/burrow/vent/api"
 	"github.com/hyperledger/burrow/vent/config"
 	"github.com/hyperledger/burrow/vent/service"
 	"github.com/hyperledger/burrow/vent/sqldb"
@@ -29,13 +31,25 @@ func Vent(output Output) func(cmd *cli.Cmd) {
 				cfg := config.DefaultVentConfig()
 
 				dbOpts := sqlDBOpts(cmd, cfg)
+				sinkOpt := cmd.StringOpt("sink", cfg.Sink, "Sink to deliver projected rows to, one of 'sql' (the "+
+					"database configured by the db options), 'jsonl', 'webhook', or 'grpc'")
+				sinkURLOpt := cmd.StringOpt("sink-url", cfg.SinkURL, "File to write (or - for stdout) for the jsonl "+
+					"sink, URL to POST to for the webhook sink, or address of the receiver for the grpc sink")
+				sinkCheckpointOpt := cmd.StringOpt("sink-checkpoint", cfg.SinkCheckpoint, "File in which the "+
+					"reader of the jsonl sink writing to stdout keeps the last height it has committed")
 				grpcAddrOpt := cmd.StringOpt("grpc-addr", cfg.GRPCAddr, "Address to connect to the Hyperledger Burrow gRPC server")
 				httpAddrOpt := cmd.StringOpt("http-addr", cfg.HTTPAddr, "Address to bind the HTTP server")
 				logLevelOpt := cmd.StringOpt("log-level", cfg.LogLevel, "Logging level (error, warn, info, debug)")
 				abiFileOpt := cmd.StringsOpt("abi", cfg.AbiFileOrDirs, "EVM Contract ABI file or folder")
 				specFileOrDirOpt := cmd.StringsOpt("spec", cfg.SpecFileOrDirs, "SQLSol specification file or folder")
+				apiOpt := cmd.BoolOpt("api", false, "Serve a REST and GraphQL read API over the projection tables on the HTTP server")
 				dbBlockOpt := cmd.BoolOpt("blocks", false, "Create block tables and persist related data")
 				dbTxOpt := cmd.BoolOpt("txs", false, "Create tx tables and persist related data")
+				chainOpt := cmd.StringsOpt("chain", nil, "Chain to consume from in place of --grpc-addr, --spec, "+
+					"and --abi as '<grpc address>,<spec file or dir>[,<abi file or dir>]', repeat to follow several "+
+					"chains into the same database")
+				chainIDKeyOpt := cmd.BoolOpt("chain-id-key", false, "Include the chain ID in the primary key of "+
+					"each table so that chains can share tables")
 
 				announceEveryOpt := cmd.StringOpt("announce-every", "5s", "Announce vent status every period as a Go duration, e.g. 1ms, 3s, 1h")
 
@@ -44,17 +58,37 @@ func Vent(output Output) func(cmd *cli.Cmd) {
 					cfg.DBAdapter = *dbOpts.adapter
 					cfg.DBURL = *dbOpts.url
 					cfg.DBSchema = *dbOpts.schema
+					cfg.Sink = *sinkOpt
+					cfg.SinkURL = *sinkURLOpt
+					cfg.SinkCheckpoint = *sinkCheckpointOpt
 					cfg.GRPCAddr = *grpcAddrOpt
 					cfg.HTTPAddr = *httpAddrOpt
 					cfg.LogLevel = *logLevelOpt
 					cfg.AbiFileOrDirs = *abiFileOpt
 					cfg.SpecFileOrDirs = *specFileOrDirOpt
+					cfg.API = *apiOpt
 					if *dbBlockOpt {
 						cfg.SpecOpt |= sqlsol.Block
 					}
 					if *dbTxOpt {
 						cfg.SpecOpt |= sqlsol.Tx
 					}
+					if *chainIDKeyOpt {
+						cfg.SpecOpt |= sqlsol.ChainID
+					}
+					for _, chain := range *chainOpt {
+						chainConfig, err := parseChain(chain)
+						if err != nil {
+							output.Fatalf("could not parse chain: %v", err)
+						}
+						cfg.Chains = append(cfg.Chains, chainConfig)
+					}
+					if cfg.MultiChain() && cfg.Sink != "" && cfg.Sink != types.SQLSink {
+						output.Fatalf("Multiple chains can only be consumed with the %s sink", types.SQLSink)
+					}
+					if !cfg.MultiChain() && len(cfg.SpecFileOrDirs) == 0 {
+						output.Fatalf("Either --spec or --chain must be provided")
+					}
 
 					if *announceEveryOpt != "" {
 						var err error
@@ -65,8 +99,9 @@ func Vent(output Output) func(cmd *cli.Cmd) {
 					}
 				}
 
-				cmd.Spec = "--spec=<spec file or dir> [--abi=<abi file or dir>] [--db-adapter] [--db-url] [--db-schema] " +
-					"[--blocks] [--txs] [--grpc-addr] [--http-addr] [--log-level] [--announce-every=<duration>]"
+				cmd.Spec = "[--spec=<spec file or dir>] [--abi=<abi file or dir>] [--chain=<grpc address,spec,abi>]... " +
+					"[--chain-id-key] [--db-adapter] [--db-url] [--db-schema] [--sink] [--sink-url] [--sink-checkpoint] " +
+					"[--api] [--blocks] [--txs] [--grpc-addr] [--http-addr] [--log-level] [--announce-every=<duration>]"
 
 				cmd.Action = func() {
 					log, err := logconfig.New().NewLogger()
@@ -75,12 +110,47 @@ func Vent(output Output) func(cmd *cli.Cmd) {
 					}
 
 					log = log.With("service", "vent")
-					consumer := service.NewConsumer(cfg, log, make(chan types.EventData))
-					server := service.NewServer(cfg, log, consumer)
 
-					projection, err := sqlsol.SpecLoader(cfg.SpecFileOrDirs, cfg.SpecOpt)
+					chains := cfg.Chains
+					if !cfg.MultiChain() {
+						chains = []config.ChainConfig{{
+							GRPCAddr:       cfg.GRPCAddr,
+							SpecFileOrDirs: cfg.SpecFileOrDirs,
+							AbiFileOrDirs:  cfg.AbiFileOrDirs,
+						}}
+					}
+
+					consumers := make([]*service.Consumer, len(chains))
+					projections := make([]*sqlsol.Projection, len(chains))
+					for i, chain := range chains {
+						chainLog := log
+						if cfg.MultiChain() {
+							chainLog = log.With("grpc_addr", chain.GRPCAddr)
+						}
+						consumers[i] = service.NewConsumer(cfg.ForChain(chain), chainLog, make(chan types.EventData))
+						projections[i], err = sqlsol.SpecLoader(chain.SpecFileOrDirs, cfg.SpecOpt)
+						if err != nil {
+							output.Fatalf("Spec loader error: %v", err)
+						}
+					}
+					server := service.NewServer(cfg, log, consumers...)
+
+					projection, err := sqlsol.MergeProjections(projections...)
 					if err != nil {
-						output.Fatalf("Spec loader error: %v", err)
+						output.Fatalf("Could not combine projections of chains: %v", err)
+					}
+
+					if cfg.API {
+						if cfg.Sink != "" && cfg.Sink != types.SQLSink {
+							output.Fatalf("The read API can only be served with the %s sink", types.SQLSink)
+						}
+						readAPI, err := api.NewAPI(cfg, projection, log)
+						if err != nil {
+							output.Fatalf("Could not start read API: %v", err)
+						}
+						defer readAPI.Close()
+						server.Handle(api.RESTPath, readAPI)
+						server.Handle(api.GraphQLPath, readAPI)
 					}
 
 					var wg sync.WaitGroup
@@ -95,7 +165,7 @@ func Vent(output Output) func(cmd *cli.Cmd) {
 					wg.Add(1)
 
 					go func() {
-						if err := consumer.Run(projection, true); err != nil {
+						if err := service.RunChains(consumers, projections, true); err != nil {
 							output.Fatalf("Consumer execution error: %v", err)
 						}
 
@@ -114,7 +184,9 @@ func Vent(output Output) func(cmd *cli.Cmd) {
 					// gracefully shutdown the events consumer and the http server
 					go func() {
 						<-ch
-						consumer.Shutdown()
+						for _, consumer := range consumers {
+							consumer.Shutdown()
+						}
 						server.Shutdown()
 					}()
 
@@ -216,6 +288,23 @@ func Vent(output Output) func(cmd *cli.Cmd) {
 	}
 }
 
+// parseChain parses a chain to consume from given as '<grpc address>,<spec file or dir>[,<abi file or dir>]'
+func parseChain(str string) (config.ChainConfig, error) {
+	parts := strings.Split(str, ",")
+	if len(parts) < 2 || len(parts) > 3 || parts[0] == "" || parts[1] == "" {
+		return config.ChainConfig{}, fmt.Errorf("chain '%s' should be given as "+
+			"'<grpc address>,<spec file or dir>[,<abi file or dir>]'", str)
+	}
+	chain := config.ChainConfig{
+		GRPCAddr:       parts[0],
+		SpecFileOrDirs: []string{parts[1]},
+	}
+	if len(parts) == 3 {
+		chain.AbiFileOrDirs = []string{parts[2]}
+	}
+	return chain, nil
+}
+
 type dbOpts struct {
 	adapter *string
 	url     *string
@@ -224,7 +313,7 @@ type dbOpts struct {
 
 func sqlDBOpts(cmd *cli.Cmd, cfg *config.VentConfig) dbOpts {
 	return dbOpts{
-		adapter: cmd.StringOpt("db-adapter", cfg.DBAdapter, "Database adapter, 'postgres' or 'sqlite' (if built with the sqlite tag) are supported"),
+		adapter: cmd.StringOpt("db-adapter", cfg.DBAdapter, "Database adapter, 'postgres', 'mysql', or 'sqlite' (if built with the sqlite tag) are supported"),
 		url:     cmd.StringOpt("db-url", cfg.DBURL, "PostgreSQL database URL or SQLite db file path"),
 		schema:  cmd.StringOpt("db-schema", cfg.DBSchema, "PostgreSQL database schema (empty for SQLite)"),
 	}
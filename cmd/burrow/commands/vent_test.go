@@ -0,0 +1,31 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/vent/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseChain(t *testing.T) {
+	chain, err := parseChain("localhost:10997,spec.json")
+	require.NoError(t, err)
+	assert.Equal(t, config.ChainConfig{
+		GRPCAddr:       "localhost:10997",
+		SpecFileOrDirs: []string{"spec.json"},
+	}, chain)
+
+	chain, err = parseChain("localhost:20997,specs,abis")
+	require.NoError(t, err)
+	assert.Equal(t, config.ChainConfig{
+		GRPCAddr:       "localhost:20997",
+		SpecFileOrDirs: []string{"specs"},
+		AbiFileOrDirs:  []string{"abis"},
+	}, chain)
+
+	for _, str := range []string{"", "localhost:10997", ",spec.json", "localhost:10997,spec.json,abis,extra"} {
+		_, err = parseChain(str)
+		assert.Error(t, err, str)
+	}
+}
@@ -163,6 +163,10 @@ func (app *App) BeginBlock(block types.RequestBeginBlock) (respBeginBlock types.
 			}
 		}
 	}
+	err := app.committer.BeginBlock(&block)
+	if err != nil {
+		panic(errors.Wrap(err, "could not begin block"))
+	}
 	return
 }
 
@@ -235,7 +239,11 @@ func (app *App) EndBlock(reqEndBlock types.RequestEndBlock) types.ResponseEndBlo
 			app.panicFunc(fmt.Errorf("panic occurred in abci.App/EndBlock: %v\n%s", r, debug.Stack()))
 		}
 	}()
-	err := app.validators.ValidatorChanges(BurrowValidatorDelayInBlocks).IterateValidators(func(id crypto.Addressable, power *big.Int) error {
+	err := app.committer.EndBlock(&reqEndBlock)
+	if err != nil {
+		panic(errors.Wrap(err, "could not end block"))
+	}
+	err = app.validators.ValidatorChanges(BurrowValidatorDelayInBlocks).IterateValidators(func(id crypto.Addressable, power *big.Int) error {
 		app.logger.InfoMsg("Updating validator power", "validator_address", id.GetAddress(),
 			"new_power", power)
 		validatorUpdates = append(validatorUpdates, types.ValidatorUpdate{
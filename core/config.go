@@ -47,6 +47,7 @@ func (kern *Kernel) LoadExecutionOptionsFromConfig(conf *execution.ExecutionConf
 		}
 		kern.exeOptions = exeOptions
 		kern.timeoutFactor = conf.TimeoutFactor
+		kern.pruning = conf.Pruning
 	}
 	return nil
 }
@@ -79,6 +79,7 @@ type Kernel struct {
 	processes      map[string]process.Process
 	listeners      map[string]net.Listener
 	timeoutFactor  float64
+	pruning        *state.PruningConfig
 	shutdownNotify chan struct{}
 	shutdownOnce   sync.Once
 }
@@ -145,7 +146,10 @@ func (kern *Kernel) LoadState(genesisDoc *genesis.GenesisDoc) (err error) {
 
 	kern.Logger.InfoMsg("State loading successful")
 
-	params := execution.ParamsFromGenesis(genesisDoc)
+	params, err := execution.ParamsFromGenesis(genesisDoc)
+	if err != nil {
+		return err
+	}
 	kern.checker = execution.NewBatchChecker(kern.State, params, kern.Blockchain, kern.Logger)
 	kern.committer = execution.NewBatchCommitter(kern.State, params, kern.Blockchain, kern.Emitter, kern.Logger, kern.exeOptions...)
 	return nil
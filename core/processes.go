@@ -9,6 +9,7 @@ import (
 	"github.com/hyperledger/burrow/bcm"
 	"github.com/hyperledger/burrow/consensus/abci"
 	"github.com/hyperledger/burrow/execution"
+	"github.com/hyperledger/burrow/execution/state"
 	"github.com/hyperledger/burrow/keys"
 	"github.com/hyperledger/burrow/logging/structure"
 	"github.com/hyperledger/burrow/process"
@@ -28,6 +29,7 @@ import (
 const (
 	ProfilingProcessName   = "Profiling"
 	DatabaseProcessName    = "Database"
+	PruningProcessName     = "Pruning"
 	NoConsensusProcessName = "NoConsensusExecution"
 	TendermintProcessName  = "Tendermint"
 	StartupProcessName     = "StartupAnnouncer"
@@ -41,6 +43,7 @@ func DefaultProcessLaunchers(kern *Kernel, rpcConfig *rpc.RPCConfig, keysConfig
 	return []process.Launcher{
 		ProfileLauncher(kern, rpcConfig.Profiler),
 		DatabaseLauncher(kern),
+		PruningLauncher(kern),
 		NoConsensusLauncher(kern),
 		TendermintLauncher(kern),
 		StartupLauncher(kern),
@@ -83,6 +86,35 @@ func DatabaseLauncher(kern *Kernel) process.Launcher {
 	}
 }
 
+// Delete old versions of state in the background
+func PruningLauncher(kern *Kernel) process.Launcher {
+	return process.Launcher{
+		Name:    PruningProcessName,
+		Enabled: kern.pruning.Enabled(),
+		Launch: func() (process.Process, error) {
+			pruner, err := state.NewPruner(kern.State, kern.pruning, kern.Logger)
+			if err != nil {
+				return nil, err
+			}
+			ctx, cancel := context.WithCancel(context.Background())
+			done := make(chan struct{})
+			go func() {
+				pruner.Run(ctx)
+				close(done)
+			}()
+			return process.ShutdownFunc(func(ctx context.Context) error {
+				cancel()
+				select {
+				case <-done:
+					return nil
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}), nil
+		},
+	}
+}
+
 // Run a single uncoordinated local state
 func NoConsensusLauncher(kern *Kernel) process.Launcher {
 	return process.Launcher{
@@ -279,7 +311,7 @@ func GRPCLauncher(kern *Kernel, conf *rpc.ServerConfig, keyConfig *keys.KeysConf
 			nameRegState := kern.State
 			proposalRegState := kern.State
 			rpcquery.RegisterQueryServer(grpcServer, rpcquery.NewQueryServer(kern.State, nameRegState, proposalRegState,
-				kern.Blockchain, kern.State, nodeView, kern.Logger))
+				kern.State, kern.State, kern.Blockchain, kern.State, kern.State.AtHeight, nodeView, kern.Logger))
 
 			txCodec := txs.NewProtobufCodec()
 			rpctransact.RegisterTransactServer(grpcServer,
@@ -1,13 +1,16 @@
 package def
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
 	"strconv"
+	"sync"
 	"time"
 
 	"reflect"
+	"sort"
 
 	hex "github.com/tmthrgd/go-hex"
 
@@ -35,16 +38,87 @@ type Client struct {
 	MempoolSigning    bool
 	ChainAddress      string
 	KeysClientAddress string
+	// Run transactions against a Simulation of the chain rather than broadcasting them
+	DryRun bool
 	// Memoised clients and info
+	dialLock              sync.Mutex
 	chainID               string
 	timeout               time.Duration
 	transactClient        rpctransact.TransactClient
 	queryClient           rpcquery.QueryClient
 	executionEventsClient rpcevents.ExecutionEventsClient
 	keyClient             keys.KeyClient
+	simulation            *Simulation
+	sequences             accountSequences
 	AllSpecs              *abi.Spec
 }
 
+// accountSequences orders the transactions sent from each account so that jobs running in parallel can send from the
+// same account. An input whose sequence is taken from its account when it is formed is tracked until the transaction
+// is broadcast, at which point the sequence is taken again while holding the account's lock since another transaction
+// may have used it in the meantime. The lock is held until the transaction has been executed. An input is untracked
+// when its transaction is broadcast, whether or not that succeeds, or by ReleaseInputs if it never will be.
+type accountSequences struct {
+	sync.Mutex
+	locks  map[crypto.Address]*sync.Mutex
+	inputs map[*payload.TxInput]struct{}
+}
+
+func (as *accountSequences) track(input *payload.TxInput) {
+	as.Lock()
+	defer as.Unlock()
+	if as.inputs == nil {
+		as.inputs = make(map[*payload.TxInput]struct{})
+	}
+	as.inputs[input] = struct{}{}
+}
+
+// untrack returns whether the sequence of input was taken from its account and stops tracking it
+func (as *accountSequences) untrack(input *payload.TxInput) bool {
+	as.Lock()
+	defer as.Unlock()
+	_, ok := as.inputs[input]
+	delete(as.inputs, input)
+	return ok
+}
+
+// lock locks the accounts of inputs (in address order so that transactions with several inputs cannot deadlock) and
+// returns a function that unlocks them
+func (as *accountSequences) lock(inputs []*payload.TxInput) func() {
+	addresses := make([]crypto.Address, len(inputs))
+	for i, input := range inputs {
+		addresses[i] = input.Address
+	}
+	sort.Slice(addresses, func(i, j int) bool {
+		return bytes.Compare(addresses[i].Bytes(), addresses[j].Bytes()) < 0
+	})
+
+	as.Lock()
+	if as.locks == nil {
+		as.locks = make(map[crypto.Address]*sync.Mutex)
+	}
+	var locks []*sync.Mutex
+	for i, address := range addresses {
+		if i > 0 && address == addresses[i-1] {
+			continue
+		}
+		if as.locks[address] == nil {
+			as.locks[address] = new(sync.Mutex)
+		}
+		locks = append(locks, as.locks[address])
+	}
+	as.Unlock()
+
+	for _, lock := range locks {
+		lock.Lock()
+	}
+	return func() {
+		for _, lock := range locks {
+			lock.Unlock()
+		}
+	}
+}
+
 func NewClient(chain, keysClientAddress string, mempoolSigning bool, timeout time.Duration) *Client {
 	client := Client{
 		ChainAddress:      chain,
@@ -57,6 +131,9 @@ func NewClient(chain, keysClientAddress string, mempoolSigning bool, timeout tim
 
 // Connect GRPC clients using ChainURL
 func (c *Client) dial(logger *logging.Logger) error {
+	// Jobs may run in parallel
+	c.dialLock.Lock()
+	defer c.dialLock.Unlock()
 	if c.transactClient == nil {
 		conn, err := grpc.Dial(c.ChainAddress, grpc.WithInsecure())
 		if err != nil {
@@ -85,10 +162,25 @@ func (c *Client) dial(logger *logging.Logger) error {
 			return err
 		}
 		c.chainID = stat.ChainID
+		if c.DryRun {
+			logger.InfoMsg("Dry run: transactions will be simulated and not sent to the chain")
+			c.simulation, err = newSimulation(c.queryClient, stat, c.timeout)
+			if err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
 
+// SimulationWarnings describes the ways in which the simulation used for a dry run may differ from the chain
+func (c *Client) SimulationWarnings() []string {
+	if c.simulation == nil {
+		return nil
+	}
+	return c.simulation.Warnings()
+}
+
 func (c *Client) Transact(logger *logging.Logger) (rpctransact.TransactClient, error) {
 	err := c.dial(logger)
 	if err != nil {
@@ -136,12 +228,22 @@ func (c *Client) ParseAddress(key string, logger *logging.Logger) (crypto.Addres
 }
 
 func (c *Client) GetAccount(address crypto.Address) (*acm.Account, error) {
+	if c.simulation != nil {
+		acc, err := c.simulation.GetAccount(address)
+		if acc == nil && err == nil {
+			acc = &acm.Account{}
+		}
+		return acc, err
+	}
 	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
 	defer cancel()
 	return c.queryClient.GetAccount(ctx, &rpcquery.GetAccountParam{Address: address})
 }
 
 func (c *Client) GetMetadataForAccount(address crypto.Address) (string, error) {
+	if c.simulation != nil {
+		return c.simulation.GetMetadataForAccount(address)
+	}
 	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
 	defer cancel()
 	metadata, err := c.queryClient.GetMetadata(ctx, &rpcquery.GetMetadataParam{Address: &address})
@@ -153,6 +255,9 @@ func (c *Client) GetMetadataForAccount(address crypto.Address) (string, error) {
 }
 
 func (c *Client) GetMetadata(metahash acmstate.MetadataHash) (string, error) {
+	if c.simulation != nil {
+		return c.simulation.GetMetadata(metahash)
+	}
 	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
 	defer cancel()
 	var bs binary.HexBytes = metahash.Bytes()
@@ -165,6 +270,9 @@ func (c *Client) GetMetadata(metahash acmstate.MetadataHash) (string, error) {
 }
 
 func (c *Client) GetStorage(address crypto.Address, key binary.Word256) ([]byte, error) {
+	if c.simulation != nil {
+		return c.simulation.GetStorage(address, key)
+	}
 	val, err := c.queryClient.GetStorage(context.Background(), &rpcquery.GetStorageParam{Address: address, Key: key})
 	if err != nil {
 		return []byte{}, err
@@ -177,6 +285,9 @@ func (c *Client) GetName(name string, logger *logging.Logger) (*names.Entry, err
 	if err != nil {
 		return nil, err
 	}
+	if c.simulation != nil {
+		return c.simulation.GetName(name)
+	}
 	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
 	defer cancel()
 	return c.queryClient.GetName(ctx, &rpcquery.GetNameParam{Name: name})
@@ -187,6 +298,9 @@ func (c *Client) GetValidatorSet(logger *logging.Logger) (*rpcquery.ValidatorSet
 	if err != nil {
 		return nil, err
 	}
+	if c.simulation != nil {
+		return c.simulation.GetValidatorSet(), nil
+	}
 	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
 	defer cancel()
 	return c.queryClient.GetValidatorSet(ctx, &rpcquery.GetValidatorSetParam{})
@@ -222,15 +336,46 @@ func (c *Client) ListProposals(proposed bool, logger *logging.Logger) ([]*rpcque
 }
 
 func (c *Client) SignAndBroadcast(tx payload.Payload, logger *logging.Logger) (*exec.TxExecution, error) {
-	err := c.dial(logger)
+	unlock, err := c.takeSequences(tx.GetInputs(), true, logger)
 	if err != nil {
 		return nil, err
 	}
+	defer unlock()
+	err = c.dial(logger)
+	if err != nil {
+		return nil, err
+	}
+	if c.simulation != nil {
+		// Simulated transactions need no signatures
+		return unifyErrors(c.simulation.Execute(txs.Enclose(c.chainID, tx), logger))
+	}
 	txEnv, err := c.SignTx(tx, logger)
 	if err != nil {
 		return nil, err
 	}
-	return unifyErrors(c.BroadcastEnvelope(txEnv, logger))
+	return unifyErrors(c.broadcastEnvelope(txEnv, logger))
+}
+
+// takeSequences locks the accounts of inputs and takes the sequence again for each input that is tracked if retake is
+// set. Every input is untracked whether or not this succeeds. On success the returned function must be called to
+// unlock the accounts once the transaction has been executed.
+func (c *Client) takeSequences(inputs []*payload.TxInput, retake bool, logger *logging.Logger) (func(), error) {
+	unlock := c.sequences.lock(inputs)
+	var err error
+	for _, input := range inputs {
+		if c.sequences.untrack(input) && retake && err == nil {
+			var sequence uint64
+			sequence, err = c.getSequence("", input.Address, false, logger)
+			if err == nil {
+				input.Sequence = sequence
+			}
+		}
+	}
+	if err != nil {
+		unlock()
+		return nil, err
+	}
+	return unlock, nil
 }
 
 func (c *Client) SignTx(tx payload.Payload, logger *logging.Logger) (*txs.Envelope, error) {
@@ -284,10 +429,18 @@ func (c *Client) CreateKey(keyName, curveTypeString string, logger *logging.Logg
 
 // Broadcast payload for remote signing
 func (c *Client) Broadcast(tx payload.Payload, logger *logging.Logger) (*exec.TxExecution, error) {
-	err := c.dial(logger)
+	unlock, err := c.takeSequences(tx.GetInputs(), true, logger)
 	if err != nil {
 		return nil, err
 	}
+	defer unlock()
+	err = c.dial(logger)
+	if err != nil {
+		return nil, err
+	}
+	if c.simulation != nil {
+		return unifyErrors(c.simulation.Execute(txs.Enclose(c.chainID, tx), logger))
+	}
 	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
 	defer cancel()
 	return unifyErrors(c.transactClient.BroadcastTxSync(ctx, &rpctransact.TxEnvelopeParam{Payload: tx.Any()}))
@@ -295,10 +448,24 @@ func (c *Client) Broadcast(tx payload.Payload, logger *logging.Logger) (*exec.Tx
 
 // Broadcast envelope - can be locally signed or remote signing will be attempted
 func (c *Client) BroadcastEnvelope(txEnv *txs.Envelope, logger *logging.Logger) (*exec.TxExecution, error) {
+	// Taking the sequence again would invalidate any signatures, so a signed envelope is only ordered
+	unlock, err := c.takeSequences(txEnv.Tx.GetInputs(), len(txEnv.Signatories) == 0, logger)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	return c.broadcastEnvelope(txEnv, logger)
+}
+
+// broadcastEnvelope broadcasts txEnv once the sequences of its inputs have been taken
+func (c *Client) broadcastEnvelope(txEnv *txs.Envelope, logger *logging.Logger) (*exec.TxExecution, error) {
 	err := c.dial(logger)
 	if err != nil {
 		return nil, err
 	}
+	if c.simulation != nil {
+		return unifyErrors(c.simulation.Execute(txEnv, logger))
+	}
 	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
 	defer cancel()
 
@@ -329,11 +496,35 @@ func (c *Client) QueryContract(arg *QueryArg, logger *logging.Logger) (*exec.TxE
 	if err != nil {
 		return nil, err
 	}
+	if c.simulation != nil {
+		return unifyErrors(c.simulation.Call(tx, logger))
+	}
 	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
 	defer cancel()
 	return unifyErrors(c.transactClient.CallTxSim(ctx, tx))
 }
 
+// Find the lowest GasLimit with which tx would succeed against the current committed state (or simulation)
+func (c *Client) EstimateGas(tx *payload.CallTx, logger *logging.Logger) (*rpctransact.GasEstimate, error) {
+	err := c.dial(logger)
+	if err != nil {
+		return nil, err
+	}
+	if c.simulation != nil {
+		gasUsed, gasLimit, err := c.simulation.EstimateGas(tx, logger)
+		if err != nil {
+			return nil, err
+		}
+		return &rpctransact.GasEstimate{
+			GasUsed:  gasUsed,
+			GasLimit: gasLimit,
+		}, nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	return c.transactClient.EstimateGas(ctx, tx)
+}
+
 // Transaction types
 
 type GovArg struct {
@@ -353,10 +544,6 @@ func (c *Client) UpdateAccount(arg *GovArg, logger *logging.Logger) (*payload.Go
 	if err != nil {
 		return nil, err
 	}
-	input, err := c.TxInput(arg.Input, arg.Native, arg.Sequence, true, logger)
-	if err != nil {
-		return nil, err
-	}
 	update := &spec.TemplateAccount{
 		Permissions: arg.Permissions,
 		Roles:       arg.Permissions,
@@ -409,6 +596,10 @@ func (c *Client) UpdateAccount(arg *GovArg, logger *logging.Logger) (*payload.Go
 		}
 		update.Amounts = update.Balances().Power(power)
 	}
+	input, err := c.TxInput(arg.Input, arg.Native, arg.Sequence, true, logger)
+	if err != nil {
+		return nil, err
+	}
 	tx := &payload.GovTx{
 		Inputs:         []*payload.TxInput{input},
 		AccountUpdates: []*spec.TemplateAccount{update},
@@ -463,10 +654,6 @@ func (c *Client) Call(arg *CallArg, logger *logging.Logger) (*payload.CallTx, er
 		"address", arg.Address,
 		"data", arg.Data,
 		"wasm", arg.WASM)
-	input, err := c.TxInput(arg.Input, arg.Amount, arg.Sequence, true, logger)
-	if err != nil {
-		return nil, err
-	}
 	var contractAddress *crypto.Address
 	if arg.Address != "" {
 		address, err := crypto.AddressFromHexString(arg.Address)
@@ -502,6 +689,10 @@ func (c *Client) Call(arg *CallArg, logger *logging.Logger) (*payload.CallTx, er
 		})
 	}
 
+	input, err := c.TxInput(arg.Input, arg.Amount, arg.Sequence, true, logger)
+	if err != nil {
+		return nil, err
+	}
 	tx := &payload.CallTx{
 		Input:        input,
 		Address:      contractAddress,
@@ -524,11 +715,11 @@ type SendArg struct {
 
 func (c *Client) Send(arg *SendArg, logger *logging.Logger) (*payload.SendTx, error) {
 	logger.InfoMsg("SendTx", "send", arg)
-	input, err := c.TxInput(arg.Input, arg.Amount, arg.Sequence, true, logger)
+	outputAddress, err := c.ParseAddress(arg.Output, logger)
 	if err != nil {
 		return nil, err
 	}
-	outputAddress, err := c.ParseAddress(arg.Output, logger)
+	input, err := c.TxInput(arg.Input, arg.Amount, arg.Sequence, true, logger)
 	if err != nil {
 		return nil, err
 	}
@@ -585,6 +776,64 @@ func (c *Client) Unbond(arg *UnbondArg, logger *logging.Logger) (*payload.Unbond
 	return tx, nil
 }
 
+type DelegateArg struct {
+	Input     string
+	Validator string
+	Amount    string
+	Sequence  string
+}
+
+func (c *Client) Delegate(arg *DelegateArg, logger *logging.Logger) (*payload.DelegateTx, error) {
+	logger.InfoMsg("DelegateTx", "delegation", arg)
+	if err := c.dial(logger); err != nil {
+		return nil, err
+	}
+	validatorAddress, err := c.ParseAddress(arg.Validator, logger)
+	if err != nil {
+		return nil, err
+	}
+	input, err := c.TxInput(arg.Input, arg.Amount, arg.Sequence, true, logger)
+	if err != nil {
+		return nil, err
+	}
+	return &payload.DelegateTx{
+		Input:     input,
+		Validator: validatorAddress,
+	}, nil
+}
+
+type UndelegateArg struct {
+	Input     string
+	Validator string
+	Amount    string
+	Sequence  string
+}
+
+func (c *Client) Undelegate(arg *UndelegateArg, logger *logging.Logger) (*payload.UndelegateTx, error) {
+	logger.InfoMsg("UndelegateTx", "delegation", arg)
+	if err := c.dial(logger); err != nil {
+		return nil, err
+	}
+	// The amount is withdrawn from the delegation rather than charged to the input
+	validatorAddress, err := c.ParseAddress(arg.Validator, logger)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := c.ParseUint64(arg.Amount)
+	if err != nil {
+		return nil, err
+	}
+	input, err := c.TxInput(arg.Input, "", arg.Sequence, true, logger)
+	if err != nil {
+		return nil, err
+	}
+	return &payload.UndelegateTx{
+		Input:     input,
+		Validator: validatorAddress,
+		Amount:    amount,
+	}, nil
+}
+
 type NameArg struct {
 	Input    string
 	Amount   string
@@ -596,11 +845,11 @@ type NameArg struct {
 
 func (c *Client) Name(arg *NameArg, logger *logging.Logger) (*payload.NameTx, error) {
 	logger.InfoMsg("NameTx", "name", arg)
-	input, err := c.TxInput(arg.Input, arg.Amount, arg.Sequence, true, logger)
+	fee, err := c.ParseUint64(arg.Fee)
 	if err != nil {
 		return nil, err
 	}
-	fee, err := c.ParseUint64(arg.Fee)
+	input, err := c.TxInput(arg.Input, arg.Amount, arg.Sequence, true, logger)
 	if err != nil {
 		return nil, err
 	}
@@ -625,10 +874,6 @@ type PermArg struct {
 
 func (c *Client) Permissions(arg *PermArg, logger *logging.Logger) (*payload.PermsTx, error) {
 	logger.InfoMsg("PermsTx", "perm", arg)
-	input, err := c.TxInput(arg.Input, "", arg.Sequence, true, logger)
-	if err != nil {
-		return nil, err
-	}
 	action, err := permission.PermStringToFlag(arg.Action)
 	if err != nil {
 		return nil, err
@@ -667,6 +912,10 @@ func (c *Client) Permissions(arg *PermArg, logger *logging.Logger) (*payload.Per
 		permArgs.Role = &arg.Role
 	}
 
+	input, err := c.TxInput(arg.Input, "", arg.Sequence, true, logger)
+	if err != nil {
+		return nil, err
+	}
 	tx := &payload.PermsTx{
 		Input:    input,
 		PermArgs: permArgs,
@@ -691,15 +940,28 @@ func (c *Client) TxInput(inputString, amountString, sequenceString string, allow
 		}
 	}
 	var sequence uint64
-	sequence, err = c.getSequence(sequenceString, inputAddress, c.MempoolSigning && allowMempoolSigning, logger)
+	mempoolSigning := c.MempoolSigning && allowMempoolSigning
+	sequence, err = c.getSequence(sequenceString, inputAddress, mempoolSigning, logger)
 	if err != nil {
 		return nil, err
 	}
-	return &payload.TxInput{
+	input := &payload.TxInput{
 		Address:  inputAddress,
 		Amount:   amount,
 		Sequence: sequence,
-	}, nil
+	}
+	if sequenceString == "" && !(mempoolSigning && c.simulation == nil) {
+		// The sequence is taken again when the transaction is broadcast, see accountSequences
+		c.sequences.track(input)
+	}
+	return input, nil
+}
+
+// ReleaseInputs stops tracking the sequences of inputs whose transactions will not be broadcast
+func (c *Client) ReleaseInputs(inputs ...*payload.TxInput) {
+	for _, input := range inputs {
+		c.sequences.untrack(input)
+	}
 }
 
 func (c *Client) getSequence(sequence string, inputAddress crypto.Address, mempoolSigning bool, logger *logging.Logger) (uint64, error) {
@@ -708,14 +970,12 @@ func (c *Client) getSequence(sequence string, inputAddress crypto.Address, mempo
 		return 0, err
 	}
 	if sequence == "" {
-		if mempoolSigning {
+		if mempoolSigning && c.simulation == nil {
 			// Perform mempool signing
 			return 0, nil
 		}
-		// Get from chain
-		ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
-		defer cancel()
-		acc, err := c.queryClient.GetAccount(ctx, &rpcquery.GetAccountParam{Address: inputAddress})
+		// Get from chain (or simulation)
+		acc, err := c.GetAccount(inputAddress)
 		if err != nil {
 			return 0, err
 		}
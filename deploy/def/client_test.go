@@ -3,8 +3,13 @@ package def
 import (
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/txs/payload"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestArgMap(t *testing.T) {
@@ -15,3 +20,28 @@ func TestArgMap(t *testing.T) {
 	assert.Equal(t, "fooo", mp["Address"])
 	assert.Len(t, mp, 8)
 }
+
+func TestTakeSequences(t *testing.T) {
+	logger := logging.NewNoopLogger()
+	// Nothing is listening so sequences cannot be taken from the chain
+	client := NewClient("localhost:1", "", false, 100*time.Millisecond)
+	address := crypto.Address{1}
+	inputs := []*payload.TxInput{{Address: address, Sequence: 3}, {Address: address, Sequence: 4}}
+	for _, input := range inputs {
+		client.sequences.track(input)
+	}
+
+	_, err := client.takeSequences(inputs, true, logger)
+	require.Error(t, err)
+	// Both inputs are released even though taking the first sequence failed
+	for _, input := range inputs {
+		assert.False(t, client.sequences.untrack(input))
+	}
+
+	// The account was unlocked and untracked inputs keep their sequences
+	unlock, err := client.takeSequences(inputs, true, logger)
+	require.NoError(t, err)
+	unlock()
+	assert.Equal(t, uint64(3), inputs[0].Sequence)
+	assert.Equal(t, uint64(4), inputs[1].Sequence)
+}
@@ -0,0 +1,170 @@
+package def
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+)
+
+// Condition is a parsed `if` expression: a disjunction (||) of conjunctions (&&) of comparisons each of which may be
+// negated with a leading !. Operands are kept as written so that variables can be substituted into them when the
+// condition is evaluated.
+type Condition [][]*Comparison
+
+// Comparison compares two operands with one of the relations available to assert jobs or, when it has no relation,
+// tests the truth of a single operand (anything other than "", "false" or "0")
+type Comparison struct {
+	Negate   bool
+	Left     string
+	Relation string
+	Right    string
+}
+
+var (
+	disjunctionRegex = regexp.MustCompile(`\|\|`)
+	conjunctionRegex = regexp.MustCompile(`&&`)
+	// Symbolic relations may be written without surrounding spaces, word relations may not
+	relationRegex = regexp.MustCompile(`(==|!=|>=|<=|>|<)|\s(eq|ne|ge|gt|le|lt)\s`)
+)
+
+// ParseCondition parses an `if` expression. Operands may not themselves contain &&, || or a relation.
+func ParseCondition(expr string) (Condition, error) {
+	if strings.TrimSpace(expr) == "" {
+		return nil, fmt.Errorf("condition is empty")
+	}
+	var condition Condition
+	for _, disjunct := range disjunctionRegex.Split(expr, -1) {
+		var conjunction []*Comparison
+		for _, conjunct := range conjunctionRegex.Split(disjunct, -1) {
+			comparison, err := parseComparison(conjunct)
+			if err != nil {
+				return nil, fmt.Errorf("could not parse condition '%s': %v", expr, err)
+			}
+			conjunction = append(conjunction, comparison)
+		}
+		condition = append(condition, conjunction)
+	}
+	return condition, nil
+}
+
+func parseComparison(term string) (*Comparison, error) {
+	comparison := new(Comparison)
+	term = strings.TrimSpace(term)
+	if strings.HasPrefix(term, "!") && !strings.HasPrefix(term, "!=") {
+		comparison.Negate = true
+		term = strings.TrimSpace(term[1:])
+	}
+	if term == "" {
+		return nil, fmt.Errorf("missing operand")
+	}
+	loc := relationRegex.FindStringSubmatchIndex(term)
+	if loc == nil {
+		comparison.Left = unquote(term)
+		return comparison, nil
+	}
+	if loc[2] >= 0 {
+		comparison.Relation = term[loc[2]:loc[3]]
+	} else {
+		comparison.Relation = term[loc[4]:loc[5]]
+	}
+	left, right := strings.TrimSpace(term[:loc[0]]), strings.TrimSpace(term[loc[1]:])
+	if left == "" || right == "" {
+		return nil, fmt.Errorf("relation %s must have an operand on either side", comparison.Relation)
+	}
+	if relationRegex.MatchString(right) {
+		return nil, fmt.Errorf("comparisons cannot be chained in '%s'", term)
+	}
+	comparison.Left = unquote(left)
+	comparison.Right = unquote(right)
+	return comparison, nil
+}
+
+// Evaluate evaluates the condition passing each operand through substitute first (to replace any variables it
+// contains)
+func (condition Condition) Evaluate(substitute func(string) (string, error)) (bool, error) {
+	for _, conjunction := range condition {
+		holds := true
+		for _, comparison := range conjunction {
+			result, err := comparison.Evaluate(substitute)
+			if err != nil {
+				return false, err
+			}
+			if !result {
+				holds = false
+				break
+			}
+		}
+		if holds {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (comparison *Comparison) Evaluate(substitute func(string) (string, error)) (bool, error) {
+	left, err := substitute(comparison.Left)
+	if err != nil {
+		return false, err
+	}
+	if comparison.Relation == "" {
+		return truthy(left) != comparison.Negate, nil
+	}
+	right, err := substitute(comparison.Right)
+	if err != nil {
+		return false, err
+	}
+	result, err := compare(left, comparison.Relation, right)
+	if err != nil {
+		return false, err
+	}
+	return result != comparison.Negate, nil
+}
+
+// Integers are compared numerically, anything else can only be compared for (in)equality
+func compare(left, relation, right string) (bool, error) {
+	l, lok := new(big.Int).SetString(left, 10)
+	r, rok := new(big.Int).SetString(right, 10)
+	if lok && rok {
+		cmp := l.Cmp(r)
+		switch relation {
+		case "==", "eq":
+			return cmp == 0, nil
+		case "!=", "ne":
+			return cmp != 0, nil
+		case ">", "gt":
+			return cmp > 0, nil
+		case ">=", "ge":
+			return cmp >= 0, nil
+		case "<", "lt":
+			return cmp < 0, nil
+		case "<=", "le":
+			return cmp <= 0, nil
+		}
+	}
+	switch relation {
+	case "==", "eq":
+		return left == right, nil
+	case "!=", "ne":
+		return left != right, nil
+	}
+	return false, fmt.Errorf("cannot compare '%s' %s '%s' since they are not both integers", left, relation, right)
+}
+
+func truthy(value string) bool {
+	switch strings.TrimSpace(value) {
+	case "", "false", "0":
+		return false
+	}
+	return true
+}
+
+func unquote(operand string) string {
+	if len(operand) >= 2 {
+		first, last := operand[0], operand[len(operand)-1]
+		if first == last && (first == '"' || first == '\'') {
+			return operand[1 : len(operand)-1]
+		}
+	}
+	return operand
+}
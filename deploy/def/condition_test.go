@@ -0,0 +1,60 @@
+package def
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCondition(t *testing.T) {
+	condition, err := ParseCondition("$env == 'production' && !$skip || $count gt 2")
+	require.NoError(t, err)
+	assert.Equal(t, Condition{
+		{
+			{Left: "$env", Relation: "==", Right: "production"},
+			{Negate: true, Left: "$skip"},
+		},
+		{
+			{Left: "$count", Relation: "gt", Right: "2"},
+		},
+	}, condition)
+
+	for _, expr := range []string{"", "$a &&", "== 3", "$a < $b < $c"} {
+		_, err = ParseCondition(expr)
+		assert.Error(t, err, "expression '%s' should not parse", expr)
+	}
+}
+
+func TestCondition_Evaluate(t *testing.T) {
+	variables := strings.NewReplacer("$env", "production", "$count", "3", "$skip", "false", "$big",
+		"115792089237316195423570985008687907853269984665640564039457584007913129639935")
+	substitute := func(operand string) (string, error) {
+		return variables.Replace(operand), nil
+	}
+
+	for expr, expected := range map[string]bool{
+		"$env == production":                   true,
+		"$env != production":                   false,
+		"!$env":                                false,
+		"$skip":                                false,
+		"!$skip && $count >= 3":                true,
+		"$count > 3 || $env eq 'production'":   true,
+		"$count<3":                             false,
+		"$big > 1":                             true,
+		"$env == staging || $count le 2":       false,
+		"'' || 0 || false || \"a b\" == 'a b'": true,
+	} {
+		condition, err := ParseCondition(expr)
+		require.NoError(t, err)
+		result, err := condition.Evaluate(substitute)
+		require.NoError(t, err)
+		assert.Equal(t, expected, result, "expression '%s'", expr)
+	}
+
+	condition, err := ParseCondition("$env > 2")
+	require.NoError(t, err)
+	_, err = condition.Evaluate(substitute)
+	assert.Error(t, err)
+}
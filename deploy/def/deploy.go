@@ -1,6 +1,8 @@
 package def
 
 import (
+	"fmt"
+
 	validation "github.com/go-ozzo/ozzo-validation"
 	"github.com/hyperledger/burrow/deploy/def/rule"
 )
@@ -29,6 +31,7 @@ type DeployArgs struct {
 	ProposeVerify bool     `mapstructure:"," json:"," yaml:"," toml:","`
 	ProposeVote   bool     `mapstructure:"," json:"," yaml:"," toml:","`
 	ProposeCreate bool     `mapstructure:"," json:"," yaml:"," toml:","`
+	DryRun        bool     `mapstructure:"," json:"," yaml:"," toml:","`
 }
 
 func (args *DeployArgs) Validate() error {
@@ -51,6 +54,18 @@ type Playbook struct {
 
 func (pkg *Playbook) Validate() error {
 	return validation.ValidateStruct(pkg,
-		validation.Field(&pkg.Jobs),
+		validation.Field(&pkg.Jobs, validation.By(func(value interface{}) error {
+			// Dependencies must be earlier jobs so that they cannot form a cycle
+			earlier := make(map[string]bool)
+			for _, job := range pkg.Jobs {
+				for _, name := range job.DependsOn {
+					if !earlier[name] {
+						return fmt.Errorf("job %s depends on %s which is not an earlier job", job.Name, name)
+					}
+				}
+				earlier[job.Name] = true
+			}
+			return nil
+		})),
 	)
 }
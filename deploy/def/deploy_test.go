@@ -51,3 +51,20 @@ func TestPackage_Validate(t *testing.T) {
 	err = pkgs.Validate()
 	require.NoError(t, err)
 }
+
+func TestPackage_ValidateDependsOn(t *testing.T) {
+	pkgs := &Playbook{
+		Jobs: []*Job{
+			{Name: "first", Set: &Set{Value: "1"}},
+			{Name: "second", Set: &Set{Value: "2"}, DependsOn: []string{"first"}},
+			{Name: "third", Set: &Set{Value: "3"}, DependsOn: []string{"first", "second"}},
+		},
+	}
+	require.NoError(t, pkgs.Validate())
+
+	pkgs.Jobs[1].DependsOn = []string{"third"}
+	require.Error(t, pkgs.Validate(), "cannot depend on a later job")
+
+	pkgs.Jobs[1].DependsOn = []string{"missing"}
+	require.Error(t, pkgs.Validate())
+}
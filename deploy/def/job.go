@@ -23,6 +23,13 @@ type Job struct {
 	Result interface{} `json:"-" yaml:"-" toml:"-"`
 	// For multiple values
 	Variables []*abi.Variable `json:"-" yaml:"-" toml:"-"`
+	// Only run the job if this expression over variables holds, e.g. '$env == production && $count > 2'
+	If string `mapstructure:"if,omitempty" json:"if,omitempty" yaml:"if,omitempty" toml:"if"`
+	// Run the job once for each item of a list or range
+	Foreach *Foreach `mapstructure:"foreach,omitempty" json:"foreach,omitempty" yaml:"foreach,omitempty" toml:"foreach"`
+	// Run the job as soon as these (earlier) jobs have finished rather than after all earlier jobs have finished,
+	// allowing independent jobs to run in parallel
+	DependsOn []string `mapstructure:"depends-on,omitempty" json:"depends-on,omitempty" yaml:"depends-on,omitempty" toml:"depends-on"`
 	// Create proposal or vote for one
 	Proposal *Proposal `mapstructure:"proposal,omitempty" json:"proposal,omitempty" yaml:"proposal,omitempty" toml:"proposal"`
 	// Sets/Resets the primary account to use
@@ -43,6 +50,10 @@ type Job struct {
 	Bond *Bond `mapstructure:"bond,omitempty" json:"bond,omitempty" yaml:"bond,omitempty" toml:"bond"`
 	// Unbond tokens from an account
 	Unbond *Unbond `mapstructure:"unbond,omitempty" json:"unbond,omitempty" yaml:"unbond,omitempty" toml:"unbond"`
+	// Delegate tokens from an account to a validator
+	Delegate *Delegate `mapstructure:"delegate,omitempty" json:"delegate,omitempty" yaml:"delegate,omitempty" toml:"delegate"`
+	// Undelegate tokens delegated to a validator
+	Undelegate *Undelegate `mapstructure:"undelegate,omitempty" json:"undelegate,omitempty" yaml:"undelegate,omitempty" toml:"undelegate"`
 	// Utilize monax:db's native name registry to register a name
 	RegisterName *RegisterName `mapstructure:"register,omitempty" json:"register,omitempty" yaml:"register,omitempty" toml:"register"`
 	// Sends a transaction which will update the permissions of an account. Must be sent from an account which
@@ -66,6 +77,30 @@ type Job struct {
 	Assert *Assert `mapstructure:"assert,omitempty" json:"assert,omitempty" yaml:"assert,omitempty" toml:"assert"`
 }
 
+// Foreach runs a job for each of Items or each integer in Range with the current item available to the job as the
+// variable named by As
+type Foreach struct {
+	// A list of items, each of which may contain variables
+	Items []string `mapstructure:"items,omitempty" json:"items,omitempty" yaml:"items,omitempty" toml:"items"`
+	// An inclusive range of integers written from..to, either bound may be a variable
+	Range string `mapstructure:"range,omitempty" json:"range,omitempty" yaml:"range,omitempty" toml:"range"`
+	// (Optional) the name of the variable holding the current item, defaults to item
+	As string `mapstructure:"as,omitempty" json:"as,omitempty" yaml:"as,omitempty" toml:"as"`
+}
+
+const DefaultForeachVariable = "item"
+
+var ForeachRangeRegex = regexp.MustCompile(`^\s*(\S+?)\s*\.\.\s*(\S+)\s*$`)
+
+var wordRegex = regexp.MustCompile(`^[[:word:]]+$`)
+
+func (foreach *Foreach) Variable() string {
+	if foreach.As == "" {
+		return DefaultForeachVariable
+	}
+	return foreach.As
+}
+
 type Payload interface {
 	validation.Validatable
 }
@@ -80,10 +115,52 @@ func (job *Job) Validate() error {
 			Error("must contain word characters; alphanumeric plus underscores/hyphens")),
 		validation.Field(&job.Result, rule.New(rule.IsOmitted, "internally reserved and should be removed")),
 		validation.Field(&job.Variables, rule.New(rule.IsOmitted, "internally reserved and should be removed")),
+		validation.Field(&job.If, validation.By(func(value interface{}) error {
+			if job.If == "" {
+				return nil
+			}
+			_, err := ParseCondition(job.If)
+			return err
+		})),
+		validation.Field(&job.Foreach, validation.By(func(value interface{}) error {
+			if job.Foreach == nil {
+				return nil
+			}
+			if job.Meta != nil || job.Proposal != nil {
+				return fmt.Errorf("cannot be used with meta or proposal jobs")
+			}
+			return job.Foreach.validate()
+		})),
+		validation.Field(&job.DependsOn, validation.By(func(value interface{}) error {
+			if len(job.DependsOn) > 0 && job.Account != nil {
+				return fmt.Errorf("cannot be used with account jobs since they set the account for the jobs after them")
+			}
+			for _, name := range job.DependsOn {
+				if !wordRegex.MatchString(name) {
+					return fmt.Errorf("'%s' is not the name of a job", name)
+				}
+				if name == job.Name {
+					return fmt.Errorf("job cannot depend on itself")
+				}
+			}
+			return nil
+		})),
 		validation.Field(payloadField.Addr().Interface()),
 	)
 }
 
+// Not a Validate method since that would make Foreach a Payload
+func (foreach *Foreach) validate() error {
+	if (len(foreach.Items) == 0) == (foreach.Range == "") {
+		return fmt.Errorf("exactly one of items or range must be set")
+	}
+	return validation.ValidateStruct(foreach,
+		validation.Field(&foreach.Range, validation.Match(ForeachRangeRegex).Error("must be of the form from..to")),
+		validation.Field(&foreach.As, validation.Match(wordRegex).
+			Error("must contain only word characters; alphanumeric plus underscores")),
+	)
+}
+
 var payloadType = reflect.TypeOf((*Payload)(nil)).Elem()
 
 func (job *Job) Payload() (Payload, error) {
@@ -116,3 +193,20 @@ func (job *Job) PayloadField() (reflect.Value, error) {
 
 	return rv.Field(payloadIndex), nil
 }
+
+// Copy returns a copy of the job (without any results) with its own copy of the job's payload so that running the copy,
+// which substitutes variables into the fields of its payload, leaves the job as it was
+func (job *Job) Copy() (*Job, error) {
+	jobCopy := &Job{}
+	*jobCopy = *job
+	jobCopy.Result = nil
+	jobCopy.Variables = nil
+	field, err := jobCopy.PayloadField()
+	if err != nil {
+		return nil, err
+	}
+	payload := reflect.New(field.Type().Elem())
+	payload.Elem().Set(field.Elem())
+	field.Set(payload)
+	return jobCopy, nil
+}
@@ -41,3 +41,46 @@ func TestJob_Validate(t *testing.T) {
 	err = job.Validate()
 	require.NoError(t, err)
 }
+
+func TestJob_ValidateControlFlow(t *testing.T) {
+	job := &Job{
+		Name:      "setter",
+		If:        "$env == production",
+		Foreach:   &Foreach{Items: []string{"a", "$b"}, As: "letter"},
+		DependsOn: []string{"other"},
+		Set:       &Set{Value: "$letter"},
+	}
+	require.NoError(t, job.Validate())
+
+	job.If = "$env =="
+	assert.Error(t, job.Validate())
+	job.If = ""
+
+	job.Foreach.Range = "1..$n"
+	assert.Error(t, job.Validate(), "cannot have items and range")
+	job.Foreach.Items = nil
+	require.NoError(t, job.Validate())
+	job.Foreach.Range = "1-3"
+	assert.Error(t, job.Validate())
+	job.Foreach = &Foreach{Range: "1..3", As: "not a word"}
+	assert.Error(t, job.Validate())
+	job.Foreach = nil
+
+	job.DependsOn = []string{"setter"}
+	assert.Error(t, job.Validate(), "cannot depend on itself")
+	job.DependsOn = nil
+
+	job = &Job{
+		Name:    "meta",
+		Foreach: &Foreach{Items: []string{"a"}},
+		Meta:    &Meta{File: "other.yaml"},
+	}
+	assert.Error(t, job.Validate(), "cannot use foreach with meta jobs")
+
+	job = &Job{
+		Name:      "account",
+		DependsOn: []string{"other"},
+		Account:   &Account{Address: "$addr"},
+	}
+	assert.Error(t, job.Validate(), "cannot use depends-on with account jobs")
+}
@@ -1,6 +1,7 @@
 package def
 
 import (
+	"fmt"
 	"regexp"
 
 	validation "github.com/go-ozzo/ozzo-validation"
@@ -39,7 +40,14 @@ func (job *Proposal) Validate() error {
 		validation.Field(&job.VotingPower, rule.Uint64OrPlaceholder),
 		validation.Field(&job.Name, validation.Required),
 		validation.Field(&job.Description, validation.Required),
-		validation.Field(&job.Jobs, validation.Required),
+		validation.Field(&job.Jobs, validation.Required, validation.By(func(value interface{}) error {
+			for _, j := range job.Jobs {
+				if j.If != "" || j.Foreach != nil || len(j.DependsOn) > 0 {
+					return fmt.Errorf("job %s in proposal cannot use if, foreach, or depends-on", j.Name)
+				}
+			}
+			return nil
+		})),
 	)
 }
 
@@ -211,6 +219,48 @@ func (job *Unbond) Validate() error {
 	)
 }
 
+type Delegate struct {
+	// (Optional, if account job or global account set) address of the account from which to delegate (the
+	// public key for the account must be available to burrow keys)
+	Source string `mapstructure:"source" json:"source" yaml:"source" toml:"source"`
+	// (Required) address of the validator to delegate to
+	Validator string `mapstructure:"validator" json:"validator" yaml:"validator" toml:"validator"`
+	// (Required) the stake to add to the validator's power
+	Amount string `mapstructure:"amount" json:"amount" yaml:"amount" toml:"amount"`
+	// (Optional, advanced only) sequence to use when burrow keys signs the transaction
+	// (do not use unless you know what you're doing)
+	Sequence string `mapstructure:"sequence" json:"sequence" yaml:"sequence" toml:"sequence"`
+}
+
+func (job *Delegate) Validate() error {
+	return validation.ValidateStruct(job,
+		validation.Field(&job.Validator, validation.Required),
+		validation.Field(&job.Amount, validation.Required),
+		validation.Field(&job.Sequence, rule.Uint64OrPlaceholder),
+	)
+}
+
+type Undelegate struct {
+	// (Optional, if account job or global account set) address of the delegator (the public key for the
+	// account must be available to burrow keys)
+	Source string `mapstructure:"source" json:"source" yaml:"source" toml:"source"`
+	// (Required) address of the validator to withdraw delegated stake from
+	Validator string `mapstructure:"validator" json:"validator" yaml:"validator" toml:"validator"`
+	// (Required) the delegated stake to withdraw
+	Amount string `mapstructure:"amount" json:"amount" yaml:"amount" toml:"amount"`
+	// (Optional, advanced only) sequence to use when burrow keys signs the transaction (do not use unless you
+	// know what you're doing)
+	Sequence string `mapstructure:"sequence" json:"sequence" yaml:"sequence" toml:"sequence"`
+}
+
+func (job *Undelegate) Validate() error {
+	return validation.ValidateStruct(job,
+		validation.Field(&job.Validator, validation.Required),
+		validation.Field(&job.Amount, validation.Required),
+		validation.Field(&job.Sequence, rule.Uint64OrPlaceholder),
+	)
+}
+
 type RegisterName struct {
 	// (Optional, if account job or global account set) address of the account from which to send (the
 	// public key for the account must be available to burrow keys)
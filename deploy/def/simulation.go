@@ -0,0 +1,548 @@
+package def
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/acm/validator"
+	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/deploy/compile"
+	"github.com/hyperledger/burrow/execution"
+	"github.com/hyperledger/burrow/execution/contexts"
+	"github.com/hyperledger/burrow/execution/delegation"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/names"
+	"github.com/hyperledger/burrow/execution/slashing"
+	"github.com/hyperledger/burrow/genesis"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/rpc"
+	"github.com/hyperledger/burrow/rpc/rpcquery"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/tendermint/tendermint/types"
+)
+
+// Simulation is a throwaway overlay of the chain's state against which transactions are run locally rather than being
+// broadcast. Anything the overlay has not changed is read from the chain (as of the latest block) so that each
+// transaction sees the effects of those run before it without the chain ever being touched. Transactions from jobs run
+// in parallel are executed one at a time. Transactions are executed with the chain's genesis parameters, though not
+// with the VM options from the configuration of the node.
+type Simulation struct {
+	sync.RWMutex
+	blockchain *simulatedBlockchain
+	params     execution.Params
+	// Ways in which the simulation may differ from the chain
+	warnings    []string
+	state       *acmstate.Cache
+	names       *names.Cache
+	validators  *validator.Set
+	slashing    *slashing.Cache
+	delegations *delegation.Cache
+}
+
+func newSimulation(queryClient rpcquery.QueryClient, status *rpc.ResultStatus, timeout time.Duration) (*Simulation, error) {
+	chain := &chainReader{
+		queryClient: queryClient,
+		timeout:     timeout,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	validatorSet, err := queryClient.GetValidatorSet(ctx, &rpcquery.GetValidatorSetParam{})
+	if err != nil {
+		return nil, fmt.Errorf("could not get validator set for simulation: %v", err)
+	}
+	warnings := []string{"the VM options of the node (such as its call and data stack limits) are not available " +
+		"so the defaults are used"}
+	genesisDoc := genesis.GenesisDoc{
+		ChainName: status.ChainID,
+	}
+	chainParams, err := queryClient.GetParams(ctx, &rpcquery.GetParamsParam{})
+	if err != nil {
+		// Nodes that predate the query do not provide the parameters
+		warnings = append(warnings, fmt.Sprintf("could not get the chain's parameters so the defaults (including "+
+			"the gas schedule and unbonding period) are used: %v", err))
+	} else {
+		genesisDoc.Params.ProposalThreshold = chainParams.ProposalThreshold
+		genesisDoc.Params.GasSchedule = chainParams.GasSchedule
+		genesisDoc.Params.Hardfork = chainParams.Hardfork
+		genesisDoc.Params.SlashFraction = chainParams.SlashFraction
+		genesisDoc.Params.JailBlocks = chainParams.JailBlocks
+		genesisDoc.Params.UnbondingBlocks = chainParams.UnbondingBlocks
+		genesisDoc.Params.PayoutFees = chainParams.PayoutFees
+		genesisDoc.Params.BlockReward = chainParams.BlockReward
+		genesisDoc.Params.MaxValidators = chainParams.MaxValidators
+		genesisDoc.Params.MinBond = chainParams.MinBond
+		genesisDoc.Params.MaxPowerFraction = chainParams.MaxPowerFraction
+		genesisDoc.Params.MaxValidatorChanges = chainParams.MaxValidatorChanges
+	}
+	params, err := execution.ParamsFromGenesis(&genesisDoc)
+	if err != nil {
+		return nil, fmt.Errorf("could not use chain parameters for simulation: %v", err)
+	}
+	return &Simulation{
+		blockchain: &simulatedBlockchain{
+			chain:      chain,
+			status:     status,
+			genesisDoc: genesisDoc,
+		},
+		params:      params,
+		warnings:    warnings,
+		state:       acmstate.NewCache(chain, acmstate.Named("Simulation")),
+		names:       names.NewCache(chain),
+		validators:  validator.UnpersistSet(validatorSet.Set),
+		slashing:    slashing.NewCache(chain),
+		delegations: delegation.NewCache(chain),
+	}, nil
+}
+
+// Execute runs a transaction against the overlay as though it were included in the next block, checking and
+// incrementing the sequence numbers of its inputs as the chain would. The overlay is left unchanged if the transaction
+// cannot be executed.
+func (sim *Simulation) Execute(txEnv *txs.Envelope, logger *logging.Logger) (*exec.TxExecution, error) {
+	sim.Lock()
+	defer sim.Unlock()
+	state := acmstate.NewCache(sim.state)
+	nameReg := names.NewCache(sim.names)
+	validators := validator.NewCache(sim.validators, validator.WithPolicy(sim.params.ValidatorPolicy))
+	slashes := slashing.NewCache(sim.slashing)
+	delegations := delegation.NewCache(sim.delegations)
+
+	var txExecutor contexts.Context
+	switch txEnv.Tx.Type() {
+	case payload.TypeSend:
+		txExecutor = &contexts.SendContext{
+			StateWriter: state,
+			Logger:      logger,
+		}
+	case payload.TypeCall:
+		txExecutor = &contexts.CallContext{
+			Blockchain:  sim.blockchain,
+			StateWriter: state,
+			RunCall:     true,
+			GasSchedule: sim.params.GasSchedule,
+			Hardfork:    sim.params.Hardfork,
+			Logger:      logger,
+		}
+	case payload.TypeName:
+		txExecutor = &contexts.NameContext{
+			Blockchain:  sim.blockchain,
+			StateWriter: state,
+			NameReg:     nameReg,
+			Logger:      logger,
+		}
+	case payload.TypePermissions:
+		txExecutor = &contexts.PermissionsContext{
+			StateWriter: state,
+			Logger:      logger,
+		}
+	case payload.TypeGovernance:
+		txExecutor = &contexts.GovernanceContext{
+			ValidatorSet: validators,
+			Policy:       validators,
+			StateWriter:  state,
+			Logger:       logger,
+		}
+	case payload.TypeBond:
+		txExecutor = &contexts.BondContext{
+			ValidatorSet: validators,
+			Policy:       validators,
+			StateWriter:  state,
+			Slashing:     slashes,
+			Logger:       logger,
+		}
+	case payload.TypeUnbond:
+		txExecutor = &contexts.UnbondContext{
+			Blockchain:      sim.blockchain,
+			ValidatorSet:    validators,
+			Policy:          validators,
+			StateWriter:     state,
+			Slashing:        slashes,
+			Delegations:     delegations,
+			UnbondingBlocks: sim.params.Slashing.UnbondingBlocks,
+			Logger:          logger,
+		}
+	case payload.TypeDelegate:
+		txExecutor = &contexts.DelegateContext{
+			ValidatorSet: validators,
+			Policy:       validators,
+			StateWriter:  state,
+			Slashing:     slashes,
+			Delegations:  delegations,
+			Logger:       logger,
+		}
+	case payload.TypeUndelegate:
+		txExecutor = &contexts.UndelegateContext{
+			Blockchain:      sim.blockchain,
+			ValidatorSet:    validators,
+			Policy:          validators,
+			StateWriter:     state,
+			Slashing:        slashes,
+			Delegations:     delegations,
+			UnbondingBlocks: sim.params.Slashing.UnbondingBlocks,
+			Logger:          logger,
+		}
+	default:
+		return nil, fmt.Errorf("cannot simulate %v", txEnv.Tx.Type())
+	}
+
+	inputs := txEnv.Tx.GetInputs()
+	for _, in := range inputs {
+		acc, err := state.GetAccount(in.Address)
+		if err != nil {
+			return nil, err
+		}
+		if acc == nil {
+			return nil, errors.ErrorCodef(errors.ErrorCodeInvalidAddress, "validateInputs() expects existing input "+
+				"accounts, but could not find account %v", in.Address)
+		}
+		if acc.Sequence+1 != in.Sequence {
+			return nil, errors.ErrorCodef(errors.ErrorCodeInvalidSequence, "Error invalid sequence in input %v: input "+
+				"has sequence %d, but account has sequence %d, so expected input to have sequence %d", in,
+				in.Sequence, acc.Sequence, acc.Sequence+1)
+		}
+	}
+
+	txe := exec.NewTxExecution(txEnv)
+	err := txExecutor.Execute(txe, txe.Envelope.Tx.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, in := range inputs {
+		acc, err := state.GetAccount(in.Address)
+		if err != nil {
+			return nil, err
+		}
+		acc.Sequence++
+		err = state.UpdateAccount(acc)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = state.Sync(sim.state)
+	if err != nil {
+		return nil, err
+	}
+	err = nameReg.Sync(sim.names)
+	if err != nil {
+		return nil, err
+	}
+	err = validators.Flush(sim.validators, sim.validators)
+	if err != nil {
+		return nil, err
+	}
+	err = slashes.Sync(sim.slashing)
+	if err != nil {
+		return nil, err
+	}
+	err = delegations.Sync(sim.delegations)
+	if err != nil {
+		return nil, err
+	}
+	return txe, nil
+}
+
+// Call runs a CallTx against the overlay without keeping any of its effects in the same way as CallTxSim
+func (sim *Simulation) Call(tx *payload.CallTx, logger *logging.Logger) (*exec.TxExecution, error) {
+	if tx.Address == nil {
+		return nil, fmt.Errorf("CallSim requires a non-nil address from which to retrieve code")
+	}
+	sim.RLock()
+	defer sim.RUnlock()
+	return execution.CallSim(sim.state, sim.blockchain, tx.Input.Address, *tx.Address, tx.Data, logger)
+}
+
+// EstimateGas finds the lowest GasLimit with which tx would succeed against the overlay
+func (sim *Simulation) EstimateGas(tx *payload.CallTx, logger *logging.Logger) (gasUsed, gasLimit uint64, err error) {
+	sim.RLock()
+	defer sim.RUnlock()
+	return execution.EstimateGas(sim.state, sim.blockchain, tx, logger)
+}
+
+// Warnings describes the ways in which the simulation may differ from the chain
+func (sim *Simulation) Warnings() []string {
+	return sim.warnings
+}
+
+func (sim *Simulation) GetAccount(address crypto.Address) (*acm.Account, error) {
+	return sim.state.GetAccount(address)
+}
+
+func (sim *Simulation) GetStorage(address crypto.Address, key binary.Word256) ([]byte, error) {
+	return sim.state.GetStorage(address, key)
+}
+
+func (sim *Simulation) GetMetadata(metahash acmstate.MetadataHash) (string, error) {
+	return sim.state.GetMetadata(metahash)
+}
+
+// GetMetadataForAccount finds the metadata of the contract deployed at address in the same way as the GetMetadata query
+func (sim *Simulation) GetMetadataForAccount(address crypto.Address) (string, error) {
+	acc, err := sim.state.GetAccount(address)
+	if err != nil || acc == nil || acc.CodeHash == nil {
+		return "", err
+	}
+	codehash := acc.CodeHash
+	if acc.Forebear != nil {
+		acc, err = sim.state.GetAccount(*acc.Forebear)
+		if err != nil || acc == nil {
+			return "", err
+		}
+	}
+	deployCodehash := compile.GetDeployCodeHash(acc.EVMCode, address)
+	for _, hash := range [][]byte{codehash, deployCodehash} {
+		for _, m := range acc.ContractMeta {
+			if bytes.Equal(m.CodeHash, hash) {
+				var metahash acmstate.MetadataHash
+				copy(metahash[:], m.MetadataHash)
+				return sim.state.GetMetadata(metahash)
+			}
+		}
+	}
+	return "", nil
+}
+
+func (sim *Simulation) GetName(name string) (*names.Entry, error) {
+	entry, err := sim.names.GetName(name)
+	if entry == nil && err == nil {
+		err = fmt.Errorf("name %s not found", name)
+	}
+	return entry, err
+}
+
+func (sim *Simulation) GetValidatorSet() *rpcquery.ValidatorSet {
+	sim.RLock()
+	defer sim.RUnlock()
+	return &rpcquery.ValidatorSet{
+		Height: sim.blockchain.LastBlockHeight(),
+		Set:    sim.validators.Validators(),
+	}
+}
+
+// chainReader reads the state of the chain as of its latest block
+type chainReader struct {
+	queryClient rpcquery.QueryClient
+	timeout     time.Duration
+}
+
+func (cr *chainReader) GetAccount(address crypto.Address) (*acm.Account, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), cr.timeout)
+	defer cancel()
+	acc, err := cr.queryClient.GetAccount(ctx, &rpcquery.GetAccountParam{Address: address})
+	if err != nil {
+		return nil, err
+	}
+	// The query returns an empty account for an address that does not exist
+	if acc.Address != address {
+		return nil, nil
+	}
+	return acc, nil
+}
+
+func (cr *chainReader) GetStorage(address crypto.Address, key binary.Word256) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), cr.timeout)
+	defer cancel()
+	val, err := cr.queryClient.GetStorage(ctx, &rpcquery.GetStorageParam{Address: address, Key: key})
+	if err != nil {
+		return nil, err
+	}
+	return val.Value, nil
+}
+
+func (cr *chainReader) GetMetadata(metahash acmstate.MetadataHash) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), cr.timeout)
+	defer cancel()
+	var bs binary.HexBytes = metahash.Bytes()
+	metadata, err := cr.queryClient.GetMetadata(ctx, &rpcquery.GetMetadataParam{MetadataHash: &bs})
+	if err != nil {
+		return "", err
+	}
+	return metadata.Metadata, nil
+}
+
+func (cr *chainReader) GetName(name string) (*names.Entry, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), cr.timeout)
+	defer cancel()
+	entry, err := cr.queryClient.GetName(ctx, &rpcquery.GetNameParam{Name: name})
+	if err != nil {
+		// The query gives an error rather than no entry for a name that does not exist
+		if strings.Contains(err.Error(), fmt.Sprintf("name %s not found", name)) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return entry, nil
+}
+
+// Jails are not exposed by the query service so the simulation cannot tell whether a validator is jailed
+func (cr *chainReader) GetJail(address crypto.Address) (*slashing.Jail, error) {
+	return nil, nil
+}
+
+func (cr *chainReader) IterateJails(consumer func(*slashing.Jail) error) error {
+	return nil
+}
+
+func (cr *chainReader) IterateSlashes(consumer func(*slashing.Slash) error) error {
+	ctx, cancel := context.WithTimeout(context.Background(), cr.timeout)
+	defer cancel()
+	stream, err := cr.queryClient.ListSlashes(ctx, &rpcquery.ListSlashesParam{})
+	if err != nil {
+		return err
+	}
+	for {
+		slash, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		err = consumer(slash)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (cr *chainReader) GetUnbonding(releaseHeight uint64, validator, address crypto.Address) (*slashing.Unbonding, error) {
+	var found *slashing.Unbonding
+	err := cr.IterateUnbondings(func(unbonding *slashing.Unbonding) error {
+		if unbonding.ReleaseHeight == releaseHeight && unbonding.Validator == validator &&
+			unbonding.Address == address {
+			found = unbonding
+		}
+		return nil
+	})
+	return found, err
+}
+
+func (cr *chainReader) IterateUnbondings(consumer func(*slashing.Unbonding) error) error {
+	ctx, cancel := context.WithTimeout(context.Background(), cr.timeout)
+	defer cancel()
+	stream, err := cr.queryClient.ListUnbondings(ctx, &rpcquery.ListUnbondingsParam{})
+	if err != nil {
+		return err
+	}
+	for {
+		unbonding, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		err = consumer(unbonding)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (cr *chainReader) GetDelegation(validator, delegator crypto.Address) (*delegation.Delegation, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), cr.timeout)
+	defer cancel()
+	d, err := cr.queryClient.GetDelegation(ctx, &rpcquery.GetDelegationParam{Validator: validator, Delegator: delegator})
+	if err != nil {
+		// The query gives an error rather than no delegation for a delegation that does not exist
+		if strings.Contains(err.Error(), fmt.Sprintf("%v has not delegated to %v", delegator, validator)) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return d, nil
+}
+
+func (cr *chainReader) IterateDelegations(consumer func(*delegation.Delegation) error) error {
+	ctx, cancel := context.WithTimeout(context.Background(), cr.timeout)
+	defer cancel()
+	stream, err := cr.queryClient.ListDelegations(ctx, &rpcquery.ListDelegationsParam{})
+	if err != nil {
+		return err
+	}
+	for {
+		d, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		err = consumer(d)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// simulatedBlockchain stands in for the chain's blockchain as of its latest block, the simulated transactions are run as
+// though they were in the next block. Only the parameters of the chain's genesis are available.
+type simulatedBlockchain struct {
+	chain      *chainReader
+	status     *rpc.ResultStatus
+	genesisDoc genesis.GenesisDoc
+}
+
+var _ bcm.BlockchainInfo = (*simulatedBlockchain)(nil)
+
+func (sb *simulatedBlockchain) GenesisHash() []byte {
+	return sb.status.GenesisHash
+}
+
+func (sb *simulatedBlockchain) GenesisDoc() genesis.GenesisDoc {
+	return sb.genesisDoc
+}
+
+func (sb *simulatedBlockchain) ChainID() string {
+	return sb.status.ChainID
+}
+
+func (sb *simulatedBlockchain) LastBlockHeight() uint64 {
+	return sb.status.SyncInfo.LatestBlockHeight
+}
+
+func (sb *simulatedBlockchain) LastBlockTime() time.Time {
+	return sb.status.SyncInfo.LatestBlockTime
+}
+
+func (sb *simulatedBlockchain) LastCommitTime() time.Time {
+	return sb.status.SyncInfo.LatestBlockSeenTime
+}
+
+func (sb *simulatedBlockchain) LastCommitDuration() time.Duration {
+	return sb.status.SyncInfo.LatestBlockDuration
+}
+
+func (sb *simulatedBlockchain) LastBlockHash() []byte {
+	return sb.status.SyncInfo.LatestBlockHash
+}
+
+func (sb *simulatedBlockchain) AppHashAfterLastBlock() []byte {
+	return sb.status.SyncInfo.LatestAppHash
+}
+
+func (sb *simulatedBlockchain) BlockHash(height uint64) []byte {
+	if height == sb.LastBlockHeight() {
+		return sb.LastBlockHash()
+	}
+	// Only the header of the following block is available to us
+	ctx, cancel := context.WithTimeout(context.Background(), sb.chain.timeout)
+	defer cancel()
+	header, err := sb.chain.queryClient.GetBlockHeader(ctx, &rpcquery.GetBlockParam{Height: height + 1})
+	if err != nil {
+		return nil
+	}
+	return header.LastBlockId.Hash
+}
+
+func (sb *simulatedBlockchain) GetBlockHeader(height uint64) (*types.Header, error) {
+	return nil, fmt.Errorf("block headers are not available to a simulation")
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"strings"
+	"sync/atomic"
 
 	compilers "github.com/hyperledger/burrow/deploy/compile"
 	"github.com/hyperledger/burrow/deploy/def"
@@ -116,175 +117,349 @@ func getCompilerWork(intermediate interface{}) (*compilers.Response, error) {
 	return nil, fmt.Errorf("internal error: no compiler work queued")
 }
 
+// assertionsFailed names the assert jobs that failed during a dry run
+type assertionsFailed []string
+
+func (af assertionsFailed) Error() string {
+	return fmt.Sprintf("assertions failed: %s", strings.Join(af, ", "))
+}
+
 func doJobs(playbook *def.Playbook, args *def.DeployArgs, client *def.Client, logger *logging.Logger) error {
-	for _, job := range playbook.Jobs {
-		payload, err := job.Payload()
-		if err != nil {
-			return fmt.Errorf("could not get Job payload: %v", payload)
+	// Each job waits for the jobs it depends on or, if it does not declare any dependencies, for all the jobs before
+	// it. Jobs without dependencies therefore run in order while independent jobs can run in parallel. Every job also
+	// waits for the last account job before it since that sets the account it uses.
+	done := make([]chan struct{}, len(playbook.Jobs))
+	errs := make([]error, len(playbook.Jobs))
+	indices := make(map[string][]int)
+	lastAccount := -1
+	var aborted int32
+
+	for i, job := range playbook.Jobs {
+		done[i] = make(chan struct{})
+		var dependencies []int
+		if len(job.DependsOn) == 0 {
+			for j := 0; j < i; j++ {
+				dependencies = append(dependencies, j)
+			}
+		}
+		for _, name := range job.DependsOn {
+			dependencies = append(dependencies, indices[name]...)
+		}
+		if len(job.DependsOn) > 0 && lastAccount >= 0 {
+			dependencies = append(dependencies, lastAccount)
+		}
+		indices[job.Name] = append(indices[job.Name], i)
+		if job.Account != nil {
+			lastAccount = i
+		}
+
+		go func(i int, job *def.Job) {
+			defer close(done[i])
+			for _, j := range dependencies {
+				<-done[j]
+			}
+			// Nothing new is started once a job has failed
+			if atomic.LoadInt32(&aborted) != 0 {
+				return
+			}
+			errs[i] = runJob(job, playbook, args, client, logger)
+			if errs[i] != nil && !(args.DryRun && assertionFailure(job, errs[i])) {
+				atomic.StoreInt32(&aborted, 1)
+			}
+		}(i, job)
+	}
+
+	var failed assertionsFailed
+	for i, job := range playbook.Jobs {
+		<-done[i]
+		err := errs[i]
+		if err == nil {
+			continue
+		}
+		// A dry run reports every assertion rather than stopping at the first that fails
+		if args.DryRun && assertionFailure(job, err) {
+			if metaFailed, ok := err.(assertionsFailed); ok {
+				failed = append(failed, metaFailed...)
+			} else {
+				failed = append(failed, job.Name)
+			}
+			continue
+		}
+		// Wait for any jobs already running before returning
+		for j := i + 1; j < len(done); j++ {
+			<-done[j]
+		}
+		return err
+	}
+
+	if len(failed) > 0 {
+		return failed
+	}
+	return nil
+}
+
+func assertionFailure(job *def.Job, err error) bool {
+	if job.Assert != nil {
+		return true
+	}
+	_, ok := err.(assertionsFailed)
+	return ok
+}
+
+// runJob runs a job, once for each of its items if it has a foreach, provided that its condition holds
+func runJob(job *def.Job, playbook *def.Playbook, args *def.DeployArgs, client *def.Client, logger *logging.Logger) error {
+	if job.Foreach == nil {
+		run, err := jobCondition(job, playbook, args, client, logger)
+		if err != nil || !run {
+			return err
 		}
+		return doJob(job, playbook, args, client, logger)
+	}
 
-		err = util.PreProcessFields(payload, args, playbook, client, logger)
+	items, err := util.PreProcessForeach(job.Foreach, args, playbook, client, logger)
+	if err != nil {
+		return fmt.Errorf("could not get items of foreach of job %s: %v", job.Name, err)
+	}
+	results := make([]interface{}, len(items))
+	defer func() {
+		job.Result = results
+	}()
+	var assertionErr error
+	for i, item := range items {
+		// Each iteration runs a fresh copy of the job in a playbook of its own in which the current item is available
+		// as a variable
+		iteration, err := job.Copy()
+		if err != nil {
+			return err
+		}
+		iterationPlaybook := &def.Playbook{
+			Filename: playbook.Filename,
+			Account:  playbook.Account,
+			Jobs:     []*def.Job{{Name: job.Foreach.Variable(), Result: item}, iteration},
+			Path:     playbook.Path,
+			BinPath:  playbook.BinPath,
+			Parent:   playbook,
+		}
+		logger.InfoMsg("Foreach iteration", "Job Name", job.Name, job.Foreach.Variable(), item)
+		run, err := jobCondition(iteration, iterationPlaybook, args, client, logger)
 		if err != nil {
 			return err
 		}
-		// Revalidate with possible replacements
-		err = payload.Validate()
+		if !run {
+			continue
+		}
+		err = doJob(iteration, iterationPlaybook, args, client, logger)
+		results[i] = iteration.Result
 		if err != nil {
-			return fmt.Errorf("error validating job %s after pre-processing variables: %v", job.Name, err)
+			if args.DryRun && iteration.Assert != nil {
+				assertionErr = err
+				continue
+			}
+			return err
 		}
+	}
+	return assertionErr
+}
 
-		switch payload.(type) {
-		case *def.Proposal:
-			announce(job.Name, "Proposal", logger)
-			job.Result, err = ProposalJob(job.Proposal, args, playbook, client, logger)
+func jobCondition(job *def.Job, playbook *def.Playbook, args *def.DeployArgs, client *def.Client,
+	logger *logging.Logger) (bool, error) {
 
-		// Meta Job
-		case *def.Meta:
-			announce(job.Name, "Meta", logger)
-			metaPlaybook := job.Meta.Playbook
-			if metaPlaybook.Account == "" {
-				metaPlaybook.Account = playbook.Account
-			}
-			err = doJobs(metaPlaybook, args, client, logger)
+	if job.If == "" {
+		return true, nil
+	}
+	run, err := util.PreProcessCondition(job.If, args, playbook, client, logger)
+	if err != nil {
+		return false, fmt.Errorf("could not evaluate condition of job %s: %v", job.Name, err)
+	}
+	if !run {
+		logger.InfoMsg("*****Skipping Job*****", "Job Name", job.Name, "condition", job.If)
+	}
+	return run, nil
+}
 
-		// Governance
-		case *def.UpdateAccount:
-			announce(job.Name, "UpdateAccount", logger)
-			var tx *pbpayload.GovTx
-			tx, job.Variables, err = FormulateUpdateAccountJob(job.UpdateAccount, playbook.Account, client, logger)
-			if err != nil {
-				return err
-			}
-			err = UpdateAccountJob(job.UpdateAccount, playbook.Account, tx, client, logger)
-
-		// Util jobs
-		case *def.Account:
-			announce(job.Name, "Account", logger)
-			job.Result, err = SetAccountJob(job.Account, args, playbook, logger)
-		case *def.Set:
-			announce(job.Name, "Set", logger)
-			job.Result, err = SetValJob(job.Set, args, logger)
-
-		// Transaction jobs
-		case *def.Send:
-			announce(job.Name, "Send", logger)
-			tx, err := FormulateSendJob(job.Send, playbook.Account, client, logger)
-			if err != nil {
-				return err
-			}
-			job.Result, err = SendJob(job.Send, tx, playbook.Account, client, logger)
-			if err != nil {
-				return err
-			}
-		case *def.Bond:
-			announce(job.Name, "Bond", logger)
-			tx, err := FormulateBondJob(job.Bond, playbook.Account, client, logger)
-			if err != nil {
-				return err
-			}
-			job.Result, err = BondJob(job.Bond, tx, playbook.Account, client, logger)
-			if err != nil {
-				return err
-			}
-		case *def.Unbond:
-			announce(job.Name, "Unbond", logger)
-			tx, err := FormulateUnbondJob(job.Unbond, playbook.Account, client, logger)
-			if err != nil {
-				return err
-			}
-			job.Result, err = UnbondJob(job.Unbond, tx, playbook.Account, client, logger)
-			if err != nil {
-				return err
-			}
-		case *def.RegisterName:
-			announce(job.Name, "RegisterName", logger)
-			txs, err := FormulateRegisterNameJob(job.RegisterName, args, playbook, client, logger)
-			if err != nil {
-				return err
-			}
-			job.Result, err = RegisterNameJob(job.RegisterName, args, playbook, txs, client, logger)
-			if err != nil {
-				return err
-			}
-		case *def.Permission:
-			announce(job.Name, "Permission", logger)
-			tx, err := FormulatePermissionJob(job.Permission, playbook.Account, client, logger)
-			if err != nil {
-				return err
-			}
-			job.Result, err = PermissionJob(job.Permission, playbook.Account, tx, client, logger)
-			if err != nil {
-				return err
-			}
+func doJob(job *def.Job, playbook *def.Playbook, args *def.DeployArgs, client *def.Client, logger *logging.Logger) error {
+	payload, err := job.Payload()
+	if err != nil {
+		return fmt.Errorf("could not get Job payload: %v", payload)
+	}
 
-		// Contracts jobs
-		case *def.Deploy:
-			announce(job.Name, "Deploy", logger)
-			txs, contracts, ferr := FormulateDeployJob(job.Deploy, args, playbook, client, job.Intermediate, logger)
-			if ferr != nil {
-				return ferr
-			}
-			job.Result, err = DeployJob(job.Deploy, args, playbook, client, txs, contracts, logger)
+	err = util.PreProcessFields(payload, args, playbook, client, logger)
+	if err != nil {
+		return err
+	}
+	// Revalidate with possible replacements
+	err = payload.Validate()
+	if err != nil {
+		return fmt.Errorf("error validating job %s after pre-processing variables: %v", job.Name, err)
+	}
 
-		case *def.Call:
-			announce(job.Name, "Call", logger)
-			CallTx, ferr := FormulateCallJob(job.Call, args, playbook, client, logger)
-			if ferr != nil {
-				return ferr
-			}
-			job.Result, job.Variables, err = CallJob(job.Call, CallTx, args, playbook, client, logger)
-		case *def.Build:
-			announce(job.Name, "Build", logger)
-			var resp *compilers.Response
-			resp, err = getCompilerWork(job.Intermediate)
-			if err != nil {
-				return err
-			}
-			job.Result, err = BuildJob(job.Build, playbook, resp, logger)
-
-		// State jobs
-		case *def.RestoreState:
-			announce(job.Name, "RestoreState", logger)
-			job.Result, err = RestoreStateJob(job.RestoreState)
-		case *def.DumpState:
-			announce(job.Name, "DumpState", logger)
-			job.Result, err = DumpStateJob(job.DumpState)
-
-		// Test jobs
-		case *def.QueryAccount:
-			announce(job.Name, "QueryAccount", logger)
-			job.Result, err = QueryAccountJob(job.QueryAccount, client, logger)
-		case *def.QueryContract:
-			announce(job.Name, "QueryContract", logger)
-			job.Result, job.Variables, err = QueryContractJob(job.QueryContract, args, playbook, client, logger)
-		case *def.QueryName:
-			announce(job.Name, "QueryName", logger)
-			job.Result, err = QueryNameJob(job.QueryName, client, logger)
-		case *def.QueryVals:
-			announce(job.Name, "QueryVals", logger)
-			job.Result, err = QueryValsJob(job.QueryVals, client, logger)
-		case *def.Assert:
-			announce(job.Name, "Assert", logger)
-			job.Result, err = AssertJob(job.Assert, logger)
-
-		default:
-			logger.InfoMsg("Error")
-			return fmt.Errorf("the Job specified in deploy.yaml and parsed as '%v' is not recognised as a valid job",
-				job)
-		}
-
-		if len(job.Variables) != 0 {
-			for _, theJob := range job.Variables {
-				logger.InfoMsg("Job Vars", "name", theJob.Name, "value", theJob.Value)
-			}
+	switch payload.(type) {
+	case *def.Proposal:
+		announce(job.Name, "Proposal", logger)
+		job.Result, err = ProposalJob(job.Proposal, args, playbook, client, logger)
+
+	// Meta Job
+	case *def.Meta:
+		announce(job.Name, "Meta", logger)
+		metaPlaybook := job.Meta.Playbook
+		if metaPlaybook.Account == "" {
+			metaPlaybook.Account = playbook.Account
 		}
+		err = doJobs(metaPlaybook, args, client, logger)
 
+	// Governance
+	case *def.UpdateAccount:
+		announce(job.Name, "UpdateAccount", logger)
+		var tx *pbpayload.GovTx
+		tx, job.Variables, err = FormulateUpdateAccountJob(job.UpdateAccount, playbook.Account, client, logger)
+		if err != nil {
+			return err
+		}
+		err = UpdateAccountJob(job.UpdateAccount, playbook.Account, tx, client, logger)
+
+	// Util jobs
+	case *def.Account:
+		announce(job.Name, "Account", logger)
+		job.Result, err = SetAccountJob(job.Account, args, playbook, logger)
+	case *def.Set:
+		announce(job.Name, "Set", logger)
+		job.Result, err = SetValJob(job.Set, args, logger)
+
+	// Transaction jobs
+	case *def.Send:
+		announce(job.Name, "Send", logger)
+		tx, err := FormulateSendJob(job.Send, playbook.Account, client, logger)
+		if err != nil {
+			return err
+		}
+		job.Result, err = SendJob(job.Send, tx, playbook.Account, client, logger)
+		if err != nil {
+			return err
+		}
+	case *def.Bond:
+		announce(job.Name, "Bond", logger)
+		tx, err := FormulateBondJob(job.Bond, playbook.Account, client, logger)
+		if err != nil {
+			return err
+		}
+		job.Result, err = BondJob(job.Bond, tx, playbook.Account, client, logger)
+		if err != nil {
+			return err
+		}
+	case *def.Unbond:
+		announce(job.Name, "Unbond", logger)
+		tx, err := FormulateUnbondJob(job.Unbond, playbook.Account, client, logger)
+		if err != nil {
+			return err
+		}
+		job.Result, err = UnbondJob(job.Unbond, tx, playbook.Account, client, logger)
+		if err != nil {
+			return err
+		}
+	case *def.Delegate:
+		announce(job.Name, "Delegate", logger)
+		tx, err := FormulateDelegateJob(job.Delegate, playbook.Account, client, logger)
+		if err != nil {
+			return err
+		}
+		job.Result, err = DelegateJob(job.Delegate, tx, playbook.Account, client, logger)
 		if err != nil {
 			return err
 		}
+	case *def.Undelegate:
+		announce(job.Name, "Undelegate", logger)
+		tx, err := FormulateUndelegateJob(job.Undelegate, playbook.Account, client, logger)
+		if err != nil {
+			return err
+		}
+		job.Result, err = UndelegateJob(job.Undelegate, tx, playbook.Account, client, logger)
+		if err != nil {
+			return err
+		}
+	case *def.RegisterName:
+		announce(job.Name, "RegisterName", logger)
+		txs, err := FormulateRegisterNameJob(job.RegisterName, args, playbook, client, logger)
+		if err != nil {
+			return err
+		}
+		job.Result, err = RegisterNameJob(job.RegisterName, args, playbook, txs, client, logger)
+		if err != nil {
+			return err
+		}
+	case *def.Permission:
+		announce(job.Name, "Permission", logger)
+		tx, err := FormulatePermissionJob(job.Permission, playbook.Account, client, logger)
+		if err != nil {
+			return err
+		}
+		job.Result, err = PermissionJob(job.Permission, playbook.Account, tx, client, logger)
+		if err != nil {
+			return err
+		}
+
+	// Contracts jobs
+	case *def.Deploy:
+		announce(job.Name, "Deploy", logger)
+		txs, contracts, ferr := FormulateDeployJob(job.Deploy, args, playbook, client, job.Intermediate, logger)
+		if ferr != nil {
+			return ferr
+		}
+		job.Result, err = DeployJob(job.Deploy, args, playbook, client, txs, contracts, logger)
+
+	case *def.Call:
+		announce(job.Name, "Call", logger)
+		CallTx, ferr := FormulateCallJob(job.Call, args, playbook, client, logger)
+		if ferr != nil {
+			return ferr
+		}
+		job.Result, job.Variables, err = CallJob(job.Call, CallTx, args, playbook, client, logger)
+	case *def.Build:
+		announce(job.Name, "Build", logger)
+		var resp *compilers.Response
+		resp, err = getCompilerWork(job.Intermediate)
+		if err != nil {
+			return err
+		}
+		job.Result, err = BuildJob(job.Build, playbook, resp, logger)
+
+	// State jobs
+	case *def.RestoreState:
+		announce(job.Name, "RestoreState", logger)
+		job.Result, err = RestoreStateJob(job.RestoreState)
+	case *def.DumpState:
+		announce(job.Name, "DumpState", logger)
+		job.Result, err = DumpStateJob(job.DumpState)
+
+	// Test jobs
+	case *def.QueryAccount:
+		announce(job.Name, "QueryAccount", logger)
+		job.Result, err = QueryAccountJob(job.QueryAccount, client, logger)
+	case *def.QueryContract:
+		announce(job.Name, "QueryContract", logger)
+		job.Result, job.Variables, err = QueryContractJob(job.QueryContract, args, playbook, client, logger)
+	case *def.QueryName:
+		announce(job.Name, "QueryName", logger)
+		job.Result, err = QueryNameJob(job.QueryName, client, logger)
+	case *def.QueryVals:
+		announce(job.Name, "QueryVals", logger)
+		job.Result, err = QueryValsJob(job.QueryVals, client, logger)
+	case *def.Assert:
+		announce(job.Name, "Assert", logger)
+		job.Result, err = AssertJob(job.Assert, logger)
+
+	default:
+		logger.InfoMsg("Error")
+		return fmt.Errorf("the Job specified in deploy.yaml and parsed as '%v' is not recognised as a valid job",
+			job)
 	}
 
-	return nil
+	if len(job.Variables) != 0 {
+		for _, theJob := range job.Variables {
+			logger.InfoMsg("Job Vars", "name", theJob.Name, "value", theJob.Value)
+		}
+	}
+
+	return err
 }
 
 func ExecutePlaybook(args *def.DeployArgs, playbook *def.Playbook, client *def.Client, logger *logging.Logger) error {
@@ -320,6 +495,13 @@ func ExecutePlaybook(args *def.DeployArgs, playbook *def.Playbook, client *def.C
 	}
 
 	err = doJobs(playbook, args, client, logger)
+	if args.DryRun {
+		for _, warning := range client.SimulationWarnings() {
+			logger.InfoMsg("Dry run warning", "warning", warning)
+		}
+		reportDryRun(playbook, logger)
+		return err
+	}
 	if err != nil {
 		return err
 	}
@@ -328,6 +510,25 @@ func ExecutePlaybook(args *def.DeployArgs, playbook *def.Playbook, client *def.C
 	return nil
 }
 
+// reportDryRun logs the result of each job that was run against a simulation of the chain. No output file is written
+// since none of the results (the addresses of deployed contracts in particular) exist on the chain.
+func reportDryRun(playbook *def.Playbook, logger *logging.Logger) {
+	for _, job := range playbook.Jobs {
+		if job.Meta != nil && job.Meta.Playbook != nil {
+			reportDryRun(job.Meta.Playbook, logger)
+			continue
+		}
+		if job.Result == nil {
+			// Job was not reached
+			continue
+		}
+		logger.InfoMsg("Dry run result", "job", job.Name, "result", job.Result)
+		for _, variable := range job.Variables {
+			logger.InfoMsg("Dry run variable", "job", job.Name, "name", variable.Name, "value", variable.Value)
+		}
+	}
+}
+
 func announce(job, typ string, logger *logging.Logger) {
 	logger.InfoMsg("*****Executing Job*****", "Job Name", job, "Type", typ)
 }
@@ -0,0 +1,90 @@
+package jobs
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDoJobsDryRunAssertions(t *testing.T) {
+	playbook := func() *def.Playbook {
+		return &def.Playbook{
+			Jobs: []*def.Job{
+				{Name: "right", Assert: &def.Assert{Key: "1", Relation: "eq", Value: "1"}},
+				{Name: "wrong", Assert: &def.Assert{Key: "1", Relation: "eq", Value: "2"}},
+				{Name: "set", Set: &def.Set{Value: "foo"}},
+				{Name: "alsoWrong", Assert: &def.Assert{Key: "1", Relation: "gt", Value: "2"}},
+			},
+		}
+	}
+	logger := logging.NewNoopLogger()
+
+	pb := playbook()
+	err := doJobs(pb, &def.DeployArgs{}, nil, logger)
+	assert.EqualError(t, err, "assertion failed")
+	assert.Nil(t, pb.Jobs[2].Result)
+
+	pb = playbook()
+	err = doJobs(pb, &def.DeployArgs{DryRun: true}, nil, logger)
+	require.Equal(t, assertionsFailed{"wrong", "alsoWrong"}, err)
+	assert.EqualError(t, err, "assertions failed: wrong, alsoWrong")
+	assert.Equal(t, "passed", pb.Jobs[0].Result)
+	assert.Equal(t, "failed", pb.Jobs[1].Result)
+	assert.Equal(t, "foo", pb.Jobs[2].Result)
+	assert.Equal(t, "failed", pb.Jobs[3].Result)
+}
+
+func TestDoJobsControlFlow(t *testing.T) {
+	playbook := &def.Playbook{
+		Jobs: []*def.Job{
+			{Name: "env", Set: &def.Set{Value: "production"}},
+			{Name: "skipped", If: "$env == staging", Set: &def.Set{Value: "x"}},
+			{Name: "ran", If: "$env == production", Set: &def.Set{Value: "y"}},
+			{Name: "letters", Foreach: &def.Foreach{Items: []string{"a", "$ran"}}, Set: &def.Set{Value: "$item-$env"}},
+			{Name: "numbers", Foreach: &def.Foreach{Range: "1..3", As: "n"}, If: "$n != 2", Set: &def.Set{Value: "$n"}},
+			{Name: "first", DependsOn: []string{"env"}, Set: &def.Set{Value: "$env"}},
+			{Name: "second", DependsOn: []string{"letters"}, Set: &def.Set{Value: "$letters"}},
+			{Name: "both", DependsOn: []string{"first", "second"}, Assert: &def.Assert{Key: "$first", Relation: "eq",
+				Value: "production"}},
+		},
+	}
+	require.NoError(t, playbook.Validate())
+	err := doJobs(playbook, &def.DeployArgs{}, nil, logging.NewNoopLogger())
+	require.NoError(t, err)
+
+	results := make(map[string]interface{})
+	for _, job := range playbook.Jobs {
+		results[job.Name] = job.Result
+	}
+	assert.Equal(t, map[string]interface{}{
+		"env":     "production",
+		"skipped": nil,
+		"ran":     "y",
+		"letters": []interface{}{"a-production", "y-production"},
+		"numbers": []interface{}{"1", nil, "3"},
+		"first":   "production",
+		"second":  `["a-production","y-production"]`,
+		"both":    "passed",
+	}, results)
+	// Iterations run on copies of the job
+	assert.Equal(t, "$item-$env", playbook.Jobs[3].Set.Value)
+}
+
+func TestDoJobsDependsOnFailure(t *testing.T) {
+	playbook := &def.Playbook{
+		Jobs: []*def.Job{
+			{Name: "first", Set: &def.Set{Value: "1"}},
+			{Name: "failing", DependsOn: []string{"first"}, Assert: &def.Assert{Key: "1", Relation: "eq", Value: "2"}},
+			{Name: "dependent", DependsOn: []string{"failing"}, Set: &def.Set{Value: "2"}},
+			{Name: "later", Set: &def.Set{Value: "3"}},
+		},
+	}
+	err := doJobs(playbook, &def.DeployArgs{}, nil, logging.NewNoopLogger())
+	assert.EqualError(t, err, "assertion failed")
+	assert.Equal(t, "1", playbook.Jobs[0].Result)
+	assert.Nil(t, playbook.Jobs[2].Result)
+	assert.Nil(t, playbook.Jobs[3].Result)
+}
@@ -5,6 +5,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 
 	"github.com/hyperledger/burrow/acm/acmstate"
 	"github.com/hyperledger/burrow/execution/errors"
@@ -16,6 +17,7 @@ import (
 	"github.com/hyperledger/burrow/deploy/def"
 	"github.com/hyperledger/burrow/deploy/util"
 	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/wasm"
 	"github.com/hyperledger/burrow/txs/payload"
 	hex "github.com/tmthrgd/go-hex"
 )
@@ -98,6 +100,15 @@ func BuildJob(build *def.Build, deployScript *def.Playbook, resp *compilers.Resp
 
 func FormulateDeployJob(deploy *def.Deploy, do *def.DeployArgs, deployScript *def.Playbook, client *def.Client, intermediate interface{}, logger *logging.Logger) (txs []*payload.CallTx, contracts []*compilers.ResponseItem, err error) {
 	deploy.Libraries, _ = util.PreProcessLibs(deploy.Libraries, do, deployScript, client, logger)
+	// Release the inputs of the transactions already formed if a later one fails
+	var formed []*payload.CallTx
+	defer func() {
+		if err != nil {
+			for _, tx := range formed {
+				client.ReleaseInputs(tx.Input)
+			}
+		}
+	}()
 	// trim the extension and path
 	contractName := filepath.Base(deploy.Contract)
 	contractName = strings.TrimSuffix(contractName, filepath.Ext(contractName))
@@ -107,6 +118,7 @@ func FormulateDeployJob(deploy *def.Deploy, do *def.DeployArgs, deployScript *de
 	deploy.Instance = FirstOf(deploy.Instance, contractName)
 	deploy.Amount = FirstOf(deploy.Amount, do.DefaultAmount)
 	deploy.Fee = FirstOf(deploy.Fee, do.DefaultFee)
+	estimate := deploy.Gas == ""
 	deploy.Gas = FirstOf(deploy.Gas, do.DefaultGas)
 
 	// assemble contract
@@ -207,10 +219,11 @@ func FormulateDeployJob(deploy *def.Deploy, do *def.DeployArgs, deployScript *de
 			}
 			mergeAbiSpecBytes(client, response.Contract.Abi)
 
-			tx, err := deployContract(deploy, do, deployScript, client, response, libs, logger)
+			tx, err := deployContract(deploy, do, deployScript, client, response, resp.Objects, libs, logger)
 			if err != nil {
 				return nil, nil, err
 			}
+			formed = append(formed, tx)
 
 			txs = []*payload.CallTx{tx}
 			contracts = append(contracts, &resp.Objects[0])
@@ -224,10 +237,11 @@ func FormulateDeployJob(deploy *def.Deploy, do *def.DeployArgs, deployScript *de
 					continue
 				}
 				mergeAbiSpecBytes(client, response.Contract.Abi)
-				tx, err := deployContract(deploy, do, deployScript, client, response, libs, logger)
+				tx, err := deployContract(deploy, do, deployScript, client, response, resp.Objects, libs, logger)
 				if err != nil {
 					return nil, nil, err
 				}
+				formed = append(formed, tx)
 				deployedCount++
 				if strings.EqualFold(response.Objectname, strings.TrimSuffix(filepath.Base(deploy.Contract), filepath.Ext(filepath.Base(deploy.Contract)))) {
 					baseObj = tx
@@ -261,10 +275,11 @@ func FormulateDeployJob(deploy *def.Deploy, do *def.DeployArgs, deployScript *de
 						"contract", response.Objectname,
 						"Abi", string(response.Contract.Abi),
 						"Bin", response.Contract.Evm.Bytecode.Object)
-					tx, err := deployContract(deploy, do, deployScript, client, response, libs, logger)
+					tx, err := deployContract(deploy, do, deployScript, client, response, resp.Objects, libs, logger)
 					if err != nil {
 						return nil, nil, err
 					}
+					formed = append(formed, tx)
 					txs = append(txs, tx)
 					// make sure we copy response, as it is the loop variable and will be overwritten
 					contracts = append(contracts, &resp.Objects[i])
@@ -273,6 +288,11 @@ func FormulateDeployJob(deploy *def.Deploy, do *def.DeployArgs, deployScript *de
 		}
 	}
 
+	if estimate {
+		for _, tx := range txs {
+			estimateGas(tx, client, logger)
+		}
+	}
 	return
 }
 
@@ -282,6 +302,12 @@ func DeployJob(deploy *def.Deploy, do *def.DeployArgs, script *def.Playbook, cli
 	// these are naively added to the end of the contract code using standard
 	// mint packing
 
+	// Release the inputs of any transactions left unbroadcast by an error
+	defer func() {
+		for _, tx := range txs {
+			client.ReleaseInputs(tx.Input)
+		}
+	}()
 	for i, tx := range txs {
 		// Sign, broadcast, display
 		contractAddress, err := deployFinalize(do, client, tx, logger)
@@ -338,7 +364,7 @@ func findContractFile(contract, binPath string, deployPath string) (string, erro
 }
 
 // TODO [rj] refactor to remove [contractPath] from functions signature => only used in a single error throw.
-func deployContract(deploy *def.Deploy, do *def.DeployArgs, script *def.Playbook, client *def.Client, compilersResponse compilers.ResponseItem, libs map[string]string, logger *logging.Logger) (*payload.CallTx, error) {
+func deployContract(deploy *def.Deploy, do *def.DeployArgs, script *def.Playbook, client *def.Client, compilersResponse compilers.ResponseItem, objects []compilers.ResponseItem, libs map[string]string, logger *logging.Logger) (*payload.CallTx, error) {
 	contract := compilersResponse.Contract
 	contractName := compilersResponse.Objectname
 	logger.InfoMsg("Saving Binary", "contract", contractName)
@@ -361,7 +387,10 @@ func deployContract(deploy *def.Deploy, do *def.DeployArgs, script *def.Playbook
 	wasm := ""
 	data := ""
 	if contract.EWasm.Wasm != "" {
-		wasm = contract.EWasm.Wasm
+		wasm, err = bundleWASM(compilersResponse, objects)
+		if err != nil {
+			return nil, err
+		}
 	} else {
 		err = contract.Link(libs)
 		if err != nil {
@@ -402,6 +431,34 @@ func deployContract(deploy *def.Deploy, do *def.DeployArgs, script *def.Playbook
 	return deployTx(client, deploy, compilersResponse.Objectname, data, wasm, metaMap, logger)
 }
 
+// Bundle the other WASM contracts compiled alongside a WASM contract with it so that it can create them
+func bundleWASM(compilersResponse compilers.ResponseItem, objects []compilers.ResponseItem) (string, error) {
+	var children [][]byte
+	for _, object := range objects {
+		if object.Contract.EWasm.Wasm == "" ||
+			(object.Filename == compilersResponse.Filename && object.Objectname == compilersResponse.Objectname) {
+			continue
+		}
+		child, err := hex.DecodeString(object.Contract.EWasm.Wasm)
+		if err != nil {
+			return "", fmt.Errorf("could not decode WASM for %s: %v", object.Objectname, err)
+		}
+		children = append(children, child)
+	}
+	if len(children) == 0 {
+		return compilersResponse.Contract.EWasm.Wasm, nil
+	}
+	parent, err := hex.DecodeString(compilersResponse.Contract.EWasm.Wasm)
+	if err != nil {
+		return "", fmt.Errorf("could not decode WASM for %s: %v", compilersResponse.Objectname, err)
+	}
+	bundle, err := wasm.Bundle(parent, children...)
+	if err != nil {
+		return "", fmt.Errorf("could not bundle WASM for %s: %v", compilersResponse.Objectname, err)
+	}
+	return hex.EncodeToString(bundle), nil
+}
+
 func deployTx(client *def.Client, deploy *def.Deploy, contractName, data, wasm string, metamap map[acmstate.CodeHash]string, logger *logging.Logger) (*payload.CallTx, error) {
 	// Deploy contract
 	logger.TraceMsg("Deploying Contract",
@@ -435,6 +492,7 @@ func FormulateCallJob(call *def.Call, do *def.DeployArgs, deployScript *def.Play
 	call.Source = FirstOf(call.Source, deployScript.Account)
 	call.Amount = FirstOf(call.Amount, do.DefaultAmount)
 	call.Fee = FirstOf(call.Fee, do.DefaultFee)
+	estimate := call.Gas == ""
 	call.Gas = FirstOf(call.Gas, do.DefaultGas)
 
 	// Get address (possibly via key)
@@ -483,7 +541,7 @@ func FormulateCallJob(call *def.Call, do *def.DeployArgs, deployScript *def.Play
 		"function", call.Function,
 		"data", callData)
 
-	return client.Call(&def.CallArg{
+	tx, err = client.Call(&def.CallArg{
 		Input:    call.Source,
 		Amount:   call.Amount,
 		Address:  address.String(),
@@ -492,6 +550,13 @@ func FormulateCallJob(call *def.Call, do *def.DeployArgs, deployScript *def.Play
 		Data:     callData,
 		Sequence: call.Sequence,
 	}, logger)
+	if err != nil {
+		return nil, err
+	}
+	if estimate {
+		estimateGas(tx, client, logger)
+	}
+	return tx, nil
 }
 
 func CallJob(call *def.Call, tx *payload.CallTx, do *def.DeployArgs, playbook *def.Playbook, client *def.Client, logger *logging.Logger) (string, []*abi.Variable, error) {
@@ -581,7 +646,12 @@ func deployFinalize(do *def.DeployArgs, client *def.Client, tx payload.Payload,
 	return &txe.Receipt.ContractAddress, nil
 }
 
+// Guards the AllSpecs of the client since jobs may run in parallel
+var allSpecsLock sync.RWMutex
+
 func logEvents(txe *exec.TxExecution, client *def.Client, logger *logging.Logger) {
+	allSpecsLock.RLock()
+	defer allSpecsLock.RUnlock()
 	if client.AllSpecs == nil {
 		return
 	}
@@ -624,6 +694,8 @@ func logEvents(txe *exec.TxExecution, client *def.Client, logger *logging.Logger
 func mergeAbiSpecBytes(client *def.Client, bs []byte) {
 	spec, err := abi.ReadSpec(bs)
 	if err == nil {
+		allSpecsLock.Lock()
+		defer allSpecsLock.Unlock()
 		client.AllSpecs = abi.MergeSpec([]*abi.Spec{client.AllSpecs, spec})
 	}
 }
@@ -127,3 +127,73 @@ func UnbondJob(bond *def.Unbond, tx *payload.UnbondTx, account string, client *d
 
 	return txe.Receipt.TxHash.String(), nil
 }
+
+func FormulateDelegateJob(delegate *def.Delegate, account string, client *def.Client, logger *logging.Logger) (*payload.DelegateTx, error) {
+	// Use Default
+	delegate.Source = FirstOf(delegate.Source, account)
+
+	// Formulate tx
+	logger.InfoMsg("Delegating Transaction",
+		"source", delegate.Source,
+		"validator", delegate.Validator,
+		"amount", delegate.Amount)
+
+	arg := &def.DelegateArg{
+		Input:     delegate.Source,
+		Validator: delegate.Validator,
+		Amount:    delegate.Amount,
+		Sequence:  delegate.Sequence,
+	}
+
+	return client.Delegate(arg, logger)
+}
+
+func DelegateJob(delegate *def.Delegate, tx *payload.DelegateTx, account string, client *def.Client, logger *logging.Logger) (string, error) {
+	// Sign, broadcast, display
+	txe, err := client.SignAndBroadcast(tx, logger)
+	if err != nil {
+		return "", util.ChainErrorHandler(account, err, logger)
+	}
+
+	util.ReadTxSignAndBroadcast(txe, err, logger)
+	if err != nil {
+		return "", err
+	}
+
+	return txe.Receipt.TxHash.String(), nil
+}
+
+func FormulateUndelegateJob(undelegate *def.Undelegate, account string, client *def.Client, logger *logging.Logger) (*payload.UndelegateTx, error) {
+	// Use Default
+	undelegate.Source = FirstOf(undelegate.Source, account)
+
+	// Formulate tx
+	logger.InfoMsg("Undelegating Transaction",
+		"source", undelegate.Source,
+		"validator", undelegate.Validator,
+		"amount", undelegate.Amount)
+
+	arg := &def.UndelegateArg{
+		Input:     undelegate.Source,
+		Validator: undelegate.Validator,
+		Amount:    undelegate.Amount,
+		Sequence:  undelegate.Sequence,
+	}
+
+	return client.Undelegate(arg, logger)
+}
+
+func UndelegateJob(undelegate *def.Undelegate, tx *payload.UndelegateTx, account string, client *def.Client, logger *logging.Logger) (string, error) {
+	// Sign, broadcast, display
+	txe, err := client.SignAndBroadcast(tx, logger)
+	if err != nil {
+		return "", util.ChainErrorHandler(account, err, logger)
+	}
+
+	util.ReadTxSignAndBroadcast(txe, err, logger)
+	if err != nil {
+		return "", err
+	}
+
+	return txe.Receipt.TxHash.String(), nil
+}
@@ -178,6 +178,8 @@ func ProposalJob(prop *def.Proposal, do *def.DeployArgs, parentScript *def.Playb
 	if err != nil {
 		return "", err
 	}
+	// The proposal input is only signed as part of the batch so it is never broadcast itself
+	defer client.ReleaseInputs(proposalInput)
 	proposal.BatchTx.Inputs = []*payload.TxInput{proposalInput}
 	proposalHash := proposal.Hash()
 
@@ -4,6 +4,7 @@ import (
 	"encoding/csv"
 	"fmt"
 	"io"
+	"math"
 	"os"
 	"path/filepath"
 
@@ -157,6 +158,12 @@ func registerNameTx(name *def.RegisterName, do *def.DeployArgs, account string,
 
 func RegisterNameJob(name *def.RegisterName, do *def.DeployArgs, script *def.Playbook, txs []*payload.NameTx, client *def.Client, logger *logging.Logger) (string, error) {
 	var result string
+	// Release the inputs of any transactions left unbroadcast by an error
+	defer func() {
+		for _, tx := range txs {
+			client.ReleaseInputs(tx.Input)
+		}
+	}()
 
 	for _, tx := range txs {
 		// Sign, broadcast, display
@@ -214,6 +221,37 @@ func PermissionJob(perm *def.Permission, account string, tx *payload.PermsTx, cl
 	return txe.Receipt.TxHash.String(), nil
 }
 
+// Fraction of the estimated GasLimit added as headroom against changes to state between estimating and sending
+const gasEstimateMarginDivisor = 4
+
+// Lower the GasLimit of tx to the lowest with which it succeeds against the current committed state plus a safety
+// margin. If no estimate can be made (for example because tx would fail) the existing GasLimit is kept.
+func estimateGas(tx *payload.CallTx, client *def.Client, logger *logging.Logger) {
+	estimate, err := client.EstimateGas(tx, logger)
+	if err != nil {
+		logger.InfoMsg("Could not estimate gas, using default", "gas", tx.GasLimit, "error", err)
+		return
+	}
+	logger.TraceMsg("Estimated gas", "gas_limit", estimate.GasLimit, "gas_used", estimate.GasUsed)
+	tx.GasLimit = gasLimitWithMargin(estimate.GasLimit, tx.GasLimit)
+}
+
+// Returns the estimated gas limit with a margin added, but no more than the default limit. A zero estimate (as given for
+// a tx that uses no gas) keeps the default.
+func gasLimitWithMargin(estimate, defaultLimit uint64) uint64 {
+	if estimate == 0 {
+		return defaultLimit
+	}
+	limit := estimate + estimate/gasEstimateMarginDivisor
+	if limit < estimate {
+		limit = math.MaxUint64
+	}
+	if defaultLimit > 0 && limit > defaultLimit {
+		return defaultLimit
+	}
+	return limit
+}
+
 func FirstOf(inputs ...string) string {
 	for _, in := range inputs {
 		if in != "" {
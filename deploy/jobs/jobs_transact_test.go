@@ -0,0 +1,17 @@
+package jobs
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGasLimitWithMargin(t *testing.T) {
+	assert.Equal(t, uint64(1250), gasLimitWithMargin(1000, 1100000))
+	// Never more than the default
+	assert.Equal(t, uint64(1100), gasLimitWithMargin(1000, 1100))
+	// A zero estimate keeps the default rather than sending with no gas
+	assert.Equal(t, uint64(1100000), gasLimitWithMargin(0, 1100000))
+	assert.Equal(t, uint64(math.MaxUint64), gasLimitWithMargin(math.MaxUint64-1, math.MaxUint64))
+}
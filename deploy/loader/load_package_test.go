@@ -35,6 +35,24 @@ func TestUnmarshal(t *testing.T) {
     target: bar
     permissions: [foo, bar]
     roles: ["foo"]
+`)
+	testUnmarshal(t, `jobs:
+
+- name: environments
+  if: $env == production && !$skip
+  foreach:
+    items: [staging, 2]
+    as: environment
+  set:
+    val: $environment
+
+- name: counted
+  depends-on: [environments]
+  foreach:
+    range: 1..$count
+  query-account:
+    account: $item
+    field: balance
 `)
 }
 
@@ -83,55 +83,97 @@ func PreProcess(toProcess string, do *def.DeployArgs, script *def.Playbook, clie
 			continue
 		}
 
-		// second we loop through the jobNames to do a result replace
-		var loopJobs func(script *def.Playbook) error
-
-		loopJobs = func(script *def.Playbook) error {
-			if script.Parent != nil {
-				err := loopJobs(script.Parent)
-				if err != nil {
-					return err
+		// second we look for the job in our own playbook and then in those of our parents, so the innermost job of a
+		// name (for instance the item of a foreach) takes precedence
+		replaced := false
+		for playbook := script; playbook != nil && !replaced; playbook = playbook.Parent {
+			for _, job := range playbook.Jobs {
+				if pm.JobName != job.Name {
+					continue
 				}
-			}
-
-			for _, job := range script.Jobs {
-				if pm.JobName == job.Name {
-					if pm.VariableName != "" {
-						for _, variable := range job.Variables {
-							if variable.Name == pm.VariableName { //find the value we want from the bunch
-								toProcess = strings.Replace(toProcess, pm.Match, variable.Value, 1)
-								logger.TraceMsg("Fixing Inner Vars",
-									"job", pm.JobName,
-									"varName", pm.VariableName,
-									"result", variable.Value)
-							}
+				if pm.VariableName != "" {
+					for _, variable := range job.Variables {
+						if variable.Name == pm.VariableName { //find the value we want from the bunch
+							toProcess = strings.Replace(toProcess, pm.Match, variable.Value, 1)
+							replaced = true
+							logger.TraceMsg("Fixing Inner Vars",
+								"job", pm.JobName,
+								"varName", pm.VariableName,
+								"result", variable.Value)
+							break
 						}
-					} else {
-						// If result is returned as string assume that rendering otherwise marshal to JSON
-						result, ok := job.Result.(string)
-						if !ok {
-							bs, err := json.Marshal(job.Result)
-							if err != nil {
-								return fmt.Errorf("error marhsalling tx result in post processing: %v", err)
-							}
-							result = string(bs)
+					}
+				} else {
+					// If result is returned as string assume that rendering otherwise marshal to JSON
+					result, ok := job.Result.(string)
+					if !ok {
+						bs, err := json.Marshal(job.Result)
+						if err != nil {
+							return "", fmt.Errorf("error marhsalling tx result in post processing: %v", err)
 						}
-						logger.TraceMsg("Fixing Variables",
-							"var", string(pm.JobName),
-							"res", result)
-						toProcess = strings.Replace(toProcess, pm.Match, result, 1)
+						result = string(bs)
 					}
+					logger.TraceMsg("Fixing Variables",
+						"var", string(pm.JobName),
+						"res", result)
+					toProcess = strings.Replace(toProcess, pm.Match, result, 1)
+					replaced = true
+				}
+				if replaced {
+					break
 				}
 			}
-			return nil
 		}
+	}
+	return toProcess, nil
+}
+
+// PreProcessCondition evaluates the condition of a job substituting variables into each of its operands
+func PreProcessCondition(condition string, do *def.DeployArgs, script *def.Playbook, client *def.Client, logger *logging.Logger) (bool, error) {
+	parsed, err := def.ParseCondition(condition)
+	if err != nil {
+		return false, err
+	}
+	return parsed.Evaluate(func(operand string) (string, error) {
+		return PreProcess(operand, do, script, client, logger)
+	})
+}
 
-		err := loopJobs(script)
+// PreProcessForeach returns the items over which a job is to be run substituting variables into each of the items or
+// the bounds of the range
+func PreProcessForeach(foreach *def.Foreach, do *def.DeployArgs, script *def.Playbook, client *def.Client, logger *logging.Logger) ([]string, error) {
+	if foreach.Range == "" {
+		items := make([]string, len(foreach.Items))
+		for i, item := range foreach.Items {
+			str, err := PreProcess(item, do, script, client, logger)
+			if err != nil {
+				return nil, err
+			}
+			items[i] = str
+		}
+		return items, nil
+	}
+
+	match := def.ForeachRangeRegex.FindStringSubmatch(foreach.Range)
+	if match == nil {
+		return nil, fmt.Errorf("range '%s' is not of the form from..to", foreach.Range)
+	}
+	bounds := make([]int64, 2)
+	for i, bound := range match[1:] {
+		str, err := PreProcess(bound, do, script, client, logger)
 		if err != nil {
-			return "", err
+			return nil, err
+		}
+		bounds[i], err = strconv.ParseInt(str, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("bound '%s' of range '%s' is not an integer", str, foreach.Range)
 		}
 	}
-	return toProcess, nil
+	var items []string
+	for i := bounds[0]; i <= bounds[1]; i++ {
+		items = append(items, strconv.FormatInt(i, 10))
+	}
+	return items, nil
 }
 
 func replaceBlockVariable(toReplace string, client *def.Client, logger *logging.Logger) (string, error) {
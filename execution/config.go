@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/hyperledger/burrow/execution/evm"
+	"github.com/hyperledger/burrow/execution/state"
 )
 
 type VMOption string
@@ -23,6 +24,8 @@ type ExecutionConfig struct {
 	DataStackInitialCapacity uint64
 	DataStackMaxDepth        uint64
 	VMOptions                []VMOption `json:",omitempty" toml:",omitempty"`
+	// Which versions of state (one per block) to retain for historical queries, by default all versions are retained
+	Pruning *state.PruningConfig `json:",omitempty" toml:",omitempty"`
 }
 
 func DefaultExecutionConfig() *ExecutionConfig {
@@ -56,6 +59,10 @@ func (ec *ExecutionConfig) ExecutionOptions() ([]ExecutionOption, error) {
 		}
 	}
 	vmOptions = append(vmOptions, evm.StackOptions(ec.CallStackMaxDepth, ec.DataStackInitialCapacity, ec.DataStackMaxDepth))
+	err := ec.Pruning.Validate()
+	if err != nil {
+		return nil, err
+	}
 	exeOptions = append(exeOptions, VMOptions(vmOptions...))
 	return exeOptions, nil
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/hyperledger/burrow/acm/acmstate"
 	"github.com/hyperledger/burrow/acm/validator"
 	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/slashing"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/txs/payload"
 )
@@ -14,8 +15,11 @@ import (
 type BondContext struct {
 	StateWriter  acmstate.ReaderWriter
 	ValidatorSet validator.ReaderWriter
-	Logger       *logging.Logger
-	tx           *payload.BondTx
+	Slashing     slashing.Reader
+	// Optional chain policy on the validator set
+	Policy validator.Checker
+	Logger *logging.Logger
+	tx     *payload.BondTx
 }
 
 // Execute a BondTx to add or remove a new validator
@@ -38,6 +42,14 @@ func (ctx *BondContext) Execute(txe *exec.TxExecution, p payload.Payload) error
 		return fmt.Errorf("account '%s' lacks bond permission", account.Address)
 	}
 
+	// jailed validators must serve their time
+	jail, err := ctx.Slashing.GetJail(account.Address)
+	if err != nil {
+		return err
+	} else if jail != nil {
+		return fmt.Errorf("validator %s is jailed until height %d", account.Address, jail.Until)
+	}
+
 	// check account has enough to bond
 	amount := ctx.tx.Input.GetAmount()
 	if amount == 0 {
@@ -47,6 +59,12 @@ func (ctx *BondContext) Execute(txe *exec.TxExecution, p payload.Payload) error
 			"we are deducting %v", account.Address, account.Balance, amount)
 	}
 
+	// does the chain's policy allow the validator this power?
+	err = checkAddPower(ctx.Policy, ctx.ValidatorSet, account.Address, power)
+	if err != nil {
+		return err
+	}
+
 	// we're good to go
 	err = account.SubtractFromBalance(amount)
 	if err != nil {
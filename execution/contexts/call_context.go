@@ -24,6 +24,9 @@ type CallContext struct {
 	RunCall     bool
 	Blockchain  Blockchain
 	VMOptions   []func(*evm.VM)
+	GasSchedule *evm.GasSchedule
+	Hardfork    evm.Hardfork
+	Fees        *Fees
 	Logger      *logging.Logger
 	tx          *payload.CallTx
 	txe         *exec.TxExecution
@@ -107,6 +110,7 @@ func (ctx *CallContext) Precheck() (*acm.Account, *acm.Account, error) {
 	if err != nil {
 		return nil, nil, err
 	}
+	ctx.Fees.Collect(ctx.tx.Fee)
 	return inAcc, outAcc, nil
 }
 
@@ -135,9 +139,12 @@ func (ctx *CallContext) Deliver(inAcc, outAcc *acm.Account, value uint64) error
 		ret     []byte         = nil
 		txCache                = evm.NewState(ctx.StateWriter, ctx.Blockchain.BlockHash, acmstate.Named("TxCache"))
 		params                 = evm.Params{
+			ChainID:     ctx.txe.Envelope.Tx.ChainID,
 			BlockHeight: ctx.Blockchain.LastBlockHeight() + 1,
 			BlockTime:   ctx.Blockchain.LastBlockTime().Unix(),
 			GasLimit:    GasLimit,
+			GasSchedule: ctx.GasSchedule,
+			Hardfork:    ctx.Hardfork,
 		}
 	)
 
@@ -210,7 +217,8 @@ func (ctx *CallContext) Deliver(inAcc, outAcc *acm.Account, value uint64) error
 		if createContract {
 			txCache.InitWASMCode(callee, wcode)
 		}
-		ret, exception = wasm.RunWASM(txCache, callee, createContract, wcode, ctx.tx.Data)
+		vmach := wasm.NewVM(params, caller, txHash, logger, ctx.VMOptions...)
+		ret, exception = vmach.Call(txCache, ctx.txe, caller, callee, wcode, ctx.tx.Data, value, &gas, createContract)
 		if exception != nil {
 			// Failure. Charge the gas fee. The 'value' was otherwise not transferred.
 			ctx.Logger.InfoMsg("Error on WASM execution",
@@ -225,6 +233,7 @@ func (ctx *CallContext) Deliver(inAcc, outAcc *acm.Account, value uint64) error
 				return err
 			}
 		}
+		ctx.CallEvents(exception)
 		ctx.txe.Return(ret, ctx.tx.GasLimit-gas)
 	} else {
 		// EVM
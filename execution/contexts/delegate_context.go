@@ -0,0 +1,109 @@
+package contexts
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/acm/validator"
+	"github.com/hyperledger/burrow/execution/delegation"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/slashing"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/txs/payload"
+)
+
+type DelegateContext struct {
+	StateWriter  acmstate.ReaderWriter
+	ValidatorSet validator.IterableReaderWriter
+	Slashing     slashing.Reader
+	Delegations  delegation.ReaderWriter
+	// Optional chain policy on the validator set
+	Policy validator.Checker
+	Logger *logging.Logger
+	tx     *payload.DelegateTx
+}
+
+// Execute a DelegateTx to add the delegator's stake to the power of an existing validator
+func (ctx *DelegateContext) Execute(txe *exec.TxExecution, p payload.Payload) error {
+	var ok bool
+	ctx.tx, ok = p.(*payload.DelegateTx)
+	if !ok {
+		return fmt.Errorf("payload must be DelegateTx, but is: %v", txe.Envelope.Tx.Payload)
+	}
+
+	// the account delegating stake
+	account, err := ctx.StateWriter.GetAccount(ctx.tx.Input.Address)
+	if err != nil {
+		return err
+	}
+	if account == nil {
+		return errors.ErrorCodef(errors.ErrorCodeInvalidAddress, "Cannot find input account: %v", ctx.tx.Input)
+	}
+
+	// can the account bond?
+	if !hasBondPermission(ctx.StateWriter, account, ctx.Logger) {
+		return fmt.Errorf("account '%s' lacks bond permission", account.Address)
+	}
+
+	// stake may not be delegated to a jailed validator since it has no power to add to
+	jail, err := ctx.Slashing.GetJail(ctx.tx.Validator)
+	if err != nil {
+		return err
+	} else if jail != nil {
+		return fmt.Errorf("validator %s is jailed until height %d", ctx.tx.Validator, jail.Until)
+	}
+
+	publicKey, _, err := findValidator(ctx.ValidatorSet, ctx.tx.Validator)
+	if err != nil {
+		return err
+	} else if publicKey == nil {
+		return fmt.Errorf("cannot delegate to %s since it is not a validator", ctx.tx.Validator)
+	}
+
+	// check account has enough to delegate
+	amount := ctx.tx.Input.GetAmount()
+	if amount == 0 {
+		return fmt.Errorf("nothing to delegate")
+	} else if account.Balance < amount {
+		return fmt.Errorf("insufficient funds, account %s only has balance %v and "+
+			"we are deducting %v", account.Address, account.Balance, amount)
+	}
+
+	// does the chain's policy allow the validator this power?
+	power := new(big.Int).SetUint64(amount)
+	err = checkAddPower(ctx.Policy, ctx.ValidatorSet, ctx.tx.Validator, power)
+	if err != nil {
+		return err
+	}
+
+	// we're good to go
+	err = account.SubtractFromBalance(amount)
+	if err != nil {
+		return err
+	}
+
+	err = validator.AddPower(ctx.ValidatorSet, *publicKey, power)
+	if err != nil {
+		return err
+	}
+
+	d, err := ctx.Delegations.GetDelegation(ctx.tx.Validator, account.Address)
+	if err != nil {
+		return err
+	}
+	if d == nil {
+		d = &delegation.Delegation{
+			Validator: ctx.tx.Validator,
+			Delegator: account.Address,
+		}
+	}
+	d.Amount += amount
+	err = ctx.Delegations.UpdateDelegation(d)
+	if err != nil {
+		return err
+	}
+
+	return ctx.StateWriter.UpdateAccount(account)
+}
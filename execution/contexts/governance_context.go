@@ -19,9 +19,11 @@ import (
 type GovernanceContext struct {
 	StateWriter  acmstate.ReaderWriter
 	ValidatorSet validator.ReaderWriter
-	Logger       *logging.Logger
-	tx           *payload.GovTx
-	txe          *exec.TxExecution
+	// Optional chain policy on the validator set
+	Policy validator.Checker
+	Logger *logging.Logger
+	tx     *payload.GovTx
+	txe    *exec.TxExecution
 }
 
 // GovTx provides a set of TemplateAccounts and GovernanceContext tries to alter the chain state to match the
@@ -82,6 +84,12 @@ func (ctx *GovernanceContext) UpdateAccount(account *acm.Account, update *spec.T
 			return
 		}
 		power := new(big.Int).SetUint64(update.Balances().GetPower(0))
+		if ctx.Policy != nil {
+			err = ctx.Policy.CheckPower(update.PublicKey.GetAddress(), power)
+			if err != nil {
+				return ev, err
+			}
+		}
 		_, err := ctx.ValidatorSet.SetPower(*update.PublicKey, power)
 		if err != nil {
 			return ev, err
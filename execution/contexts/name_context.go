@@ -22,8 +22,10 @@ type NameContext struct {
 	Blockchain  BlockchainHeight
 	StateWriter acmstate.ReaderWriter
 	NameReg     names.ReaderWriter
-	Logger      *logging.Logger
-	tx          *payload.NameTx
+	// Collects the fee for payout to the validators, nil if fees are not paid out in which case no fee is charged
+	Fees   *Fees
+	Logger *logging.Logger
+	tx     *payload.NameTx
 }
 
 func (ctx *NameContext) Execute(txe *exec.TxExecution, p payload.Payload) error {
@@ -165,7 +167,13 @@ func (ctx *NameContext) Execute(txe *exec.TxExecution, p payload.Payload) error
 		"old_sequence", inAcc.Sequence,
 		"new_sequence", inAcc.Sequence+1)
 
-	err = inAcc.SubtractFromBalance(value)
+	// The fee is only charged when it is paid out to the validators so as not to change the balances of chains that
+	// predate the payout
+	charged := value
+	if ctx.Fees != nil {
+		charged += ctx.tx.Fee
+	}
+	err = inAcc.SubtractFromBalance(charged)
 	if err != nil {
 		return errors.ErrorCodef(errors.ErrorCodeInsufficientFunds,
 			"Input account does not have sufficient balance to cover input amount: %v", ctx.tx.Input)
@@ -174,6 +182,7 @@ func (ctx *NameContext) Execute(txe *exec.TxExecution, p payload.Payload) error
 	if err != nil {
 		return err
 	}
+	ctx.Fees.Collect(ctx.tx.Fee)
 
 	// TODO: maybe we want to take funds on error and allow txs in that don't do anythingi?
 
@@ -0,0 +1,142 @@
+package contexts
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/acm/validator"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/logging"
+)
+
+// Fees accumulates the fees charged by transactions in a block so they can be paid out to validators
+type Fees struct {
+	collected uint64
+}
+
+// Collect adds a fee charged by a transaction, a nil Fees discards it
+func (fees *Fees) Collect(amount uint64) {
+	if fees != nil {
+		fees.collected += amount
+	}
+}
+
+func (fees *Fees) Collected() uint64 {
+	if fees == nil {
+		return 0
+	}
+	return fees.collected
+}
+
+func (fees *Fees) Reset() {
+	if fees != nil {
+		fees.collected = 0
+	}
+}
+
+// PayoutContext pays the fees collected during each block, plus any block reward, to the validators in proportion to
+// their power at the end of the block. Unlike the other contexts it does not execute a transaction.
+type PayoutContext struct {
+	StateWriter  acmstate.ReaderWriter
+	ValidatorSet validator.Iterable
+	Fees         *Fees
+	// Native token minted at the end of every block for the validators
+	BlockReward uint64
+	Logger      *logging.Logger
+}
+
+type payee struct {
+	publicKey crypto.PublicKey
+	power     *big.Int
+}
+
+// Execute credits each validator with its share of the fees and block reward recording an event for each payout
+// against the block. Any remainder from rounding down the shares goes to the most powerful validator.
+func (ctx *PayoutContext) Execute(be *exec.BlockExecution) error {
+	fees := ctx.Fees.Collected()
+	ctx.Fees.Reset()
+	if fees == 0 && ctx.BlockReward == 0 {
+		return nil
+	}
+	var payees []payee
+	totalPower := new(big.Int)
+	err := ctx.ValidatorSet.IterateValidators(func(id crypto.Addressable, power *big.Int) error {
+		if power.Sign() > 0 {
+			payees = append(payees, payee{publicKey: id.GetPublicKey(), power: new(big.Int).Set(power)})
+			totalPower.Add(totalPower, power)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if len(payees) == 0 {
+		ctx.Logger.InfoMsg("No validators to pay out fees to", "fees", fees)
+		return nil
+	}
+	// Most powerful first, then by address so the remainder is paid deterministically
+	sort.SliceStable(payees, func(i, j int) bool {
+		if cmp := payees[i].power.Cmp(payees[j].power); cmp != 0 {
+			return cmp > 0
+		}
+		addressI, addressJ := payees[i].publicKey.GetAddress(), payees[j].publicKey.GetAddress()
+		return bytes.Compare(addressI[:], addressJ[:]) < 0
+	})
+	payouts := make([]*exec.PayoutEvent, len(payees))
+	var feesPaid, rewardPaid uint64
+	for i, p := range payees {
+		payouts[i] = &exec.PayoutEvent{
+			Address: p.publicKey.GetAddress(),
+			Power:   p.power.Uint64(),
+			Fees:    share(fees, p.power, totalPower),
+			Reward:  share(ctx.BlockReward, p.power, totalPower),
+		}
+		feesPaid += payouts[i].Fees
+		rewardPaid += payouts[i].Reward
+	}
+	payouts[0].Fees += fees - feesPaid
+	payouts[0].Reward += ctx.BlockReward - rewardPaid
+
+	for i, payout := range payouts {
+		amount := payout.Fees + payout.Reward
+		if amount == 0 {
+			continue
+		}
+		account, err := ctx.StateWriter.GetAccount(payout.Address)
+		if err != nil {
+			return err
+		}
+		if account == nil {
+			account = &acm.Account{
+				Address:   payout.Address,
+				PublicKey: payees[i].publicKey,
+			}
+		}
+		err = account.AddToBalance(amount)
+		if err != nil {
+			return err
+		}
+		err = ctx.StateWriter.UpdateAccount(account)
+		if err != nil {
+			return err
+		}
+		ctx.Logger.InfoMsg("Paid validator",
+			"validator", payout.Address,
+			"power", payout.Power,
+			"fees", payout.Fees,
+			"reward", payout.Reward)
+		be.Payout(payout)
+	}
+	return nil
+}
+
+// Returns amount * power / totalPower rounded down
+func share(amount uint64, power, totalPower *big.Int) uint64 {
+	s := new(big.Int).SetUint64(amount)
+	s.Mul(s, power)
+	return s.Quo(s, totalPower).Uint64()
+}
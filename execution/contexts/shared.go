@@ -2,9 +2,11 @@ package contexts
 
 import (
 	"fmt"
+	"math/big"
 
 	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/acm/validator"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/execution/exec"
@@ -230,3 +232,46 @@ func hasBondOrSendPermission(accountGetter acmstate.AccountGetter, accs map[cryp
 	}
 	return true
 }
+
+// Returns the public key and current power of the validator with address or a nil public key if there is none
+func findValidator(validators validator.Iterable, address crypto.Address) (*crypto.PublicKey, uint64, error) {
+	var publicKey *crypto.PublicKey
+	var power uint64
+	err := validators.IterateValidators(func(id crypto.Addressable, p *big.Int) error {
+		if id.GetAddress() == address {
+			pk := id.GetPublicKey()
+			publicKey = &pk
+			power = p.Uint64()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return publicKey, power, nil
+}
+
+// Checks the power a validator would have after adding amount to its current power against policy, if there is one
+func checkAddPower(policy validator.Checker, validators validator.Reader, id crypto.Address, amount *big.Int) error {
+	if policy == nil {
+		return nil
+	}
+	power, err := validators.Power(id)
+	if err != nil {
+		return err
+	}
+	return policy.CheckPower(id, new(big.Int).Add(power, amount))
+}
+
+// Checks the power a validator would have after subtracting amount from its current power against policy, if there is
+// one. A validator may always leave the set entirely but any power it keeps must still satisfy the policy.
+func checkSubtractPower(policy validator.Checker, validators validator.Reader, id crypto.Address, amount *big.Int) error {
+	if policy == nil {
+		return nil
+	}
+	power, err := validators.Power(id)
+	if err != nil {
+		return err
+	}
+	return policy.CheckPower(id, new(big.Int).Sub(power, amount))
+}
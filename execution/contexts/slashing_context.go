@@ -0,0 +1,313 @@
+package contexts
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/acm/validator"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/delegation"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/slashing"
+	"github.com/hyperledger/burrow/logging"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+)
+
+// SlashingContext punishes validators that Tendermint has found evidence of misbehaviour against at the beginning of
+// each block. Unlike the other contexts it does not execute a transaction.
+type SlashingContext struct {
+	StateWriter  acmstate.ReaderWriter
+	ValidatorSet validator.IterableReaderWriter
+	Policy       validator.Checker
+	Slashing     slashing.IterableReaderWriter
+	Delegations  delegation.IterableReaderWriter
+	Params       slashing.Params
+	Logger       *logging.Logger
+}
+
+// Execute releases any validators whose jail time is up and any unbonded stake whose unbonding period is over, then
+// slashes the validators named in evidence recording an event for each against the block
+func (ctx *SlashingContext) Execute(be *exec.BlockExecution, evidence []abciTypes.Evidence) error {
+	err := ctx.release(be.Height)
+	if err != nil {
+		return err
+	}
+	err = ctx.releaseUnbondings(be.Height)
+	if err != nil {
+		return err
+	}
+	if !ctx.Params.Enabled() {
+		return nil
+	}
+	for _, ev := range evidence {
+		err = ctx.slash(be, ev)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Releases validators whose jail time is up and retries removing any jailed validator whose power could not be changed
+// when it was slashed
+func (ctx *SlashingContext) release(height uint64) error {
+	var released, jailed []*slashing.Jail
+	err := ctx.Slashing.IterateJails(func(jail *slashing.Jail) error {
+		if jail.Until <= height {
+			released = append(released, jail)
+			return nil
+		}
+		_, power, err := findValidator(ctx.ValidatorSet, jail.GetAddress())
+		if err != nil {
+			return err
+		}
+		if power > 0 {
+			jailed = append(jailed, jail)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, jail := range jailed {
+		// The validator was slashed when its power could not be changed so try to remove it again
+		_, err = ctx.ValidatorSet.SetPower(jail.PublicKey, new(big.Int))
+		if err != nil {
+			ctx.Logger.InfoMsg("Could not remove jailed validator",
+				"validator", jail.GetAddress(),
+				"error", err)
+		}
+	}
+	for _, jail := range released {
+		err = ctx.restore(jail)
+		if err != nil {
+			// Most likely restoring the power would exceed the maximum flow or the validator set is full so leave them
+			// in jail and try again next block
+			ctx.Logger.InfoMsg("Could not release validator from jail",
+				"validator", jail.GetAddress(),
+				"error", err)
+			continue
+		}
+		ctx.Logger.InfoMsg("Released validator from jail",
+			"validator", jail.GetAddress(),
+			"power", jail.Power)
+		err = ctx.Slashing.RemoveJail(jail.GetAddress())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Restores the power held for a jailed validator provided the validator set's policy allows it
+func (ctx *SlashingContext) restore(jail *slashing.Jail) error {
+	power := new(big.Int).SetUint64(jail.Power)
+	if ctx.Policy != nil {
+		err := ctx.Policy.CheckPower(jail.GetAddress(), power)
+		if err != nil {
+			return err
+		}
+	}
+	_, err := ctx.ValidatorSet.SetPower(jail.PublicKey, power)
+	return err
+}
+
+// Credits unbonded stake to the validator's or delegator's account once the unbonding period is over
+func (ctx *SlashingContext) releaseUnbondings(height uint64) error {
+	var released []*slashing.Unbonding
+	err := ctx.Slashing.IterateUnbondings(func(unbonding *slashing.Unbonding) error {
+		if unbonding.ReleaseHeight <= height {
+			released = append(released, unbonding)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, unbonding := range released {
+		account, err := ctx.StateWriter.GetAccount(unbonding.Address)
+		if err != nil {
+			return err
+		}
+		if account == nil {
+			return fmt.Errorf("could not find account %v to release unbonded stake to", unbonding.Address)
+		}
+		err = account.AddToBalance(unbonding.Amount)
+		if err != nil {
+			return err
+		}
+		err = ctx.StateWriter.UpdateAccount(account)
+		if err != nil {
+			return err
+		}
+		ctx.Logger.InfoMsg("Released unbonded stake",
+			"validator", unbonding.Validator,
+			"address", unbonding.Address,
+			"amount", unbonding.Amount)
+		err = ctx.Slashing.RemoveUnbonding(unbonding.ReleaseHeight, unbonding.Validator, unbonding.Address)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ctx *SlashingContext) slash(be *exec.BlockExecution, evidence abciTypes.Evidence) error {
+	address, err := crypto.AddressFromBytes(evidence.Validator.Address)
+	if err != nil {
+		return fmt.Errorf("could not read address of validator in evidence %v: %v", evidence, err)
+	}
+	slash := &slashing.Slash{
+		Address:        address,
+		EvidenceType:   evidence.Type,
+		EvidenceHeight: uint64(evidence.Height),
+		Height:         be.Height,
+	}
+
+	jail, err := ctx.Slashing.GetJail(address)
+	if err != nil {
+		return err
+	}
+	if jail != nil {
+		// The validator already has no power so slash the power held for them and extend their sentence
+		delegations, err := ctx.slashDelegations(slash, jail.Power)
+		if err != nil {
+			return err
+		}
+		slash.Power = jail.Power - slash.Slashed
+		jail.Power = slash.Power
+		jail.Until = be.Height + ctx.Params.JailBlocks
+		slash.JailedUntil = jail.Until
+		return ctx.record(be, slash, jail, delegations)
+	}
+
+	publicKey, power, err := findValidator(ctx.ValidatorSet, address)
+	if err != nil {
+		return err
+	}
+	if publicKey == nil {
+		// The validator has left the set but may have stake still unbonding
+		return ctx.record(be, slash, nil, nil)
+	}
+	delegations, err := ctx.slashDelegations(slash, power)
+	if err != nil {
+		return err
+	}
+	slash.Power = power - slash.Slashed
+	newPower := slash.Power
+	if ctx.Params.JailBlocks > 0 {
+		jail = &slashing.Jail{
+			PublicKey: *publicKey,
+			Power:     slash.Power,
+			Until:     be.Height + ctx.Params.JailBlocks,
+		}
+		slash.JailedUntil = jail.Until
+		newPower = 0
+	}
+	_, err = ctx.ValidatorSet.SetPower(*publicKey, new(big.Int).SetUint64(newPower))
+	if err != nil {
+		// Most likely we would exceed the maximum flow or the minimum total power so the validator's power cannot
+		// change this block. Its stake is slashed anyway and it is held in jail until release can update its power.
+		ctx.Logger.InfoMsg("Could not update power of slashed validator",
+			"validator", address,
+			"error", err)
+		if jail == nil {
+			jail = &slashing.Jail{
+				PublicKey: *publicKey,
+				Power:     slash.Power,
+				Until:     be.Height,
+			}
+			slash.JailedUntil = jail.Until
+		}
+	}
+	return ctx.record(be, slash, jail, delegations)
+}
+
+// Slashes the validator's own stake and each delegation to it in proportion, so the total power slashed is accumulated
+// into slash and the delegations returned are to be written once the validator's power has been updated
+func (ctx *SlashingContext) slashDelegations(slash *slashing.Slash, power uint64) ([]*delegation.Delegation, error) {
+	delegations, err := delegation.Delegations(ctx.Delegations, slash.Address)
+	if err != nil {
+		return nil, err
+	}
+	selfBond := power
+	for i, d := range delegations {
+		// Copy so as not to modify the cached delegation before the slash succeeds
+		d = &delegation.Delegation{Validator: d.Validator, Delegator: d.Delegator, Amount: d.Amount}
+		delegations[i] = d
+		if d.Amount > selfBond {
+			selfBond = 0
+		} else {
+			selfBond -= d.Amount
+		}
+		slashed := ctx.Params.Slashed(d.Amount)
+		d.Amount -= slashed
+		slash.Slashed += slashed
+	}
+	slash.Slashed += ctx.Params.Slashed(selfBond)
+	if slash.Slashed > power {
+		slash.Slashed = power
+	}
+	return delegations, nil
+}
+
+// Slashes any stake unbonded or undelegated from the validator since it misbehaved then records the slash along with
+// the slashed delegations
+func (ctx *SlashingContext) record(be *exec.BlockExecution, slash *slashing.Slash, jail *slashing.Jail,
+	delegations []*delegation.Delegation) error {
+	for _, d := range delegations {
+		var err error
+		if d.Amount == 0 {
+			err = ctx.Delegations.RemoveDelegation(d.Validator, d.Delegator)
+		} else {
+			err = ctx.Delegations.UpdateDelegation(d)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	var unbondings []*slashing.Unbonding
+	err := ctx.Slashing.IterateUnbondings(func(unbonding *slashing.Unbonding) error {
+		if unbonding.Validator == slash.Address && unbonding.Height >= slash.EvidenceHeight {
+			unbondings = append(unbondings, unbonding)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, unbonding := range unbondings {
+		slashed := ctx.Params.Slashed(unbonding.Amount)
+		unbonding.Amount -= slashed
+		slash.UnbondingSlashed += slashed
+		err = ctx.Slashing.UpdateUnbonding(unbonding)
+		if err != nil {
+			return err
+		}
+	}
+	if jail == nil && slash.Slashed == 0 && slash.UnbondingSlashed == 0 && slash.Power == 0 {
+		ctx.Logger.InfoMsg("Ignoring evidence against address with no stake",
+			"validator", slash.Address,
+			"evidence_height", slash.EvidenceHeight)
+		return nil
+	}
+	ctx.Logger.InfoMsg("Slashed validator",
+		"validator", slash.Address,
+		"slashed", slash.Slashed,
+		"unbonding_slashed", slash.UnbondingSlashed,
+		"power", slash.Power,
+		"jailed_until", slash.JailedUntil)
+	err = ctx.Slashing.AddSlash(slash)
+	if err != nil {
+		return err
+	}
+	if jail != nil {
+		err = ctx.Slashing.UpdateJail(jail)
+		if err != nil {
+			return err
+		}
+	}
+	be.Slash(slash, nil)
+	return nil
+}
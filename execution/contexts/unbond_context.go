@@ -6,19 +6,28 @@ import (
 
 	"github.com/hyperledger/burrow/acm/acmstate"
 	"github.com/hyperledger/burrow/acm/validator"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/delegation"
 	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/slashing"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/txs/payload"
 )
 
 type UnbondContext struct {
+	Blockchain   BlockchainHeight
 	StateWriter  acmstate.ReaderWriter
 	ValidatorSet validator.ReaderWriter
-	Logger       *logging.Logger
-	tx           *payload.UnbondTx
+	Policy       validator.Checker
+	Slashing     slashing.ReaderWriter
+	Delegations  delegation.Iterable
+	// The number of blocks for which unbonded stake is held before it is credited to the validator
+	UnbondingBlocks uint64
+	Logger          *logging.Logger
+	tx              *payload.UnbondTx
 }
 
-// Execute an UnbondTx to remove a validator
+// Execute an UnbondTx to remove a validator, its stake is queued for release at the end of the unbonding period
 func (ctx *UnbondContext) Execute(txe *exec.TxExecution, p payload.Payload) error {
 	var ok bool
 	ctx.tx, ok = p.(*payload.UnbondTx)
@@ -36,15 +45,69 @@ func (ctx *UnbondContext) Execute(txe *exec.TxExecution, p payload.Payload) erro
 		return err
 	}
 
-	err = account.AddToBalance(power.Uint64())
+	// a validator may only unbond its own stake, delegators must undelegate theirs
+	currentPower, err := ctx.ValidatorSet.Power(account.Address)
 	if err != nil {
 		return err
 	}
+	delegated, err := delegation.Delegated(ctx.Delegations, account.Address)
+	if err != nil {
+		return err
+	}
+	selfBond := new(big.Int).Sub(currentPower, new(big.Int).SetUint64(delegated))
+	if selfBond.Cmp(power) < 0 {
+		return fmt.Errorf("validator %s cannot unbond %v since it has only bonded %v of its own stake",
+			account.Address, power, selfBond)
+	}
+
+	err = checkSubtractPower(ctx.Policy, ctx.ValidatorSet, account.Address, power)
+	if err != nil {
+		return err
+	}
+
+	if ctx.UnbondingBlocks == 0 {
+		err = account.AddToBalance(power.Uint64())
+		if err != nil {
+			return err
+		}
+	}
 
 	err = validator.SubtractPower(ctx.ValidatorSet, account.PublicKey, power)
 	if err != nil {
 		return err
 	}
 
+	if ctx.UnbondingBlocks > 0 {
+		return queueUnbonding(ctx.Slashing, ctx.Blockchain, ctx.UnbondingBlocks, account.Address, account.Address,
+			power.Uint64(), ctx.Logger)
+	}
+
 	return ctx.StateWriter.UpdateAccount(account)
 }
+
+// Holds stake unbonded from a validator until the end of the unbonding period so that it can still be slashed for
+// misbehaviour by the validator that comes to light after it was unbonded
+func queueUnbonding(unbondings slashing.ReaderWriter, blockchain BlockchainHeight, unbondingBlocks uint64,
+	validatorAddress, address crypto.Address, amount uint64, logger *logging.Logger) error {
+	height := blockchain.LastBlockHeight() + 1
+	releaseHeight := height + unbondingBlocks
+	unbonding, err := unbondings.GetUnbonding(releaseHeight, validatorAddress, address)
+	if err != nil {
+		return err
+	}
+	if unbonding == nil {
+		unbonding = &slashing.Unbonding{
+			Address:       address,
+			Validator:     validatorAddress,
+			Height:        height,
+			ReleaseHeight: releaseHeight,
+		}
+	}
+	unbonding.Amount += amount
+	logger.InfoMsg("Queued unbonded stake",
+		"validator", validatorAddress,
+		"address", address,
+		"amount", amount,
+		"release_height", releaseHeight)
+	return unbondings.UpdateUnbonding(unbonding)
+}
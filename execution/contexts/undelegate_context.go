@@ -0,0 +1,113 @@
+package contexts
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/acm/validator"
+	"github.com/hyperledger/burrow/execution/delegation"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/slashing"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/txs/payload"
+)
+
+type UndelegateContext struct {
+	Blockchain   BlockchainHeight
+	StateWriter  acmstate.ReaderWriter
+	ValidatorSet validator.IterableReaderWriter
+	Policy       validator.Checker
+	Slashing     slashing.ReaderWriter
+	Delegations  delegation.ReaderWriter
+	// The number of blocks for which undelegated stake is held before it is credited to the delegator
+	UnbondingBlocks uint64
+	Logger          *logging.Logger
+	tx              *payload.UndelegateTx
+}
+
+// Execute an UndelegateTx to withdraw stake delegated to a validator, like unbonded stake it is queued for release at
+// the end of the unbonding period
+func (ctx *UndelegateContext) Execute(txe *exec.TxExecution, p payload.Payload) error {
+	var ok bool
+	ctx.tx, ok = p.(*payload.UndelegateTx)
+	if !ok {
+		return fmt.Errorf("payload must be UndelegateTx, but is: %v", txe.Envelope.Tx.Payload)
+	}
+
+	account, err := ctx.StateWriter.GetAccount(ctx.tx.Input.Address)
+	if err != nil {
+		return err
+	}
+	if account == nil {
+		return errors.ErrorCodef(errors.ErrorCodeInvalidAddress, "Cannot find input account: %v", ctx.tx.Input)
+	}
+
+	amount := ctx.tx.Amount
+	if amount == 0 {
+		return fmt.Errorf("nothing to undelegate")
+	}
+	d, err := ctx.Delegations.GetDelegation(ctx.tx.Validator, account.Address)
+	if err != nil {
+		return err
+	}
+	if d == nil || d.Amount < amount {
+		var delegated uint64
+		if d != nil {
+			delegated = d.Amount
+		}
+		return fmt.Errorf("account %s cannot undelegate %v from %s since it has only delegated %v",
+			account.Address, amount, ctx.tx.Validator, delegated)
+	}
+
+	// the stake is held as the validator's power while it is jailed
+	jail, err := ctx.Slashing.GetJail(ctx.tx.Validator)
+	if err != nil {
+		return err
+	} else if jail != nil {
+		return fmt.Errorf("validator %s is jailed until height %d", ctx.tx.Validator, jail.Until)
+	}
+
+	// the stake is withdrawn from the validator's power unless it has since left the validator set, for example by
+	// governance setting its power to zero, in which case that power is already gone
+	publicKey, power, err := findValidator(ctx.ValidatorSet, ctx.tx.Validator)
+	if err != nil {
+		return err
+	}
+	if publicKey != nil {
+		withdrawn := new(big.Int).SetUint64(amount)
+		if power < amount {
+			withdrawn.SetUint64(power)
+		}
+		err = checkSubtractPower(ctx.Policy, ctx.ValidatorSet, ctx.tx.Validator, withdrawn)
+		if err != nil {
+			return err
+		}
+		err = validator.SubtractPower(ctx.ValidatorSet, *publicKey, withdrawn)
+		if err != nil {
+			return err
+		}
+	}
+
+	d.Amount -= amount
+	if d.Amount == 0 {
+		err = ctx.Delegations.RemoveDelegation(d.Validator, d.Delegator)
+	} else {
+		err = ctx.Delegations.UpdateDelegation(d)
+	}
+	if err != nil {
+		return err
+	}
+
+	if ctx.UnbondingBlocks > 0 {
+		return queueUnbonding(ctx.Slashing, ctx.Blockchain, ctx.UnbondingBlocks, ctx.tx.Validator, account.Address,
+			amount, ctx.Logger)
+	}
+
+	err = account.AddToBalance(amount)
+	if err != nil {
+		return err
+	}
+	return ctx.StateWriter.UpdateAccount(account)
+}
@@ -0,0 +1,176 @@
+package delegation
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+
+	"github.com/hyperledger/burrow/crypto"
+)
+
+// The Cache buffers the changes to delegations made during a block until they are synced to state
+type Cache struct {
+	sync.RWMutex
+	backend     IterableReader
+	delegations map[delegationKey]*delegationInfo
+}
+
+type delegationKey struct {
+	validator crypto.Address
+	delegator crypto.Address
+}
+
+type delegationInfo struct {
+	delegation *Delegation
+	removed    bool
+	updated    bool
+}
+
+var _ IterableReaderWriter = &Cache{}
+
+func NewCache(backend IterableReader) *Cache {
+	return &Cache{
+		backend:     backend,
+		delegations: make(map[delegationKey]*delegationInfo),
+	}
+}
+
+func (cache *Cache) GetDelegation(validator, delegator crypto.Address) (*Delegation, error) {
+	cache.Lock()
+	defer cache.Unlock()
+	info, err := cache.get(delegationKey{validator: validator, delegator: delegator})
+	if err != nil {
+		return nil, err
+	}
+	if info.removed {
+		return nil, nil
+	}
+	return info.delegation, nil
+}
+
+func (cache *Cache) UpdateDelegation(delegation *Delegation) error {
+	cache.Lock()
+	defer cache.Unlock()
+	info, err := cache.get(delegationKey{validator: delegation.Validator, delegator: delegation.Delegator})
+	if err != nil {
+		return err
+	}
+	info.delegation = delegation
+	info.removed = false
+	info.updated = true
+	return nil
+}
+
+func (cache *Cache) RemoveDelegation(validator, delegator crypto.Address) error {
+	cache.Lock()
+	defer cache.Unlock()
+	info, err := cache.get(delegationKey{validator: validator, delegator: delegator})
+	if err != nil {
+		return err
+	}
+	info.delegation = nil
+	info.removed = true
+	info.updated = false
+	return nil
+}
+
+// Iterates over the delegations in the backend as modified by the cache in order of validator then delegator
+func (cache *Cache) IterateDelegations(consumer func(*Delegation) error) error {
+	cache.RLock()
+	defer cache.RUnlock()
+	delegations := make(map[delegationKey]*Delegation)
+	err := cache.backend.IterateDelegations(func(delegation *Delegation) error {
+		delegations[delegationKey{validator: delegation.Validator, delegator: delegation.Delegator}] = delegation
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for key, info := range cache.delegations {
+		if info.removed {
+			delete(delegations, key)
+		} else if info.updated {
+			delegations[key] = info.delegation
+		}
+	}
+	keys := make([]delegationKey, 0, len(delegations))
+	for key := range delegations {
+		keys = append(keys, key)
+	}
+	sortKeys(keys)
+	for _, key := range keys {
+		err = consumer(delegations[key])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Writes whatever is in the cache to the output Writer state. Does not flush the cache, to do that call Reset()
+// after Sync or use Flush if you wish to use the output state as your next backend
+func (cache *Cache) Sync(state Writer) error {
+	cache.RLock()
+	defer cache.RUnlock()
+	keys := make([]delegationKey, 0, len(cache.delegations))
+	for key := range cache.delegations {
+		keys = append(keys, key)
+	}
+	sortKeys(keys)
+	for _, key := range keys {
+		info := cache.delegations[key]
+		if info.removed {
+			err := state.RemoveDelegation(key.validator, key.delegator)
+			if err != nil {
+				return err
+			}
+		} else if info.updated {
+			err := state.UpdateDelegation(info.delegation)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Resets the cache to empty
+func (cache *Cache) Reset(backend IterableReader) {
+	cache.Lock()
+	defer cache.Unlock()
+	cache.backend = backend
+	cache.delegations = make(map[delegationKey]*delegationInfo)
+}
+
+// Syncs the Cache and Resets it to use backend as the backend IterableReader
+func (cache *Cache) Flush(output Writer, backend IterableReader) error {
+	err := cache.Sync(output)
+	if err != nil {
+		return err
+	}
+	cache.Reset(backend)
+	return nil
+}
+
+// Must be called with the write lock held
+func (cache *Cache) get(key delegationKey) (*delegationInfo, error) {
+	info := cache.delegations[key]
+	if info == nil {
+		delegation, err := cache.backend.GetDelegation(key.validator, key.delegator)
+		if err != nil {
+			return nil, err
+		}
+		info = &delegationInfo{delegation: delegation}
+		cache.delegations[key] = info
+	}
+	return info, nil
+}
+
+func sortKeys(keys []delegationKey) {
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].validator != keys[j].validator {
+			return bytes.Compare(keys[i].validator[:], keys[j].validator[:]) < 0
+		}
+		return bytes.Compare(keys[i].delegator[:], keys[j].delegator[:]) < 0
+	})
+}
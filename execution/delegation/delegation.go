@@ -0,0 +1,74 @@
+package delegation
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/event/query"
+)
+
+func (d *Delegation) String() string {
+	return fmt.Sprintf("Delegation{%v delegates %d to %v}", d.Delegator, d.Amount, d.Validator)
+}
+
+func (d *Delegation) Get(key string) (value interface{}, ok bool) {
+	return query.GetReflect(reflect.ValueOf(d), key)
+}
+
+type Reader interface {
+	// Returns the stake delegator has delegated to validator or nil if there is none
+	GetDelegation(validator, delegator crypto.Address) (*Delegation, error)
+}
+
+type Writer interface {
+	// Adds or updates a delegation
+	UpdateDelegation(delegation *Delegation) error
+	// Removes a delegation
+	RemoveDelegation(validator, delegator crypto.Address) error
+}
+
+type ReaderWriter interface {
+	Reader
+	Writer
+}
+
+type Iterable interface {
+	// Iterates over delegations in order of validator then delegator
+	IterateDelegations(consumer func(*Delegation) error) error
+}
+
+type IterableReader interface {
+	Iterable
+	Reader
+}
+
+type IterableReaderWriter interface {
+	Iterable
+	ReaderWriter
+}
+
+// Delegations returns the delegations to validator
+func Delegations(delegations Iterable, validator crypto.Address) ([]*Delegation, error) {
+	var ds []*Delegation
+	err := delegations.IterateDelegations(func(delegation *Delegation) error {
+		if delegation.Validator == validator {
+			ds = append(ds, delegation)
+		}
+		return nil
+	})
+	return ds, err
+}
+
+// Delegated returns the total stake delegated to validator
+func Delegated(delegations Iterable, validator crypto.Address) (uint64, error) {
+	ds, err := Delegations(delegations, validator)
+	if err != nil {
+		return 0, err
+	}
+	var total uint64
+	for _, delegation := range ds {
+		total += delegation.Amount
+	}
+	return total, nil
+}
@@ -0,0 +1,175 @@
+package execution
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/delegation"
+	"github.com/hyperledger/burrow/execution/slashing"
+	"github.com/hyperledger/burrow/execution/state"
+	"github.com/hyperledger/burrow/genesis"
+	"github.com/hyperledger/burrow/governance"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/abci/types"
+)
+
+func TestDelegation(t *testing.T) {
+	exe, st := makeSlashingExecutor(t, func(genDoc *genesis.GenesisDoc) {
+		genDoc.Params.SlashFraction = "1/10"
+		genDoc.Params.UnbondingBlocks = 2
+	})
+	validator := users[1]
+	delegator := users[4]
+	balance := getAccount(st, delegator.GetAddress()).Balance
+	height := exe.block.Height
+	sign := func(tx payload.Payload, signer acm.AddressableSigner) *txs.Envelope {
+		txEnv := txs.Enclose(exe.params.ChainID, tx)
+		require.NoError(t, txEnv.Sign(signer))
+		return txEnv
+	}
+
+	// Delegate
+	require.NoError(t, exe.BeginBlock(&types.RequestBeginBlock{Header: types.Header{Height: int64(height)}}))
+	delegateTx := payload.NewDelegateTx(delegator.GetAddress(), validator.GetAddress(), 100)
+	delegateTx.Input.Sequence = 1
+	txe, err := exe.Execute(sign(delegateTx, delegator))
+	require.NoError(t, err)
+	require.Nil(t, txe.Exception)
+	_, err = exe.Commit(nil)
+	require.NoError(t, err)
+	assertPower(t, st, validator.GetAddress(), 200)
+	assert.Equal(t, balance-100, getAccount(st, delegator.GetAddress()).Balance)
+	assert.Equal(t, []*delegation.Delegation{{
+		Validator: validator.GetAddress(),
+		Delegator: delegator.GetAddress(),
+		Amount:    100,
+	}}, delegations(t, st))
+
+	// The validator cannot unbond stake delegated to it
+	require.NoError(t, exe.BeginBlock(&types.RequestBeginBlock{Header: types.Header{Height: int64(height + 1)}}))
+	unbondTx := payload.NewUnbondTx(validator.GetAddress(), 150)
+	unbondTx.Input.Sequence = 1
+	_, err = exe.Execute(sign(unbondTx, validator))
+	require.Error(t, err)
+
+	// Undelegate some of the stake
+	undelegateTx := payload.NewUndelegateTx(delegator.GetAddress(), validator.GetAddress(), 40)
+	undelegateTx.Input.Sequence = 2
+	txe, err = exe.Execute(sign(undelegateTx, delegator))
+	require.NoError(t, err)
+	require.Nil(t, txe.Exception)
+	_, err = exe.Commit(nil)
+	require.NoError(t, err)
+	assertPower(t, st, validator.GetAddress(), 160)
+	assert.Equal(t, uint64(60), delegations(t, st)[0].Amount)
+	assert.Equal(t, []*slashing.Unbonding{{
+		Address:       delegator.GetAddress(),
+		Validator:     validator.GetAddress(),
+		Amount:        40,
+		Height:        height + 1,
+		ReleaseHeight: height + 3,
+	}}, unbondings(t, st))
+
+	// Slashing the validator slashes its delegators too, including their stake that is still unbonding
+	require.NoError(t, exe.BeginBlock(&types.RequestBeginBlock{
+		Header: types.Header{Height: int64(height + 2)},
+		ByzantineValidators: []types.Evidence{{
+			Type:      "duplicate/vote",
+			Validator: types.Validator{Address: validator.GetAddress().Bytes()},
+			Height:    int64(height + 1),
+		}},
+	}))
+	require.Len(t, exe.block.Events, 1)
+	assert.Equal(t, uint64(16), exe.block.Events[0].Slash.Slashed)
+	assert.Equal(t, uint64(4), exe.block.Events[0].Slash.UnbondingSlashed)
+	_, err = exe.Commit(nil)
+	require.NoError(t, err)
+	assertPower(t, st, validator.GetAddress(), 144)
+	assert.Equal(t, uint64(54), delegations(t, st)[0].Amount)
+
+	// Release the undelegated stake to the delegator
+	require.NoError(t, exe.BeginBlock(&types.RequestBeginBlock{Header: types.Header{Height: int64(height + 3)}}))
+	_, err = exe.Commit(nil)
+	require.NoError(t, err)
+	assert.Equal(t, balance-100+36, getAccount(st, delegator.GetAddress()).Balance)
+	assert.Empty(t, unbondings(t, st))
+}
+
+func TestDelegation_SameBlock(t *testing.T) {
+	exe, st := makeSlashingExecutor(t, func(genDoc *genesis.GenesisDoc) {})
+	validator := users[1]
+	delegator := users[4]
+
+	// Delegations made to the same validator in one block all add to its power
+	require.NoError(t, exe.BeginBlock(&types.RequestBeginBlock{Header: types.Header{Height: int64(exe.block.Height)}}))
+	for i, amount := range []uint64{10, 20} {
+		tx := payload.NewDelegateTx(delegator.GetAddress(), validator.GetAddress(), amount)
+		tx.Input.Sequence = uint64(i + 1)
+		txEnv := txs.Enclose(exe.params.ChainID, tx)
+		require.NoError(t, txEnv.Sign(delegator))
+		_, err := exe.Execute(txEnv)
+		require.NoError(t, err)
+	}
+	_, err := exe.Commit(nil)
+	require.NoError(t, err)
+	assertPower(t, st, validator.GetAddress(), 130)
+	assert.Equal(t, uint64(30), delegations(t, st)[0].Amount)
+}
+
+func TestDelegation_ValidatorLeft(t *testing.T) {
+	exe, st := makeSlashingExecutor(t, func(genDoc *genesis.GenesisDoc) {})
+	validator := users[1]
+	delegator := users[4]
+	balance := getAccount(st, delegator.GetAddress()).Balance
+	sequences := make(map[crypto.Address]uint64)
+	execute := func(tx payload.Payload, signer acm.AddressableSigner) {
+		for _, in := range tx.GetInputs() {
+			sequences[in.Address]++
+			in.Sequence = sequences[in.Address]
+		}
+		txEnv := txs.Enclose(exe.params.ChainID, tx)
+		require.NoError(t, txEnv.Sign(signer))
+		_, err := exe.Execute(txEnv)
+		require.NoError(t, err)
+	}
+	block := func(txs ...func()) {
+		require.NoError(t, exe.BeginBlock(&types.RequestBeginBlock{Header: types.Header{Height: int64(exe.block.Height)}}))
+		for _, tx := range txs {
+			tx()
+		}
+		_, err := exe.Commit(nil)
+		require.NoError(t, err)
+	}
+
+	block(func() {
+		execute(payload.NewDelegateTx(delegator.GetAddress(), validator.GetAddress(), 10), delegator)
+	})
+	assertPower(t, st, validator.GetAddress(), 110)
+
+	// Governance removes the validator from the set
+	block(func() {
+		execute(governance.AlterPowerTx(users[0].GetAddress(), validator, 0), users[0])
+	})
+	assertPower(t, st, validator.GetAddress(), 0)
+
+	// The delegator can still withdraw its stake
+	block(func() {
+		execute(payload.NewUndelegateTx(delegator.GetAddress(), validator.GetAddress(), 10), delegator)
+	})
+	assertPower(t, st, validator.GetAddress(), 0)
+	assert.Empty(t, delegations(t, st))
+	assert.Equal(t, balance, getAccount(st, delegator.GetAddress()).Balance)
+}
+
+func delegations(t *testing.T, st *state.State) []*delegation.Delegation {
+	var delegations []*delegation.Delegation
+	require.NoError(t, st.IterateDelegations(func(d *delegation.Delegation) error {
+		delegations = append(delegations, d)
+		return nil
+	}))
+	return delegations
+}
@@ -62,6 +62,10 @@ const (
 	ErrorCodeAlreadyVoted
 	ErrorCodeUnresolvedSymbols
 	ErrorCodeInvalidContractCode
+	ErrorCodeValidatorSetFull
+	ErrorCodeInsufficientBond
+	ErrorCodeValidatorPowerCapped
+	ErrorCodeTooManyValidatorChanges
 )
 
 func (c Code) ErrorCode() Code {
@@ -158,6 +162,14 @@ func (c Code) String() string {
 		return "code has unresolved symbols"
 	case ErrorCodeInvalidContractCode:
 		return "contract being created with unexpected code"
+	case ErrorCodeValidatorSetFull:
+		return "validator set has the maximum number of validators"
+	case ErrorCodeInsufficientBond:
+		return "validator power is less than the minimum bond"
+	case ErrorCodeValidatorPowerCapped:
+		return "validator power would exceed its maximum share of total power"
+	case ErrorCodeTooManyValidatorChanges:
+		return "validator set has changed the maximum number of times this block"
 	default:
 		return "Unknown error"
 	}
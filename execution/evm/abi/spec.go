@@ -122,6 +122,16 @@ func (spec *Spec) GetEventAbi(id EventID, addresses crypto.Address) (*EventSpec,
 	return &eventSpec, nil
 }
 
+// GetFunctionAbi returns the name and spec of the function with the given ID
+func (spec *Spec) GetFunctionAbi(id FunctionID, address crypto.Address) (string, *FunctionSpec, error) {
+	for name, functionSpec := range spec.Functions {
+		if functionSpec.FunctionID == id {
+			return name, &functionSpec, nil
+		}
+	}
+	return "", nil, fmt.Errorf("could not find ABI for function with ID %x", id)
+}
+
 // Pack ABI encodes a function call. The fname specifies which function should called, if
 // it doesn't exist exist the fallback function will be called. If fname is the empty
 // string, the constructor is called. The arguments must be specified in args. The count
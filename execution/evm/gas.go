@@ -14,6 +14,13 @@
 
 package evm
 
+import (
+	"fmt"
+	"math"
+
+	. "github.com/hyperledger/burrow/execution/evm/asm"
+)
+
 const (
 	GasSha3          uint64 = 1
 	GasGetAccount    uint64 = 1
@@ -30,4 +37,196 @@ const (
 	GasRipemd160Base uint64 = 1
 	GasIdentityWord  uint64 = 1
 	GasIdentityBase  uint64 = 1
+
+	// EIP-1884 repricing of state access applied when running with the Istanbul hardfork
+	GasSloadIstanbul       uint64 = 800
+	GasBalanceIstanbul     uint64 = 700
+	GasExtCodeHashIstanbul uint64 = 700
+	GasSelfBalance         uint64 = 5
+
+	// Precompile pricing scales the Istanbul prices (EIP-198, EIP-1108 and EIP-152) down by 150 so that alt_bn128
+	// addition, the cheapest, costs 1 like the other native contracts. A BLAKE2 round cannot cost less than 1.
+	GasExpModQuadDivisor    uint64 = 3000
+	GasBn256Add             uint64 = 1
+	GasBn256ScalarMul       uint64 = 40
+	GasBn256PairingBase     uint64 = 300
+	GasBn256PairingPerPoint uint64 = 227
+	GasBlake2FRound         uint64 = 1
 )
+
+const (
+	LegacyGasScheduleName   = "legacy"
+	EthereumGasScheduleName = "ethereum"
+)
+
+// GasSchedule prices EVM execution. The zero value charges nothing.
+type GasSchedule struct {
+	// Charged each time an opcode is executed, indexed by opcode
+	Opcodes [256]uint64
+	// Charged for each push to or pop from the data stack
+	StackOp uint64
+	// Charged when BALANCE, EXTCODESIZE, EXTCODECOPY, SELFDESTRUCT or a CALL to a non-native contract loads an account
+	GetAccount uint64
+	// Charged when CREATE, CREATE2 or SELFDESTRUCT creates an account
+	CreateAccount uint64
+	// Charged when a CALL or SELFDESTRUCT sends to an account that does not exist
+	NewAccount uint64
+	// Charged when a CALL or CALLCODE transfers value
+	CallValueTransfer uint64
+	// Charged when SSTORE writes a non-zero value to an empty slot
+	StorageSet uint64
+	// Charged for any other SSTORE
+	StorageUpdate uint64
+	// Active memory of n words costs n*MemoryWord + n*n/MemoryQuadDivisor, charged as memory expands. A zero
+	// divisor omits the quadratic term
+	MemoryWord        uint64
+	MemoryQuadDivisor uint64
+	// Charged per word hashed by SHA3
+	Sha3Word uint64
+	// Charged per word copied by CALLDATACOPY, CODECOPY, EXTCODECOPY and RETURNDATACOPY
+	CopyWord uint64
+	// Charged per topic and per byte of data emitted by LOGn
+	LogTopic uint64
+	LogData  uint64
+	// Charged per byte of the exponent of EXP
+	ExpByte uint64
+	// Charged per byte of code deposited by CREATE and CREATE2
+	CreateDataByte uint64
+	// The EIP-198 complexity of a modexp call is divided by ExpModQuadDivisor to give its price
+	ExpModQuadDivisor uint64
+	// Charged per alt_bn128 addition, scalar multiplication and pairing check (with a further charge per point paired)
+	Bn256Add             uint64
+	Bn256ScalarMul       uint64
+	Bn256PairingBase     uint64
+	Bn256PairingPerPoint uint64
+	// Charged per round of the BLAKE2 compression function
+	Blake2FRound uint64
+}
+
+// Burrow's historic pricing under which most operations only pay for stack usage
+func LegacyGasSchedule() *GasSchedule {
+	gs := &GasSchedule{
+		StackOp:       GasStackOp,
+		GetAccount:    GasGetAccount,
+		CreateAccount: GasCreateAccount,
+		StorageSet:    GasStorageUpdate,
+		StorageUpdate: GasStorageUpdate,
+
+		ExpModQuadDivisor:    GasExpModQuadDivisor,
+		Bn256Add:             GasBn256Add,
+		Bn256ScalarMul:       GasBn256ScalarMul,
+		Bn256PairingBase:     GasBn256PairingBase,
+		Bn256PairingPerPoint: GasBn256PairingPerPoint,
+		Blake2FRound:         GasBlake2FRound,
+	}
+	for i := range gs.Opcodes {
+		gs.Opcodes[i] = GasBaseOp
+	}
+	gs.Opcodes[SHA3] += GasSha3
+	return gs
+}
+
+// Pricing following the Ethereum yellow paper as of the Istanbul hardfork (though SSTORE uses the simpler
+// pre-EIP-2200 set/update rule)
+func EthereumGasSchedule() *GasSchedule {
+	const (
+		zero    = 0
+		base    = 2
+		veryLow = 3
+		low     = 5
+		mid     = 8
+		high    = 10
+	)
+	gs := &GasSchedule{
+		NewAccount:        25000,
+		CallValueTransfer: 9000,
+		StorageSet:        20000,
+		StorageUpdate:     5000,
+		MemoryWord:        3,
+		MemoryQuadDivisor: 512,
+		Sha3Word:          6,
+		CopyWord:          3,
+		LogTopic:          375,
+		LogData:           8,
+		ExpByte:           50,
+		CreateDataByte:    200,
+
+		ExpModQuadDivisor:    20,
+		Bn256Add:             150,
+		Bn256ScalarMul:       6000,
+		Bn256PairingBase:     45000,
+		Bn256PairingPerPoint: 34000,
+		Blake2FRound:         1,
+	}
+	tiers := map[uint64][]OpCode{
+		zero: {STOP, RETURN, REVERT},
+		base: {ADDRESS, ORIGIN, CALLER, CALLVALUE, CALLDATASIZE, CODESIZE, GASPRICE_DEPRECATED, COINBASE, TIMESTAMP,
+			BLOCKHEIGHT, DIFFICULTY_DEPRECATED, GASLIMIT, CHAINID, RETURNDATASIZE, POP, PC, MSIZE, GAS},
+		veryLow: {ADD, SUB, NOT, LT, GT, SLT, SGT, EQ, ISZERO, AND, OR, XOR, BYTE, SHL, SHR, SAR, CALLDATALOAD, MLOAD,
+			MSTORE, MSTORE8, CALLDATACOPY, CODECOPY, RETURNDATACOPY},
+		low:  {MUL, DIV, SDIV, MOD, SMOD, SIGNEXTEND, SELFBALANCE},
+		mid:  {ADDMOD, MULMOD, JUMP},
+		high: {JUMPI, EXP},
+		1:    {JUMPDEST},
+		20:   {BLOCKHASH},
+		30:   {SHA3},
+		375:  {LOG0, LOG1, LOG2, LOG3, LOG4},
+		700: {BALANCE, EXTCODESIZE, EXTCODECOPY, EXTCODEHASH, CALL, CALLCODE, DELEGATECALL,
+			STATICCALL},
+		800:   {SLOAD},
+		5000:  {SELFDESTRUCT},
+		32000: {CREATE, CREATE2},
+	}
+	for gas, ops := range tiers {
+		for _, op := range ops {
+			gs.Opcodes[op] = gas
+		}
+	}
+	for op := PUSH1; op <= SWAP16; op++ {
+		gs.Opcodes[op] = veryLow
+	}
+	return gs
+}
+
+// Returns the named schedule as it applies under hardfork. The empty name is the legacy schedule, which is priced the
+// same however it is named.
+func GasScheduleByName(name string, hardfork Hardfork) (*GasSchedule, error) {
+	switch name {
+	case "", LegacyGasScheduleName:
+		return defaultGasSchedule(hardfork), nil
+	case EthereumGasScheduleName:
+		return EthereumGasSchedule(), nil
+	default:
+		return nil, fmt.Errorf("unknown gas schedule '%s', must be one of: %s, %s", name,
+			LegacyGasScheduleName, EthereumGasScheduleName)
+	}
+}
+
+// The schedule used when none is provided on Params - legacy pricing with any repricing required by the hardfork
+func defaultGasSchedule(hardfork Hardfork) *GasSchedule {
+	gs := LegacyGasSchedule()
+	if hardfork.repricesStateAccess() {
+		gs.Opcodes[SLOAD] += GasSloadIstanbul
+		gs.Opcodes[BALANCE] += GasBalanceIstanbul
+		gs.Opcodes[EXTCODEHASH] += GasExtCodeHashIstanbul
+		gs.Opcodes[SELFBALANCE] += GasSelfBalance
+	}
+	return gs
+}
+
+// Total cost of holding the given number of words of active memory
+func (gs *GasSchedule) memoryGas(words uint64) uint64 {
+	gas := words * gs.MemoryWord
+	if gs.MemoryQuadDivisor > 0 {
+		gas += words * words / gs.MemoryQuadDivisor
+	}
+	return gas
+}
+
+// Number of 32-byte words needed to hold size bytes
+func toWords(size uint64) uint64 {
+	if size > math.MaxUint64-31 {
+		return math.MaxUint64/32 + 1
+	}
+	return (size + 31) / 32
+}
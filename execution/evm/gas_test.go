@@ -0,0 +1,75 @@
+package evm
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/crypto"
+	. "github.com/hyperledger/burrow/execution/evm/asm"
+	. "github.com/hyperledger/burrow/execution/evm/asm/bc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEthereumGasSchedule(t *testing.T) {
+	cache := NewState(newAppState(), blockHashGetter)
+	account1 := newAccount(cache, "1")
+	account2 := newAccount(cache, "101")
+	require.NoError(t, cache.Sync())
+
+	params := newParams()
+	params.GasSchedule = EthereumGasSchedule()
+	gasUsed := func(bytecode []byte) uint64 {
+		var gas uint64 = 1000000
+		_, err := NewVM(params, crypto.ZeroAddress, nil, logger).Call(cache.NewCache(), NewNoopEventSink(), account1,
+			account2, bytecode, []byte{}, 0, &gas)
+		require.NoError(t, err)
+		return 1000000 - gas
+	}
+
+	assert.Equal(t, uint64(3+3+3), gasUsed(MustSplice(PUSH1, 0x01, PUSH1, 0x02, ADD, STOP)))
+	// MLOAD of the first word expands memory by a single word
+	assert.Equal(t, uint64(3+3+3), gasUsed(MustSplice(PUSH1, 0x00, MLOAD, STOP)))
+	// Touching the 1024th word pays the quadratic term
+	assert.Equal(t, uint64(3+3+1024*3+1024*1024/512), gasUsed(MustSplice(PUSH2, 0x7F, 0xE0, MLOAD, STOP)))
+	// Setting an empty slot costs more than updating it
+	assert.Equal(t, uint64(3+3+20000), gasUsed(MustSplice(PUSH1, 0x01, PUSH1, 0x01, SSTORE, STOP)))
+	assert.Equal(t, uint64(3+3+5000), gasUsed(MustSplice(PUSH1, 0x00, PUSH1, 0x01, SSTORE, STOP)))
+	// LOG1 of 32 bytes of memory
+	assert.Equal(t, uint64(3+3+3+375+375+32*8+3),
+		gasUsed(MustSplice(PUSH1, 0x01, PUSH1, 0x20, PUSH1, 0x00, LOG1, STOP)))
+}
+
+func TestLegacyGasSchedule(t *testing.T) {
+	cache := NewState(newAppState(), blockHashGetter)
+	account1 := newAccount(cache, "1")
+	account2 := newAccount(cache, "101")
+	require.NoError(t, cache.Sync())
+
+	var gas uint64 = 1000
+	_, err := NewVM(newParams(), crypto.ZeroAddress, nil, logger).Call(cache, NewNoopEventSink(), account1,
+		account2, MustSplice(PUSH1, 0x01, PUSH1, 0x02, ADD, PUSH1, 0x00, SSTORE, STOP), []byte{}, 0, &gas)
+	require.NoError(t, err)
+	// Legacy pricing only charges for stack operations and the storage update
+	assert.Equal(t, uint64(1000-8*GasStackOp-GasStorageUpdate), gas)
+}
+
+func TestGasScheduleByName(t *testing.T) {
+	gs, err := GasScheduleByName(EthereumGasScheduleName, Burrow)
+	require.NoError(t, err)
+	assert.Equal(t, EthereumGasSchedule(), gs)
+
+	gs, err = GasScheduleByName("", Burrow)
+	require.NoError(t, err)
+	assert.Equal(t, LegacyGasSchedule(), gs)
+
+	// Both spellings of legacy are repriced by the hardfork
+	for _, name := range []string{"", LegacyGasScheduleName} {
+		gs, err = GasScheduleByName(name, Istanbul)
+		require.NoError(t, err)
+		assert.Equal(t, defaultGasSchedule(Istanbul), gs)
+		assert.Equal(t, GasBaseOp+GasSloadIstanbul, gs.Opcodes[SLOAD])
+	}
+
+	_, err = GasScheduleByName("frontier", Burrow)
+	assert.Error(t, err)
+}
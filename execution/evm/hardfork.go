@@ -0,0 +1,78 @@
+package evm
+
+import (
+	"fmt"
+
+	. "github.com/hyperledger/burrow/execution/evm/asm"
+)
+
+// Hardfork names an Ethereum fork whose opcode set and semantics the VM should follow. The zero value selects Burrow's
+// native behaviour which enables every opcode the VM implements.
+type Hardfork string
+
+const (
+	Burrow         Hardfork = ""
+	Byzantium      Hardfork = "byzantium"
+	Constantinople Hardfork = "constantinople"
+	Petersburg     Hardfork = "petersburg"
+	Istanbul       Hardfork = "istanbul"
+)
+
+// Opcodes introduced after Byzantium and the fork in which they became available
+var opcodeHardforks = map[OpCode]Hardfork{
+	SHL:         Constantinople,
+	SHR:         Constantinople,
+	SAR:         Constantinople,
+	EXTCODEHASH: Constantinople,
+	CREATE2:     Constantinople,
+	CHAINID:     Istanbul,
+	SELFBALANCE: Istanbul,
+}
+
+// Order in which forks were activated on Ethereum mainnet
+var hardforkOrder = map[Hardfork]int{
+	Byzantium:      1,
+	Constantinople: 2,
+	Petersburg:     3,
+	Istanbul:       4,
+}
+
+func (hf Hardfork) Validate() error {
+	if hf == Burrow {
+		return nil
+	}
+	if _, ok := hardforkOrder[hf]; !ok {
+		return fmt.Errorf("unknown hardfork '%s', must be one of: %s, %s, %s, %s or empty for Burrow default",
+			hf, Byzantium, Constantinople, Petersburg, Istanbul)
+	}
+	return nil
+}
+
+// Returns true if this fork is at least as recent as other. Burrow counts as more recent than any named fork.
+func (hf Hardfork) IsAtLeast(other Hardfork) bool {
+	if hf == Burrow {
+		return true
+	}
+	return hardforkOrder[hf] >= hardforkOrder[other]
+}
+
+// Whether op is part of the opcode set of this fork
+func (hf Hardfork) Enables(op OpCode) bool {
+	introduced, ok := opcodeHardforks[op]
+	if !ok {
+		return true
+	}
+	return hf.IsAtLeast(introduced)
+}
+
+// EIP-1884 repricing of state access opcodes applies only when explicitly running as Istanbul (or later)
+func (hf Hardfork) repricesStateAccess() bool {
+	return hf != Burrow && hf.IsAtLeast(Istanbul)
+}
+
+func (hf Hardfork) String() string {
+	if hf == Burrow {
+		return "burrow"
+	}
+	return string(hf)
+}
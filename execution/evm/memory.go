@@ -159,3 +159,49 @@ func (mem *dynamicMemory) ensureCapacity(newCapacity uint64) error {
 	mem.slice = mem.slice[:newCapacity]
 	return nil
 }
+
+// Charges gas according to a GasSchedule as the highest offset of memory accessed grows
+type meteredMemory struct {
+	Memory
+	schedule *GasSchedule
+	gas      *uint64
+	words    uint64
+	errSink  errors.Sink
+}
+
+func newMeteredMemory(memory Memory, schedule *GasSchedule, gas *uint64, errSink errors.Sink) *meteredMemory {
+	return &meteredMemory{
+		Memory:   memory,
+		schedule: schedule,
+		gas:      gas,
+		errSink:  errSink,
+	}
+}
+
+func (mem *meteredMemory) Read(offset, length *big.Int) []byte {
+	if length.Sign() != 0 {
+		mem.expand(offset, length)
+	}
+	return mem.Memory.Read(offset, length)
+}
+
+func (mem *meteredMemory) Write(offset *big.Int, value []byte) {
+	if len(value) != 0 {
+		mem.expand(offset, big.NewInt(int64(len(value))))
+	}
+	mem.Memory.Write(offset, value)
+}
+
+func (mem *meteredMemory) expand(offset, length *big.Int) {
+	end := new(big.Int).Add(offset, length)
+	// Leave out of range accesses for the underlying memory to reject
+	if offset.Sign() < 0 || length.Sign() < 0 || end.Cmp(big.NewInt(math.MaxInt32)) > 0 {
+		return
+	}
+	words := toWords(end.Uint64())
+	if words <= mem.words {
+		return
+	}
+	useGasNegative(mem.gas, mem.schedule.memoryGas(words)-mem.schedule.memoryGas(mem.words), mem.errSink)
+	mem.words = words
+}
@@ -16,9 +16,15 @@ package evm
 
 import (
 	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/big"
 
 	. "github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/crypto/blake2b"
+	"github.com/hyperledger/burrow/crypto/bn256"
 	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/logging"
 	"golang.org/x/crypto/ripemd160"
@@ -26,14 +32,19 @@ import (
 
 var registeredNativeContracts = make(map[crypto.Address]NativeContract)
 
+// Native contracts whose price depends on the GasSchedule the VM is running with
+var scheduledNativeContracts = make(map[crypto.Address]func(schedule *GasSchedule) NativeContract)
+
 func IsRegisteredNativeContract(address crypto.Address) bool {
 	_, ok := registeredNativeContracts[address]
+	if !ok {
+		_, ok = scheduledNativeContracts[address]
+	}
 	return ok
 }
 
 func RegisterNativeContract(address crypto.Address, fn NativeContract) bool {
-	_, exists := registeredNativeContracts[address]
-	if exists {
+	if IsRegisteredNativeContract(address) {
 		return false
 	}
 	registeredNativeContracts[address] = fn
@@ -50,14 +61,26 @@ func registerNativeContracts() {
 	registeredNativeContracts[crypto.Address{2}] = sha256Func
 	registeredNativeContracts[crypto.Address{3}] = ripemd160Func
 	registeredNativeContracts[crypto.Address{4}] = identityFunc
+	scheduledNativeContracts[crypto.Address{5}] = expModFunc
+	scheduledNativeContracts[crypto.Address{6}] = bn256AddFunc
+	scheduledNativeContracts[crypto.Address{7}] = bn256ScalarMulFunc
+	scheduledNativeContracts[crypto.Address{8}] = bn256PairingFunc
+	scheduledNativeContracts[crypto.Address{9}] = blake2FFunc
 }
 
 //-----------------------------------------------------------------------------
 
+// Execute the native contract registered at address, pricing it according to schedule (or the legacy schedule if nil)
 func ExecuteNativeContract(address crypto.Address, st Interface, caller crypto.Address, input []byte, gas *uint64,
-	logger *logging.Logger) ([]byte, errors.CodedError) {
+	schedule *GasSchedule, logger *logging.Logger) ([]byte, errors.CodedError) {
 
 	contract, ok := registeredNativeContracts[address]
+	if scheduled, isScheduled := scheduledNativeContracts[address]; isScheduled {
+		if schedule == nil {
+			schedule = LegacyGasSchedule()
+		}
+		contract, ok = scheduled(schedule), true
+	}
 	if !ok {
 		return nil, errors.ErrorCodef(errors.ErrorCodeNativeFunction,
 			"no native contract registered at address: %v", address)
@@ -140,3 +163,263 @@ func identityFunc(state Interface, caller crypto.Address, input []byte, gas *uin
 	// Return identity
 	return input, nil
 }
+
+// The largest base, exponent or modulus length in bytes that expMod accepts (the bound EIP-7823 later set on
+// Ethereum). The price of the exponent grows only linearly with its length and the legacy schedule scales it down, so
+// without a bound a caller could have us allocate and exponentiate by a number of almost any size for little gas.
+const expModMaxLength = 1024
+
+// Big integer modular exponentiation as specified by EIP-198
+func expModFunc(schedule *GasSchedule) NativeContract {
+	return func(state Interface, caller crypto.Address, input []byte, gas *uint64,
+		logger *logging.Logger) ([]byte, error) {
+		return expMod(schedule, input, gas)
+	}
+}
+
+func expMod(schedule *GasSchedule, input []byte, gas *uint64) (output []byte, err error) {
+	baseLength := new(big.Int).SetBytes(getData(input, 0, 32))
+	expLength := new(big.Int).SetBytes(getData(input, 32, 32))
+	modLength := new(big.Int).SetBytes(getData(input, 64, 32))
+	maxLength := big.NewInt(expModMaxLength)
+	if baseLength.Cmp(maxLength) > 0 || expLength.Cmp(maxLength) > 0 || modLength.Cmp(maxLength) > 0 {
+		return nil, fmt.Errorf("modexp base, exponent and modulus lengths must be at most %d bytes but were %v, %v "+
+			"and %v", expModMaxLength, baseLength, expLength, modLength)
+	}
+	if len(input) > 96 {
+		input = input[96:]
+	} else {
+		input = input[:0]
+	}
+	// Deduct gas
+	gasRequired := expModGas(schedule, input, baseLength, expLength, modLength)
+	if *gas < gasRequired {
+		return nil, errors.ErrorCodeInsufficientGas
+	} else {
+		*gas -= gasRequired
+	}
+	// Lengths are bounded by expModMaxLength so fit in memory we are prepared to allocate
+	baseLen, expLen, modLen := baseLength.Uint64(), expLength.Uint64(), modLength.Uint64()
+	if baseLen == 0 && modLen == 0 {
+		return []byte{}, nil
+	}
+	base := new(big.Int).SetBytes(getData(input, 0, baseLen))
+	exp := new(big.Int).SetBytes(getData(input, baseLen, expLen))
+	mod := new(big.Int).SetBytes(getData(input, baseLen+expLen, modLen))
+	if mod.Sign() == 0 {
+		// Modulo 0 is undefined, return zero
+		return make([]byte, modLen), nil
+	}
+	return LeftPadBytes(base.Exp(base, exp, mod).Bytes(), int(modLen)), nil
+}
+
+func expModGas(schedule *GasSchedule, input []byte, baseLength, expLength, modLength *big.Int) uint64 {
+	big32 := big.NewInt(32)
+	// Take the head 32 bytes of the exponent to compute its adjusted length
+	expHead := new(big.Int)
+	if big.NewInt(int64(len(input))).Cmp(baseLength) > 0 {
+		headLength := expLength.Uint64()
+		if expLength.Cmp(big32) > 0 {
+			headLength = 32
+		}
+		expHead.SetBytes(getData(input, baseLength.Uint64(), headLength))
+	}
+	adjustedExpLength := new(big.Int)
+	if expLength.Cmp(big32) > 0 {
+		adjustedExpLength.Sub(expLength, big32)
+		adjustedExpLength.Lsh(adjustedExpLength, 3)
+	}
+	if bitLen := expHead.BitLen(); bitLen > 0 {
+		adjustedExpLength.Add(adjustedExpLength, big.NewInt(int64(bitLen-1)))
+	}
+	if adjustedExpLength.Sign() == 0 {
+		adjustedExpLength.SetInt64(1)
+	}
+
+	x := new(big.Int).Set(baseLength)
+	if modLength.Cmp(x) > 0 {
+		x.Set(modLength)
+	}
+	// The piecewise quadratic 'mult_complexity' function from EIP-198
+	complexity := new(big.Int).Mul(x, x)
+	switch {
+	case x.Cmp(big.NewInt(64)) <= 0:
+	case x.Cmp(big.NewInt(1024)) <= 0:
+		complexity.Div(complexity, big.NewInt(4))
+		complexity.Add(complexity, new(big.Int).Mul(big.NewInt(96), x))
+		complexity.Sub(complexity, big.NewInt(3072))
+	default:
+		complexity.Div(complexity, big.NewInt(16))
+		complexity.Add(complexity, new(big.Int).Mul(big.NewInt(480), x))
+		complexity.Sub(complexity, big.NewInt(199680))
+	}
+	gasRequired := complexity.Mul(complexity, adjustedExpLength)
+	gasRequired.Div(gasRequired, new(big.Int).SetUint64(schedule.ExpModQuadDivisor))
+	if gasRequired.BitLen() > 64 {
+		return math.MaxUint64
+	}
+	return gasRequired.Uint64()
+}
+
+// Elliptic curve point addition on alt_bn128 as specified by EIP-196
+func bn256AddFunc(schedule *GasSchedule) NativeContract {
+	return func(state Interface, caller crypto.Address, input []byte, gas *uint64,
+		logger *logging.Logger) ([]byte, error) {
+		return bn256Add(schedule, input, gas)
+	}
+}
+
+func bn256Add(schedule *GasSchedule, input []byte, gas *uint64) (output []byte, err error) {
+	// Deduct gas
+	gasRequired := schedule.Bn256Add
+	if *gas < gasRequired {
+		return nil, errors.ErrorCodeInsufficientGas
+	} else {
+		*gas -= gasRequired
+	}
+	x, err := newCurvePoint(getData(input, 0, 64))
+	if err != nil {
+		return nil, err
+	}
+	y, err := newCurvePoint(getData(input, 64, 64))
+	if err != nil {
+		return nil, err
+	}
+	return new(bn256.G1).Add(x, y).Marshal(), nil
+}
+
+// Elliptic curve scalar multiplication on alt_bn128 as specified by EIP-196
+func bn256ScalarMulFunc(schedule *GasSchedule) NativeContract {
+	return func(state Interface, caller crypto.Address, input []byte, gas *uint64,
+		logger *logging.Logger) ([]byte, error) {
+		return bn256ScalarMul(schedule, input, gas)
+	}
+}
+
+func bn256ScalarMul(schedule *GasSchedule, input []byte, gas *uint64) (output []byte, err error) {
+	// Deduct gas
+	gasRequired := schedule.Bn256ScalarMul
+	if *gas < gasRequired {
+		return nil, errors.ErrorCodeInsufficientGas
+	} else {
+		*gas -= gasRequired
+	}
+	p, err := newCurvePoint(getData(input, 0, 64))
+	if err != nil {
+		return nil, err
+	}
+	return new(bn256.G1).ScalarMult(p, new(big.Int).SetBytes(getData(input, 64, 32))).Marshal(), nil
+}
+
+const bn256PairingPointLength = 192
+
+// Optimal ate pairing check on alt_bn128 as specified by EIP-197
+func bn256PairingFunc(schedule *GasSchedule) NativeContract {
+	return func(state Interface, caller crypto.Address, input []byte, gas *uint64,
+		logger *logging.Logger) ([]byte, error) {
+		return bn256Pairing(schedule, input, gas)
+	}
+}
+
+func bn256Pairing(schedule *GasSchedule, input []byte, gas *uint64) (output []byte, err error) {
+	// Deduct gas
+	gasRequired := uint64(len(input)/bn256PairingPointLength)*schedule.Bn256PairingPerPoint +
+		schedule.Bn256PairingBase
+	if *gas < gasRequired {
+		return nil, errors.ErrorCodeInsufficientGas
+	} else {
+		*gas -= gasRequired
+	}
+	if len(input)%bn256PairingPointLength != 0 {
+		return nil, fmt.Errorf("bn256 pairing input must be a multiple of %d bytes but was %d bytes long",
+			bn256PairingPointLength, len(input))
+	}
+	var curvePoints []*bn256.G1
+	var twistPoints []*bn256.G2
+	for i := 0; i < len(input); i += bn256PairingPointLength {
+		c, err := newCurvePoint(input[i : i+64])
+		if err != nil {
+			return nil, err
+		}
+		t := new(bn256.G2)
+		_, err = t.Unmarshal(input[i+64 : i+bn256PairingPointLength])
+		if err != nil {
+			return nil, fmt.Errorf("invalid bn256 twist point: %v", err)
+		}
+		curvePoints = append(curvePoints, c)
+		twistPoints = append(twistPoints, t)
+	}
+	if bn256.PairingCheck(curvePoints, twistPoints) {
+		return One256.Bytes(), nil
+	}
+	return Zero256.Bytes(), nil
+}
+
+const blake2FInputLength = 213
+
+// BLAKE2b compression function F as specified by EIP-152
+func blake2FFunc(schedule *GasSchedule) NativeContract {
+	return func(state Interface, caller crypto.Address, input []byte, gas *uint64,
+		logger *logging.Logger) ([]byte, error) {
+		return blake2F(schedule, input, gas)
+	}
+}
+
+func blake2F(schedule *GasSchedule, input []byte, gas *uint64) (output []byte, err error) {
+	if len(input) != blake2FInputLength {
+		return nil, fmt.Errorf("blake2f input must be exactly %d bytes but was %d bytes long",
+			blake2FInputLength, len(input))
+	}
+	rounds := binary.BigEndian.Uint32(input[0:4])
+	// Deduct gas
+	gasRequired := uint64(rounds) * schedule.Blake2FRound
+	if *gas < gasRequired {
+		return nil, errors.ErrorCodeInsufficientGas
+	} else {
+		*gas -= gasRequired
+	}
+	if input[212] > 1 {
+		return nil, fmt.Errorf("blake2f final block indicator must be 0 or 1 but was %d", input[212])
+	}
+	var h [8]uint64
+	var m [16]uint64
+	var t [2]uint64
+	for i := range h {
+		h[i] = binary.LittleEndian.Uint64(input[4+i*8:])
+	}
+	for i := range m {
+		m[i] = binary.LittleEndian.Uint64(input[68+i*8:])
+	}
+	t[0] = binary.LittleEndian.Uint64(input[196:204])
+	t[1] = binary.LittleEndian.Uint64(input[204:212])
+
+	blake2b.F(&h, m, t, input[212] == 1, rounds)
+
+	output = make([]byte, 64)
+	for i := range h {
+		binary.LittleEndian.PutUint64(output[i*8:], h[i])
+	}
+	return output, nil
+}
+
+func newCurvePoint(bs []byte) (*bn256.G1, error) {
+	p := new(bn256.G1)
+	_, err := p.Unmarshal(bs)
+	if err != nil {
+		return nil, fmt.Errorf("invalid bn256 curve point: %v", err)
+	}
+	return p, nil
+}
+
+// Returns length bytes of data from offset right-padding with zeroes where data is not long enough
+func getData(data []byte, offset, length uint64) []byte {
+	size := uint64(len(data))
+	if offset > size {
+		offset = size
+	}
+	end := offset + length
+	if end > size || end < offset {
+		end = size
+	}
+	return RightPadBytes(data[offset:end], int(length))
+}
@@ -0,0 +1,304 @@
+package evm
+
+import (
+	"encoding/hex"
+	"math"
+	"testing"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Test vectors taken from the Ethereum EIPs and the go-ethereum precompile tests
+type nativeVector struct {
+	name     string
+	input    string
+	expected string
+}
+
+var expModVectors = []nativeVector{
+	{
+		name: "eip_example1",
+		input: "00000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000020" +
+			"000000000000000000000000000000000000000000000000000000000000002003fffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc" +
+			"2efffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f",
+		expected: "0000000000000000000000000000000000000000000000000000000000000001",
+	},
+	{
+		name: "eip_example2",
+		input: "00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000020" +
+			"0000000000000000000000000000000000000000000000000000000000000020fffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2e" +
+			"fffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f",
+		expected: "0000000000000000000000000000000000000000000000000000000000000000",
+	},
+	{
+		name: "nagydani-1-square",
+		input: "00000000000000000000000000000000000000000000000000000000000000400000000000000000000000000000000000000000000000000000000000000001" +
+			"0000000000000000000000000000000000000000000000000000000000000040e09ad9675465c53a109fac66a445c91b292d2bb2c5268addb30cd82f80fcb003" +
+			"3ff97c80a5fc6f39193ae969c6ede6710a6b7ac27078a06d90ef1c72e5c85fb502fc9e1f6beb81516545975218075ec2af118cd8798df6e08a147c60fd6095ac" +
+			"2bb02c2908cf4dd7c81f11c289e4bce98f3553768f392a80ce22bf5c4f4a248c6b",
+		expected: "60008f1614cc01dcfb6bfb09c625cf90b47d4468db81b5f8b7a39d42f332eab9b2da8f2d95311648a8f243f4bb13cfb3d8f7f2a3c014122ebb3ed41b02783adc",
+	},
+	{
+		name: "nagydani-2-qube",
+		input: "00000000000000000000000000000000000000000000000000000000000000800000000000000000000000000000000000000000000000000000000000000001" +
+			"0000000000000000000000000000000000000000000000000000000000000080cad7d991a00047dd54d3399b6b0b937c718abddef7917c75b6681f40cc15e2be" +
+			"0003657d8d4c34167b2f0bbbca0ccaa407c2a6a07d50f1517a8f22979ce12a81dcaf707cc0cebfc0ce2ee84ee7f77c38b9281b9822a8d3de62784c089c9b18dc" +
+			"b9a2a5eecbede90ea788a862a9ddd9d609c2c52972d63e289e28f6a590ffbf5103e6d893b80aeed5e6e9ce9afa8a5d5675c93a32ac05554cb20e9951b2c140e3" +
+			"ef4e433068cf0fb73bc9f33af1853f64aa27a0028cbf570d7ac9048eae5dc7b28c87c31e5810f1e7fa2cda6adf9f1076dbc1ec1238560071e7efc4e9565c49be" +
+			"9e7656951985860a558a754594115830bcdb421f741408346dd5997bb01c287087",
+		expected: "d89ceb68c32da4f6364978d62aaa40d7b09b59ec61eb3c0159c87ec3a91037f7dc6967594e530a69d049b64adfa39c8fa208ea970cfe4b7bcd359d345744405a" +
+			"fe1cbf761647e32b3184c7fbe87cee8c6c7ff3b378faba6c68b83b6889cb40f1603ee68c56b4c03d48c595c826c041112dc941878f8c5be828154afd4a16311f",
+	},
+	{
+		name: "nagydani-3-pow0x10001",
+		input: "00000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000003" +
+			"0000000000000000000000000000000000000000000000000000000000000100c9130579f243e12451760976261416413742bd7c91d39ae087f46794062b8c23" +
+			"9f2a74abf3918605a0e046a7890e049475ba7fbb78f5de6490bd22a710cc04d30088179a919d86c2da62cf37f59d8f258d2310d94c24891be2d7eeafaa32a8cb" +
+			"4b0cfe5f475ed778f45907dc8916a73f03635f233f7a77a00a3ec9ca6761a5bbd558a2318ecd0caa1c5016691523e7e1fa267dd35e70c66e84380bdcf7c0582f" +
+			"540174e572c41f81e93da0b757dff0b0fe23eb03aa19af0bdec3afb474216febaacb8d0381e631802683182b0fe72c28392539850650b70509f54980241dc175" +
+			"191a35d967288b532a7a8223ce2440d010615f70df269501944d4ec16fe4a3cb010001d7a85909174757835187cb52e71934e6c07ef43b4c46fc30bbcd0bc729" +
+			"13068267c54a4aabebb493922492820babdeb7dc9b1558fcf7bd82c37c82d3147e455b623ab0efa752fe0b3a67ca6e4d126639e645a0bf417568adbb2a6a4eef" +
+			"62fa1fa29b2a5a43bebea1f82193a7dd98eb483d09bb595af1fa9c97c7f41f5649d976aee3e5e59e2329b43b13bea228d4a93f16ba139ccb511de521ffe747aa" +
+			"2eca664f7c9e33da59075cc335afcd2bf3ae09765f01ab5a7c3e3938ec168b74724b5074247d200d9970382f683d6059b94dbc336603d1dfee714e4b447ac2fa" +
+			"1d99ecb4961da2854e03795ed758220312d101e1e3d87d5313a6d052aebde75110363d",
+		expected: "37843d7c67920b5f177372fa56e2a09117df585f81df8b300fba245b1175f488c99476019857198ed459ed8d9799c377330e49f4180c4bf8e8f66240c64f65ed" +
+			"e93d601f957b95b83efdee1e1bfde74169ff77002eaf078c71815a9220c80b2e3b3ff22c2f358111d816ebf83c2999026b6de50bfc711ff68705d2f40b753424" +
+			"aefc9f70f08d908b5a20276ad613b4ab4309a3ea72f0c17ea9df6b3367d44fb3acab11c333909e02e81ea2ed404a712d3ea96bba87461720e2d98723e7acd052" +
+			"0ac1a5212dbedcd8dc0c1abf61d4719e319ff4758a774790b8d463cdfe131d1b2dcfee52d002694e98e720cb6ae7ccea353bc503269ba35f0f63bf8d7b672a76",
+	},
+}
+
+var bn256AddVectors = []nativeVector{
+	{
+		name: "chfast1",
+		input: "18b18acfb4c2c30276db5411368e7185b311dd124691610c5d3b74034e093dc9063c909c4720840cb5134cb9f59fa749755796819658d32efc0d288198f37266" +
+			"07c2b7f58a84bd6145f00c9c2bc0bb1a187f20ff2c92963a88019e7c6a014eed06614e20c147e940f2d70da3f74c9a17df361706a4485c742bd6788478fa17d7",
+		expected: "2243525c5efd4b9c3d3c45ac0ca3fe4dd85e830a4ce6b65fa1eeaee202839703301d1d33be6da8e509df21cc35964723180eed7532537db9ae5e7d48f195c915",
+	},
+	{
+		name: "chfast2",
+		input: "2243525c5efd4b9c3d3c45ac0ca3fe4dd85e830a4ce6b65fa1eeaee202839703301d1d33be6da8e509df21cc35964723180eed7532537db9ae5e7d48f195c915" +
+			"18b18acfb4c2c30276db5411368e7185b311dd124691610c5d3b74034e093dc9063c909c4720840cb5134cb9f59fa749755796819658d32efc0d288198f37266",
+		expected: "2bd3e6d0f3b142924f5ca7b49ce5b9d54c4703d7ae5648e61d02268b1a0a9fb721611ce0a6af85915e2f1d70300909ce2e49dfad4a4619c8390cae66cefdb204",
+	},
+	{
+		name: "cdetrio1",
+		input: "00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000" +
+			"00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+		expected: "00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+	},
+	{
+		name:     "cdetrio4",
+		input:    "",
+		expected: "00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+	},
+}
+
+var bn256ScalarMulVectors = []nativeVector{
+	{
+		name: "chfast1",
+		input: "2bd3e6d0f3b142924f5ca7b49ce5b9d54c4703d7ae5648e61d02268b1a0a9fb721611ce0a6af85915e2f1d70300909ce2e49dfad4a4619c8390cae66cefdb204" +
+			"00000000000000000000000000000000000000000000000011138ce750fa15c2",
+		expected: "070a8d6a982153cae4be29d434e8faef8a47b274a053f5a4ee2a6c9c13c31e5c031b8ce914eba3a9ffb989f9cdd5b0f01943074bf4f0f315690ec3cec6981afc",
+	},
+	{
+		name: "chfast2",
+		input: "070a8d6a982153cae4be29d434e8faef8a47b274a053f5a4ee2a6c9c13c31e5c031b8ce914eba3a9ffb989f9cdd5b0f01943074bf4f0f315690ec3cec6981afc" +
+			"30644e72e131a029b85045b68181585d97816a916871ca8d3c208c16d87cfd46",
+		expected: "025a6f4181d2b4ea8b724290ffb40156eb0adb514c688556eb79cdea0752c2bb2eff3f31dea215f1eb86023a133a996eb6300b44da664d64251d05381bb8a02e",
+	},
+	{
+		name: "cdetrio1",
+		input: "1a87b0584ce92f4593d161480614f2989035225609f08058ccfa3d0f940febe31a2f3c951f6dadcc7ee9007dff81504b0fcd6d7cf59996efdc33d92bf7f9f8f6" +
+			"ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+		expected: "2cde5879ba6f13c0b5aa4ef627f159a3347df9722efce88a9afbb20b763b4c411aa7e43076f6aee272755a7f9b84832e71559ba0d2e0b17d5f9f01755e5b0d11",
+	},
+}
+
+var bn256PairingVectors = []nativeVector{
+	{
+		name: "jeff1",
+		input: "1c76476f4def4bb94541d57ebba1193381ffa7aa76ada664dd31c16024c43f593034dd2920f673e204fee2811c678745fc819b55d3e9d294e45c9b03a76aef41" +
+			"209dd15ebff5d46c4bd888e51a93cf99a7329636c63514396b4a452003a35bf704bf11ca01483bfa8b34b43561848d28905960114c8ac04049af4b6315a41678" +
+			"2bb8324af6cfc93537a2ad1a445cfd0ca2a71acd7ac41fadbf933c2a51be344d120a2a4cf30c1bf9845f20c6fe39e07ea2cce61f0c9bb048165fe5e4de877550" +
+			"111e129f1cf1097710d41c4ac70fcdfa5ba2023c6ff1cbeac322de49d1b6df7c2032c61a830e3c17286de9462bf242fca2883585b93870a73853face6a6bf411" +
+			"198e9393920d483a7260bfb731fb5d25f1aa493335a9e71297e485b7aef312c21800deef121f1e76426a00665e5c4479674322d4f75edadd46debd5cd992f6ed" +
+			"090689d0585ff075ec9e99ad690c3395bc4b313370b38ef355acdadcd122975b12c85ea5db8c6deb4aab71808dcb408fe3d1e7690c43d37b4ce6cc0166fa7daa",
+		expected: "0000000000000000000000000000000000000000000000000000000000000001",
+	},
+	{
+		name: "jeff6",
+		input: "1c76476f4def4bb94541d57ebba1193381ffa7aa76ada664dd31c16024c43f593034dd2920f673e204fee2811c678745fc819b55d3e9d294e45c9b03a76aef41" +
+			"209dd15ebff5d46c4bd888e51a93cf99a7329636c63514396b4a452003a35bf704bf11ca01483bfa8b34b43561848d28905960114c8ac04049af4b6315a41678" +
+			"2bb8324af6cfc93537a2ad1a445cfd0ca2a71acd7ac41fadbf933c2a51be344d120a2a4cf30c1bf9845f20c6fe39e07ea2cce61f0c9bb048165fe5e4de877550" +
+			"111e129f1cf1097710d41c4ac70fcdfa5ba2023c6ff1cbeac322de49d1b6df7c103188585e2364128fe25c70558f1560f4f9350baf3959e603cc91486e110936" +
+			"198e9393920d483a7260bfb731fb5d25f1aa493335a9e71297e485b7aef312c21800deef121f1e76426a00665e5c4479674322d4f75edadd46debd5cd992f6ed" +
+			"090689d0585ff075ec9e99ad690c3395bc4b313370b38ef355acdadcd122975b12c85ea5db8c6deb4aab71808dcb408fe3d1e7690c43d37b4ce6cc0166fa7daa",
+		expected: "0000000000000000000000000000000000000000000000000000000000000000",
+	},
+	{
+		name:     "empty_data",
+		input:    "",
+		expected: "0000000000000000000000000000000000000000000000000000000000000001",
+	},
+	{
+		name: "one_point",
+		input: "00000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000002" +
+			"198e9393920d483a7260bfb731fb5d25f1aa493335a9e71297e485b7aef312c21800deef121f1e76426a00665e5c4479674322d4f75edadd46debd5cd992f6ed" +
+			"090689d0585ff075ec9e99ad690c3395bc4b313370b38ef355acdadcd122975b12c85ea5db8c6deb4aab71808dcb408fe3d1e7690c43d37b4ce6cc0166fa7daa",
+		expected: "0000000000000000000000000000000000000000000000000000000000000000",
+	},
+	{
+		name: "two_point_match_2",
+		input: "00000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000002" +
+			"198e9393920d483a7260bfb731fb5d25f1aa493335a9e71297e485b7aef312c21800deef121f1e76426a00665e5c4479674322d4f75edadd46debd5cd992f6ed" +
+			"090689d0585ff075ec9e99ad690c3395bc4b313370b38ef355acdadcd122975b12c85ea5db8c6deb4aab71808dcb408fe3d1e7690c43d37b4ce6cc0166fa7daa" +
+			"00000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000002" +
+			"198e9393920d483a7260bfb731fb5d25f1aa493335a9e71297e485b7aef312c21800deef121f1e76426a00665e5c4479674322d4f75edadd46debd5cd992f6ed" +
+			"275dc4a288d1afb3cbb1ac09187524c7db36395df7be3b99e673b13a075a65ec1d9befcd05a5323e6da4d435f3b617cdb3af83285c2df711ef39c01571827f9d",
+		expected: "0000000000000000000000000000000000000000000000000000000000000001",
+	},
+}
+
+var blake2FVectors = []nativeVector{
+	{
+		name: "vector 4",
+		input: "0000000048c9bdf267e6096a3ba7ca8485ae67bb2bf894fe72f36e3cf1361d5f3af54fa5d182e6ad7f520e511f6c3e2b8c68059b6bbd41fbabd9831f79217e13" +
+			"19cde05b616263000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000" +
+			"00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000" +
+			"000000000300000000000000000000000000000001",
+		expected: "08c9bcf367e6096a3ba7ca8485ae67bb2bf894fe72f36e3cf1361d5f3af54fa5d282e6ad7f520e511f6c3e2b8c68059b9442be0454267ce079217e1319cde05b",
+	},
+	{
+		name: "vector 5",
+		input: "0000000c48c9bdf267e6096a3ba7ca8485ae67bb2bf894fe72f36e3cf1361d5f3af54fa5d182e6ad7f520e511f6c3e2b8c68059b6bbd41fbabd9831f79217e13" +
+			"19cde05b616263000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000" +
+			"00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000" +
+			"000000000300000000000000000000000000000001",
+		expected: "ba80a53f981c4d0d6a2797b69f12f6e94c212f14685ac4b74b12bb6fdbffa2d17d87c5392aab792dc252d5de4533cc9518d38aa8dbf1925ab92386edd4009923",
+	},
+	{
+		name: "vector 6",
+		input: "0000000c48c9bdf267e6096a3ba7ca8485ae67bb2bf894fe72f36e3cf1361d5f3af54fa5d182e6ad7f520e511f6c3e2b8c68059b6bbd41fbabd9831f79217e13" +
+			"19cde05b616263000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000" +
+			"00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000" +
+			"000000000300000000000000000000000000000000",
+		expected: "75ab69d3190a562c51aef8d88f1c2775876944407270c42c9844252c26d2875298743e7f6d5ea2f2d3e8d226039cd31b4e426ac4f2d3d666a610c2116fde4735",
+	},
+	{
+		name: "vector 7",
+		input: "0000000148c9bdf267e6096a3ba7ca8485ae67bb2bf894fe72f36e3cf1361d5f3af54fa5d182e6ad7f520e511f6c3e2b8c68059b6bbd41fbabd9831f79217e13" +
+			"19cde05b616263000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000" +
+			"00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000" +
+			"000000000300000000000000000000000000000001",
+		expected: "b63a380cb2897d521994a85234ee2c181b5f844d2c624c002677e9703449d2fba551b3a8333bcdf5f2f7e08993d53923de3d64fcc68c034e717b9293fed7a421",
+	},
+}
+
+func TestNativeContracts(t *testing.T) {
+	testNativeVectors(t, crypto.Address{5}, expModVectors)
+	testNativeVectors(t, crypto.Address{6}, bn256AddVectors)
+	testNativeVectors(t, crypto.Address{7}, bn256ScalarMulVectors)
+	testNativeVectors(t, crypto.Address{8}, bn256PairingVectors)
+	testNativeVectors(t, crypto.Address{9}, blake2FVectors)
+}
+
+func TestExpModGas(t *testing.T) {
+	input, err := hex.DecodeString(expModVectors[0].input)
+	require.NoError(t, err)
+	gas := uint64(100000)
+	_, err = expMod(EthereumGasSchedule(), input, &gas)
+	require.NoError(t, err)
+	// Worked example from EIP-198
+	assert.Equal(t, uint64(100000-13056), gas)
+
+	gas = 13055
+	_, err = expMod(EthereumGasSchedule(), input, &gas)
+	assert.Equal(t, errors.ErrorCodeInsufficientGas, err)
+
+	// The legacy schedule scales the price down
+	gas = 100000
+	_, err = expMod(LegacyGasSchedule(), input, &gas)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(100000-13056*20/GasExpModQuadDivisor), gas)
+}
+
+func TestExpModMaxLength(t *testing.T) {
+	// Declare an exponent of 2^40 bytes, which would be cheap enough to pay for under the legacy schedule
+	input := make([]byte, 96)
+	input[31] = 1
+	input[32+26] = 1
+	input[95] = 1
+	input = append(input, 3, 0xff, 5)
+	for _, schedule := range []*GasSchedule{LegacyGasSchedule(), EthereumGasSchedule()} {
+		gas := uint64(math.MaxUint64)
+		_, err := expMod(schedule, input, &gas)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "at most 1024 bytes")
+	}
+
+	// Lengths at the bound are still accepted
+	input = make([]byte, 96)
+	input[30], input[31] = 4, 0
+	input[63] = 1
+	input[95] = 1
+	input = append(input, make([]byte, expModMaxLength)...)
+	input = append(input, 2, 7)
+	gas := uint64(math.MaxUint64)
+	output, err := expMod(LegacyGasSchedule(), input, &gas)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0}, output)
+}
+
+func TestBn256PairingGas(t *testing.T) {
+	input, err := hex.DecodeString(bn256PairingVectors[0].input)
+	require.NoError(t, err)
+	for _, schedule := range []*GasSchedule{LegacyGasSchedule(), EthereumGasSchedule()} {
+		gas := uint64(math.MaxUint64)
+		_, err = bn256Pairing(schedule, input, &gas)
+		require.NoError(t, err)
+		assert.Equal(t, schedule.Bn256PairingBase+2*schedule.Bn256PairingPerPoint, math.MaxUint64-gas)
+
+		_, err = bn256Pairing(schedule, input[1:], &gas)
+		assert.Error(t, err)
+	}
+	assert.Equal(t, GasBn256PairingBase+2*GasBn256PairingPerPoint, LegacyGasSchedule().Bn256PairingBase+
+		2*LegacyGasSchedule().Bn256PairingPerPoint)
+	assert.Equal(t, uint64(113000), EthereumGasSchedule().Bn256PairingBase+
+		2*EthereumGasSchedule().Bn256PairingPerPoint)
+}
+
+func TestBlake2FMalformedInput(t *testing.T) {
+	input, err := hex.DecodeString(blake2FVectors[0].input)
+	require.NoError(t, err)
+	gas := uint64(math.MaxUint64)
+
+	_, err = blake2F(LegacyGasSchedule(), nil, &gas)
+	assert.Error(t, err)
+	_, err = blake2F(LegacyGasSchedule(), input[1:], &gas)
+	assert.Error(t, err)
+	_, err = blake2F(LegacyGasSchedule(), append(input, 0), &gas)
+	assert.Error(t, err)
+
+	input[212] = 2
+	_, err = blake2F(LegacyGasSchedule(), input, &gas)
+	assert.Error(t, err)
+}
+
+func testNativeVectors(t *testing.T, address crypto.Address, vectors []nativeVector) {
+	for _, v := range vectors {
+		t.Run(v.name, func(t *testing.T) {
+			input, err := hex.DecodeString(v.input)
+			require.NoError(t, err)
+			gas := uint64(math.MaxUint64)
+			output, cerr := ExecuteNativeContract(address, nil, crypto.ZeroAddress, input, &gas, nil,
+				logging.NewNoopLogger())
+			require.NoError(t, cerr)
+			assert.Equal(t, v.expected, hex.EncodeToString(output))
+		})
+	}
+}
@@ -23,3 +23,22 @@ func StackOptions(callStackMaxDepth uint64, dataStackInitialCapacity uint64, dat
 		vm.params.DataStackMaxDepth = dataStackMaxDepth
 	}
 }
+
+func HardforkOption(hardfork Hardfork) func(*VM) {
+	return func(vm *VM) {
+		vm.params.Hardfork = hardfork
+	}
+}
+
+func TracerOption(tracer Tracer) func(*VM) {
+	return func(vm *VM) {
+		vm.tracer = tracer
+	}
+}
+
+// Start the call stack at depth rather than zero, for when the EVM is entered from a call made by another VM
+func StackDepthOption(depth uint64) func(*VM) {
+	return func(vm *VM) {
+		vm.stackDepth = depth
+	}
+}
@@ -30,8 +30,9 @@ type Stack struct {
 	maxCapacity uint64
 	ptr         int
 
-	gas     *uint64
-	errSink errors.Sink
+	gas      *uint64
+	gasPerOp uint64
+	errSink  errors.Sink
 }
 
 func NewStack(initialCapacity uint64, maxCapacity uint64, gas *uint64, errSink errors.Sink) *Stack {
@@ -40,11 +41,15 @@ func NewStack(initialCapacity uint64, maxCapacity uint64, gas *uint64, errSink e
 		ptr:         0,
 		maxCapacity: maxCapacity,
 		gas:         gas,
+		gasPerOp:    GasStackOp,
 		errSink:     errSink,
 	}
 }
 
 func (st *Stack) useGas(gasToUse uint64) {
+	if gasToUse == 0 {
+		return
+	}
 	if *st.gas > gasToUse {
 		*st.gas -= gasToUse
 	} else {
@@ -57,7 +62,7 @@ func (st *Stack) pushErr(err errors.CodedError) {
 }
 
 func (st *Stack) Push(d Word256) {
-	st.useGas(GasStackOp)
+	st.useGas(st.gasPerOp)
 	err := st.ensureCapacity(uint64(st.ptr) + 1)
 	if err != nil {
 		st.pushErr(errors.ErrorCodeDataStackOverflow)
@@ -97,7 +102,7 @@ func (st *Stack) PushBigInt(bigInt *big.Int) Word256 {
 // Pops
 
 func (st *Stack) Pop() Word256 {
-	st.useGas(GasStackOp)
+	st.useGas(st.gasPerOp)
 	if st.ptr == 0 {
 		st.pushErr(errors.ErrorCodeDataStackUnderflow)
 		return Zero256
@@ -146,7 +151,7 @@ func (st *Stack) Len() int {
 }
 
 func (st *Stack) Swap(n int) {
-	st.useGas(GasStackOp)
+	st.useGas(st.gasPerOp)
 	if st.ptr < n {
 		st.pushErr(errors.ErrorCodeDataStackUnderflow)
 		return
@@ -155,7 +160,7 @@ func (st *Stack) Swap(n int) {
 }
 
 func (st *Stack) Dup(n int) {
-	st.useGas(GasStackOp)
+	st.useGas(st.gasPerOp)
 	if st.ptr < n {
 		st.pushErr(errors.ErrorCodeDataStackUnderflow)
 		return
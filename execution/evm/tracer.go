@@ -0,0 +1,125 @@
+package evm
+
+import (
+	"math"
+	"math/big"
+
+	. "github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	. "github.com/hyperledger/burrow/execution/evm/asm"
+)
+
+// A Tracer observes execution one opcode at a time - it can be attached to a VM with TracerOption
+type Tracer interface {
+	// Called before each opcode is executed (and before it is charged for). The Step is a copy of the VM state and
+	// can be retained by the Tracer.
+	CaptureStep(step *Step)
+}
+
+// Adapts a function to a Tracer
+type TracerFunc func(step *Step)
+
+func (tf TracerFunc) CaptureStep(step *Step) {
+	tf(step)
+}
+
+// Snapshot of the VM immediately before an opcode is executed
+type Step struct {
+	// Depth of the call stack, the top-level call has depth 1
+	Depth uint64
+	// Account whose code is being executed
+	Address crypto.Address
+	PC      uint64
+	Op      OpCode
+	// Gas remaining before the opcode is executed
+	Gas uint64
+	// Data stack from bottom to top
+	Stack []Word256
+	// Memory up to the highest offset accessed so far in this call frame
+	Memory []byte
+	// The storage write this opcode is about to make, only set for SSTORE
+	StorageDelta *StorageDelta
+}
+
+type StorageDelta struct {
+	Key   Word256
+	Value Word256
+}
+
+// Collects every step executed into StructLogs
+type StructLogger struct {
+	StructLogs []*Step
+}
+
+func NewStructLogger() *StructLogger {
+	return &StructLogger{}
+}
+
+func (sl *StructLogger) CaptureStep(step *Step) {
+	sl.StructLogs = append(sl.StructLogs, step)
+}
+
+func (vm *VM) traceStep(callee crypto.Address, pc int64, op OpCode, gas uint64, stack *Stack,
+	memory *tracedMemory) {
+
+	step := &Step{
+		Depth:   vm.stackDepth,
+		Address: callee,
+		PC:      uint64(pc),
+		Op:      op,
+		Gas:     gas,
+		Stack:   make([]Word256, stack.ptr),
+		Memory:  memory.snapshot(),
+	}
+	copy(step.Stack, stack.slice[:stack.ptr])
+	if op == SSTORE && stack.ptr >= 2 {
+		step.StorageDelta = &StorageDelta{
+			Key:   stack.slice[stack.ptr-1],
+			Value: stack.slice[stack.ptr-2],
+		}
+	}
+	vm.tracer.CaptureStep(step)
+}
+
+// Tracks the extent of memory accessed so tracers see the portion in use rather than the whole allocation
+type tracedMemory struct {
+	Memory
+	length uint64
+}
+
+func newTracedMemory(memory Memory) *tracedMemory {
+	return &tracedMemory{
+		Memory: memory,
+	}
+}
+
+func (mem *tracedMemory) Read(offset, length *big.Int) []byte {
+	if length.Sign() != 0 {
+		mem.extend(offset, length)
+	}
+	return mem.Memory.Read(offset, length)
+}
+
+func (mem *tracedMemory) Write(offset *big.Int, value []byte) {
+	if len(value) != 0 {
+		mem.extend(offset, big.NewInt(int64(len(value))))
+	}
+	mem.Memory.Write(offset, value)
+}
+
+func (mem *tracedMemory) extend(offset, length *big.Int) {
+	end := new(big.Int).Add(offset, length)
+	if offset.Sign() < 0 || length.Sign() < 0 || end.Cmp(big.NewInt(math.MaxInt32)) > 0 {
+		return
+	}
+	if end.Uint64() > mem.length {
+		mem.length = end.Uint64()
+	}
+}
+
+func (mem *tracedMemory) snapshot() []byte {
+	if mem == nil || mem.length == 0 {
+		return nil
+	}
+	return mem.Memory.Read(big.NewInt(0), new(big.Int).SetUint64(mem.length))
+}
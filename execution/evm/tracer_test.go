@@ -0,0 +1,66 @@
+package evm
+
+import (
+	"testing"
+
+	. "github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	. "github.com/hyperledger/burrow/execution/evm/asm"
+	. "github.com/hyperledger/burrow/execution/evm/asm/bc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStructLogger(t *testing.T) {
+	cache := NewState(newAppState(), blockHashGetter)
+	account1 := newAccount(cache, "1")
+	account2 := newAccount(cache, "101")
+	require.NoError(t, cache.Sync())
+
+	structLogger := NewStructLogger()
+	bytecode := MustSplice(PUSH1, 0x2A, PUSH1, 0x05, SSTORE, PUSH1, 0x07, PUSH1, 0x00, MSTORE, STOP)
+
+	var gas uint64 = 100000
+	_, err := NewVM(newParams(), crypto.ZeroAddress, nil, logger, TracerOption(structLogger)).Call(cache,
+		NewNoopEventSink(), account1, account2, bytecode, []byte{}, 0, &gas)
+	require.NoError(t, err)
+
+	ops := make([]OpCode, len(structLogger.StructLogs))
+	for i, step := range structLogger.StructLogs {
+		ops[i] = step.Op
+	}
+	assert.Equal(t, []OpCode{PUSH1, PUSH1, SSTORE, PUSH1, PUSH1, MSTORE, STOP}, ops)
+
+	sstore := structLogger.StructLogs[2]
+	assert.Equal(t, uint64(4), sstore.PC)
+	assert.Equal(t, account2, sstore.Address)
+	assert.Equal(t, []Word256{Int64ToWord256(0x2A), Int64ToWord256(0x05)}, sstore.Stack)
+	assert.Equal(t, &StorageDelta{Key: Int64ToWord256(0x05), Value: Int64ToWord256(0x2A)}, sstore.StorageDelta)
+	assert.Nil(t, sstore.Memory)
+	assert.True(t, structLogger.StructLogs[1].Gas > sstore.Gas)
+
+	stop := structLogger.StructLogs[6]
+	assert.Empty(t, stop.Stack)
+	assert.Equal(t, Int64ToWord256(0x07).Bytes(), stop.Memory)
+	assert.Nil(t, stop.StorageDelta)
+}
+
+func TestTracerCallDepth(t *testing.T) {
+	cache := NewState(newAppState(), blockHashGetter)
+	account1 := newAccount(cache, "1")
+	callee := makeAccountWithCode(cache, "callee", MustSplice(PUSH1, 0x01, STOP))
+	caller := makeAccountWithCode(cache, "caller", callContractCode(callee))
+	require.NoError(t, cache.Sync())
+
+	var depths []uint64
+	tracer := TracerFunc(func(step *Step) {
+		depths = append(depths, step.Depth)
+	})
+	var gas uint64 = 100000
+	_, err := NewVM(newParams(), crypto.ZeroAddress, nil, logger, TracerOption(tracer)).Call(cache,
+		NewNoopEventSink(), account1, caller, cache.GetEVMCode(caller), []byte{}, 0, &gas)
+	require.NoError(t, err)
+	assert.Contains(t, depths, uint64(2))
+	assert.Equal(t, uint64(1), depths[0])
+	assert.Equal(t, uint64(1), depths[len(depths)-1])
+}
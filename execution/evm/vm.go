@@ -19,6 +19,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"io/ioutil"
+	"math"
 	"math/big"
 	"strings"
 
@@ -44,12 +45,17 @@ const (
 )
 
 type Params struct {
+	ChainID                  string
 	BlockHeight              uint64
 	BlockTime                int64
 	GasLimit                 uint64
 	CallStackMaxDepth        uint64
 	DataStackInitialCapacity uint64
 	DataStackMaxDepth        uint64
+	// Opt-in to the opcode set and gas semantics of a named Ethereum fork, empty for Burrow default
+	Hardfork Hardfork
+	// Pricing of execution, when nil Burrow's legacy schedule (repriced according to Hardfork) is used
+	GasSchedule *GasSchedule
 }
 
 type VM struct {
@@ -61,6 +67,7 @@ type VM struct {
 	logger         *logging.Logger
 	debugOpcodes   bool
 	dumpTokens     bool
+	tracer         Tracer
 	sequence       uint64
 }
 
@@ -79,6 +86,9 @@ func NewVM(params Params, origin crypto.Address, nonce []byte, logger *logging.L
 	for _, option := range options {
 		option(vm)
 	}
+	if vm.params.GasSchedule == nil {
+		vm.params.GasSchedule = defaultGasSchedule(vm.params.Hardfork)
+	}
 	return vm
 }
 
@@ -232,8 +242,18 @@ func (vm *VM) execute(callState Interface, eventSink EventSink, caller, callee c
 	// Program counter - the index into code that tracks current instruction
 	pc := int64(0)
 	// Provide stack and memory storage - passing in the callState as an error provider
+	schedule := vm.params.GasSchedule
 	stack := NewStack(vm.params.DataStackInitialCapacity, vm.params.DataStackMaxDepth, gas, callState)
+	stack.gasPerOp = schedule.StackOp
 	memory := vm.memoryProvider(callState)
+	var tracedMem *tracedMemory
+	if vm.tracer != nil {
+		tracedMem = newTracedMemory(memory)
+		memory = tracedMem
+	}
+	if schedule.MemoryWord > 0 || schedule.MemoryQuadDivisor > 0 {
+		memory = newMeteredMemory(memory, schedule, gas, callState)
+	}
 
 	for {
 		// Check for any error accrued to state
@@ -243,8 +263,16 @@ func (vm *VM) execute(callState Interface, eventSink EventSink, caller, callee c
 
 		var op = codeGetOp(code, pc)
 		vm.Debugf("(pc) %-3d (op) %-14s (st) %-4d (gas) %d", pc, op.String(), stack.Len(), *gas)
-		// Use BaseOp gas.
-		useGasNegative(gas, GasBaseOp, callState)
+		if vm.tracer != nil {
+			vm.traceStep(callee, pc, op, *gas, stack, tracedMem)
+		}
+		useGasNegative(gas, schedule.Opcodes[op], callState)
+
+		if !vm.params.Hardfork.Enables(op) {
+			vm.Debugf("(pc) %-3v Opcode %v not enabled in hardfork %v\n", pc, op, vm.params.Hardfork)
+			callState.PushError(errors.Errorf("opcode %v is not enabled in hardfork %v", op, vm.params.Hardfork))
+			return nil
+		}
 
 		switch op {
 
@@ -336,6 +364,7 @@ func (vm *VM) execute(callState Interface, eventSink EventSink, caller, callee c
 
 		case EXP: // 0x0A
 			x, y := stack.PopBigInt(), stack.PopBigInt()
+			useGasNegative(gas, schedule.ExpByte*uint64((y.BitLen()+7)/8), callState)
 			pow := new(big.Int).Exp(x, y, nil)
 			res := stack.PushBigInt(pow)
 			vm.Debugf(" %v ** %v = %v (%X)\n", x, y, pow, res)
@@ -495,8 +524,8 @@ func (vm *VM) execute(callState Interface, eventSink EventSink, caller, callee c
 			}
 
 		case SHA3: // 0x20
-			useGasNegative(gas, GasSha3, callState)
 			offset, size := stack.PopBigInt(), stack.PopBigInt()
+			useGasNegative(gas, schedule.Sha3Word*bigWords(size), callState)
 			data := memory.Read(offset, size)
 			data = sha3.Sha3(data)
 			stack.PushBytes(data)
@@ -508,7 +537,7 @@ func (vm *VM) execute(callState Interface, eventSink EventSink, caller, callee c
 
 		case BALANCE: // 0x31
 			address := stack.PopAddress()
-			useGasNegative(gas, GasGetAccount, callState)
+			useGasNegative(gas, schedule.GetAccount, callState)
 			balance := callState.GetBalance(address)
 			stack.PushU64(balance)
 			vm.Debugf(" => %v (%X)\n", balance, address)
@@ -540,6 +569,7 @@ func (vm *VM) execute(callState Interface, eventSink EventSink, caller, callee c
 			memOff := stack.PopBigInt()
 			inputOff := stack.Pop64()
 			length := stack.Pop64()
+			useGasNegative(gas, schedule.CopyWord*toWords(uint64(length)), callState)
 			data := subslice(input, inputOff, length, callState)
 			memory.Write(memOff, data)
 			vm.Debugf(" => [%v, %v, %v] %X\n", memOff, inputOff, length, data)
@@ -553,6 +583,7 @@ func (vm *VM) execute(callState Interface, eventSink EventSink, caller, callee c
 			memOff := stack.PopBigInt()
 			codeOff := stack.Pop64()
 			length := stack.Pop64()
+			useGasNegative(gas, schedule.CopyWord*toWords(uint64(length)), callState)
 			data := subslice(code, codeOff, length, callState)
 			memory.Write(memOff, data)
 			vm.Debugf(" => [%v, %v, %v] %X\n", memOff, codeOff, length, data)
@@ -563,7 +594,7 @@ func (vm *VM) execute(callState Interface, eventSink EventSink, caller, callee c
 
 		case EXTCODESIZE: // 0x3B
 			address := stack.PopAddress()
-			useGasNegative(gas, GasGetAccount, callState)
+			useGasNegative(gas, schedule.GetAccount, callState)
 			if callState.Exists(address) {
 				code := callState.GetEVMCode(address)
 				l := int64(len(code))
@@ -579,7 +610,7 @@ func (vm *VM) execute(callState Interface, eventSink EventSink, caller, callee c
 			}
 		case EXTCODECOPY: // 0x3C
 			address := stack.PopAddress()
-			useGasNegative(gas, GasGetAccount, callState)
+			useGasNegative(gas, schedule.GetAccount, callState)
 			if !callState.Exists(address) {
 				if _, ok := registeredNativeContracts[address]; ok {
 					vm.Debugf(" => attempted to copy native contract at %v but this is not supported\n", address)
@@ -592,6 +623,7 @@ func (vm *VM) execute(callState Interface, eventSink EventSink, caller, callee c
 			memOff := stack.PopBigInt()
 			codeOff := stack.Pop64()
 			length := stack.Pop64()
+			useGasNegative(gas, schedule.CopyWord*toWords(uint64(length)), callState)
 			data := subslice(code, codeOff, length, callState)
 			memory.Write(memOff, data)
 			vm.Debugf(" => [%v, %v, %v] %X\n", memOff, codeOff, length, data)
@@ -602,6 +634,7 @@ func (vm *VM) execute(callState Interface, eventSink EventSink, caller, callee c
 
 		case RETURNDATACOPY: // 0x3E
 			memOff, outputOff, length := stack.PopBigInt(), stack.PopBigInt(), stack.PopBigInt()
+			useGasNegative(gas, schedule.CopyWord*bigWords(length), callState)
 			end := new(big.Int).Add(outputOff, length)
 
 			if end.BitLen() > 64 || uint64(len(returnData)) < end.Uint64() {
@@ -671,6 +704,16 @@ func (vm *VM) execute(callState Interface, eventSink EventSink, caller, callee c
 			stack.PushU64(vm.params.GasLimit)
 			vm.Debugf(" => %v\n", vm.params.GasLimit)
 
+		case CHAINID: // 0x46
+			id := ChainIDWord(vm.params.ChainID)
+			stack.Push(id)
+			vm.Debugf(" => %X (%s)\n", id, vm.params.ChainID)
+
+		case SELFBALANCE: // 0x47
+			balance := callState.GetBalance(callee)
+			stack.PushU64(balance)
+			vm.Debugf(" => %v (%X)\n", balance, callee)
+
 		case POP: // 0x50
 			popped := stack.Pop()
 			vm.Debugf(" => 0x%X\n", popped)
@@ -701,7 +744,12 @@ func (vm *VM) execute(callState Interface, eventSink EventSink, caller, callee c
 
 		case SSTORE: // 0x55
 			loc, data := stack.Pop(), stack.Pop()
-			useGasNegative(gas, GasStorageUpdate, callState)
+			if schedule.StorageSet != schedule.StorageUpdate && !data.IsZero() &&
+				LeftPadWord256(callState.GetStorage(callee, loc)).IsZero() {
+				useGasNegative(gas, schedule.StorageSet, callState)
+			} else {
+				useGasNegative(gas, schedule.StorageUpdate, callState)
+			}
 			callState.SetStorage(callee, loc, data.Bytes())
 			vm.Debugf("%v {%v := %v}\n", callee, loc, data)
 
@@ -763,6 +811,7 @@ func (vm *VM) execute(callState Interface, eventSink EventSink, caller, callee c
 			for i := 0; i < n; i++ {
 				topics[i] = stack.Pop()
 			}
+			useGasNegative(gas, schedule.LogTopic*uint64(n)+schedule.LogData*bigUint64(size), callState)
 			data := memory.Read(offset, size)
 			callState.PushError(eventSink.Log(&exec.LogEvent{
 				Address: callee,
@@ -777,8 +826,7 @@ func (vm *VM) execute(callState Interface, eventSink EventSink, caller, callee c
 			offset, size := stack.PopBigInt(), stack.PopBigInt()
 			input := memory.Read(offset, size)
 
-			// TODO charge for gas to create account _ the code length * GasCreateByte
-			useGasNegative(gas, GasCreateAccount, callState)
+			useGasNegative(gas, schedule.CreateAccount, callState)
 
 			var newAccount crypto.Address
 			if op == CREATE {
@@ -805,6 +853,11 @@ func (vm *VM) execute(callState Interface, eventSink EventSink, caller, callee c
 			// Run the input to get the contract code.
 			// NOTE: no need to copy 'input' as per Call contract.
 			ret, callErr := vm.Call(childCallState, eventSink, callee, newAccount, input, input, contractValue, gas)
+			if callErr == nil {
+				// Pay to deposit the returned code
+				useGasNegative(gas, schedule.CreateDataByte*uint64(len(ret)), childCallState)
+				callErr = childCallState.Error()
+			}
 			if callErr != nil {
 				stack.Push(Zero256)
 				// Note we both set the return buffer and return the result normally
@@ -844,6 +897,13 @@ func (vm *VM) execute(callState Interface, eventSink EventSink, caller, callee c
 			// Get the arguments from the memory
 			args := memory.Read(inOffset, inSize)
 
+			if (op == CALL || op == CALLCODE) && value > 0 {
+				useGasNegative(gas, schedule.CallValueTransfer, callState)
+			}
+			if callState.Error() != nil {
+				continue
+			}
+
 			// Ensure that gasLimit is reasonable
 			if *gas < gasLimit {
 				// EIP150 - the 63/64 rule - rather than errors.CodedError we pass this specified fraction of the total available gas
@@ -859,7 +919,8 @@ func (vm *VM) execute(callState Interface, eventSink EventSink, caller, callee c
 			if IsRegisteredNativeContract(address) {
 				// Native contract
 				childCallState = callState.NewCache()
-				returnData, callErr = ExecuteNativeContract(address, childCallState, callee, args, &gasLimit, logger)
+				returnData, callErr = ExecuteNativeContract(address, childCallState, callee, args, &gasLimit,
+					vm.params.GasSchedule, logger)
 				childCallState.PushError(callErr)
 				// for now we fire the Call event. maybe later we'll fire more particulars
 				// NOTE: these fire call go_events and not particular go_events for eg name reg or permissions
@@ -867,7 +928,7 @@ func (vm *VM) execute(callState Interface, eventSink EventSink, caller, callee c
 					&gasLimit, childCallState)
 			} else {
 				// EVM contract
-				useGasNegative(gas, GasGetAccount, callState)
+				useGasNegative(gas, schedule.GetAccount, callState)
 				// since CALL is used also for sending funds,
 				// acc may not exist yet. This is an errors.CodedError for
 				// CALLCODE, but not for CALL, though I don't think
@@ -878,6 +939,7 @@ func (vm *VM) execute(callState Interface, eventSink EventSink, caller, callee c
 						continue
 					}
 					// We're sending funds to a new account so we must create it first
+					useGasNegative(gas, schedule.NewAccount, callState)
 					createAccount(callState, callee, address)
 					if callState.Error() != nil {
 						continue
@@ -957,10 +1019,10 @@ func (vm *VM) execute(callState Interface, eventSink EventSink, caller, callee c
 
 		case SELFDESTRUCT: // 0xFF
 			receiver := stack.PopAddress()
-			useGasNegative(gas, GasGetAccount, callState)
+			useGasNegative(gas, schedule.GetAccount, callState)
 			if !callState.Exists(receiver) {
 				// If receiver address doesn't exist, try to create it
-				useGasNegative(gas, GasCreateAccount, callState)
+				useGasNegative(gas, schedule.CreateAccount+schedule.NewAccount, callState)
 				createAccount(callState, callee, receiver)
 				if callState.Error() != nil {
 					continue
@@ -1019,6 +1081,18 @@ func subslice(data []byte, offset, length int64, err errors.Sink) []byte {
 	return data[offset : offset+length]
 }
 
+// Number of words needed to hold size bytes saturating for sizes that do not fit in 64 bits
+func bigWords(size *big.Int) uint64 {
+	return toWords(bigUint64(size))
+}
+
+func bigUint64(x *big.Int) uint64 {
+	if !x.IsUint64() {
+		return math.MaxUint64
+	}
+	return x.Uint64()
+}
+
 func codeGetOp(code []byte, n int64) OpCode {
 	if int64(len(code)) <= n {
 		return OpCode(0) // stop
@@ -1087,6 +1161,16 @@ func (vm *VM) ensureStackDepth() errors.CodedError {
 	return nil
 }
 
+// Returns the word pushed by CHAINID. Burrow chain IDs are arbitrary strings so a chain ID that is a decimal number
+// is pushed as that number and any other chain ID is pushed as the Keccak-256 hash of the string.
+func ChainIDWord(chainID string) Word256 {
+	id, ok := new(big.Int).SetString(chainID, 10)
+	if ok && id.Sign() >= 0 && id.BitLen() <= 256 {
+		return LeftPadWord256(id.Bytes())
+	}
+	return LeftPadWord256(sha3.Sha3([]byte(chainID)))
+}
+
 func newRevertException(ret []byte) errors.CodedError {
 	code := errors.ErrorCodeExecutionReverted
 	if len(ret) > 0 {
@@ -26,6 +26,7 @@ import (
 	"github.com/hyperledger/burrow/binary"
 	. "github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/crypto/sha3"
 	"github.com/hyperledger/burrow/execution/errors"
 	. "github.com/hyperledger/burrow/execution/evm/asm"
 	. "github.com/hyperledger/burrow/execution/evm/asm/bc"
@@ -1478,6 +1479,78 @@ func TestExtCodeHash(t *testing.T) {
 	assert.Equal(t, hex.MustDecodeString("010da270094b5199d3e54f89afe4c66cdd658dd8111a41998714227e14e171bd"), output)
 }
 
+func TestChainID(t *testing.T) {
+	cache := NewState(newAppState(), blockHashGetter)
+	account1 := newAccount(cache, "1")
+	account2 := newAccount(cache, "101")
+
+	var gas uint64 = 100000
+	bytecode := MustSplice(CHAINID, return1())
+
+	params := newParams()
+	params.ChainID = "1337"
+	output, err := NewVM(params, crypto.ZeroAddress, nil, logger).Call(cache, NewNoopEventSink(), account1, account2,
+		bytecode, []byte{}, 0, &gas)
+	require.NoError(t, err)
+	assert.Equal(t, Int64ToWord256(1337).Bytes(), output)
+
+	params.ChainID = "BurrowChain_FAB3C1"
+	output, err = NewVM(params, crypto.ZeroAddress, nil, logger).Call(cache, NewNoopEventSink(), account1, account2,
+		bytecode, []byte{}, 0, &gas)
+	require.NoError(t, err)
+	assert.Equal(t, sha3.Sha3([]byte(params.ChainID)), output)
+}
+
+func TestSelfBalance(t *testing.T) {
+	cache := NewState(newAppState(), blockHashGetter)
+	account1 := newAccount(cache, "1")
+	account2 := newAccount(cache, "101")
+	cache.AddToBalance(account2, 2322)
+
+	var gas uint64 = 100000
+	output, err := NewVM(newParams(), crypto.ZeroAddress, nil, logger).Call(cache, NewNoopEventSink(), account1,
+		account2, MustSplice(SELFBALANCE, return1()), []byte{}, 0, &gas)
+	require.NoError(t, err)
+	assert.Equal(t, Uint64ToWord256(2322).Bytes(), output)
+}
+
+func TestHardforkOpcodes(t *testing.T) {
+	cache := NewState(newAppState(), blockHashGetter)
+	account1 := newAccount(cache, "1")
+	account2 := newAccount(cache, "101")
+	require.NoError(t, cache.Sync())
+
+	run := func(hardfork Hardfork, bytecode []byte, gas *uint64) error {
+		params := newParams()
+		params.Hardfork = hardfork
+		_, err := NewVM(params, crypto.ZeroAddress, nil, logger).Call(cache.NewCache(), NewNoopEventSink(), account1,
+			account2, bytecode, []byte{}, 0, gas)
+		return err
+	}
+
+	var gas uint64 = 100000
+	shl := MustSplice(PUSH1, 0x01, PUSH1, 0x01, SHL, return1())
+	assert.NoError(t, run(Burrow, shl, &gas))
+	assert.NoError(t, run(Constantinople, shl, &gas))
+	assert.Error(t, run(Byzantium, shl, &gas))
+
+	selfBalance := MustSplice(SELFBALANCE, return1())
+	assert.NoError(t, run(Burrow, selfBalance, &gas))
+	assert.NoError(t, run(Istanbul, selfBalance, &gas))
+	assert.Error(t, run(Petersburg, selfBalance, &gas))
+
+	// EIP-1884 repricing only applies when Istanbul is selected explicitly
+	sload := MustSplice(PUSH1, 0x01, SLOAD, return1())
+	burrowGas := gas
+	require.NoError(t, run(Burrow, sload, &burrowGas))
+	istanbulGas := gas
+	require.NoError(t, run(Istanbul, sload, &istanbulGas))
+	assert.Equal(t, GasSloadIstanbul, burrowGas-istanbulGas)
+
+	assert.Error(t, Hardfork("homestead").Validate())
+	assert.NoError(t, Istanbul.Validate())
+}
+
 func BasePermissionsFromStrings(t *testing.T, perms, setBit string) permission.BasePermissions {
 	return permission.BasePermissions{
 		Perms:  PermFlagFromString(t, perms),
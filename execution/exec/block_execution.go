@@ -4,13 +4,20 @@ import (
 	"fmt"
 	"reflect"
 
+	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/event"
 	"github.com/hyperledger/burrow/event/query"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/slashing"
 	"github.com/hyperledger/burrow/txs"
 )
 
 func EventStringBlockExecution(height uint64) string { return fmt.Sprintf("Execution/Block/%v", height) }
 
+func EventStringSlash(address crypto.Address) string { return fmt.Sprintf("Slash/%v", address) }
+
+func EventStringPayout(address crypto.Address) string { return fmt.Sprintf("Payout/%v", address) }
+
 // Write out TxExecutions parenthetically
 func (be *BlockExecution) StreamEvents() []*StreamEvent {
 	var ses []*StreamEvent
@@ -20,6 +27,10 @@ func (be *BlockExecution) StreamEvents() []*StreamEvent {
 			Header: be.Header,
 		},
 	})
+	// Events emitted by the block itself rather than any of its transactions
+	for _, ev := range be.Events {
+		ses = append(ses, &StreamEvent{Event: ev})
+	}
 	for _, txe := range be.TxExecutions {
 		ses = append(ses, txe.StreamEvents()...)
 	}
@@ -40,6 +51,33 @@ func (be *BlockExecution) Tx(txEnv *txs.Envelope) *TxExecution {
 	return txe
 }
 
+// Slash records that a validator was slashed for the evidence Tendermint reported at the beginning of this block
+func (be *BlockExecution) Slash(slash *slashing.Slash, exception *errors.Exception) {
+	be.Events = append(be.Events, &Event{
+		Header: &Header{
+			EventType: TypeSlash,
+			EventID:   EventStringSlash(slash.Address),
+			Height:    be.Height,
+			Index:     uint64(len(be.Events)),
+			Exception: exception,
+		},
+		Slash: slash,
+	})
+}
+
+// Payout records the fees and block reward paid to a validator at the end of this block
+func (be *BlockExecution) Payout(payout *PayoutEvent) {
+	be.Events = append(be.Events, &Event{
+		Header: &Header{
+			EventType: TypePayout,
+			EventID:   EventStringPayout(payout.Address),
+			Height:    be.Height,
+			Index:     uint64(len(be.Events)),
+		},
+		Payout: payout,
+	})
+}
+
 func (be *BlockExecution) AppendTxs(tail ...*TxExecution) {
 	for i, txe := range tail {
 		txe.Index = uint64(len(be.TxExecutions) + i)
@@ -3,7 +3,9 @@ package exec
 import (
 	"testing"
 
+	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/event/query"
+	"github.com/hyperledger/burrow/execution/slashing"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/abci/types"
 )
@@ -36,3 +38,15 @@ func TestBlockExecution_StreamEvents(t *testing.T) {
 	match := qry.Matches(be)
 	require.True(t, match)
 }
+
+func TestBlockExecution_BlockEvents(t *testing.T) {
+	be := &BlockExecution{Height: 3}
+	be.Slash(&slashing.Slash{Address: crypto.Address{1}, Height: 3, Slashed: 10}, nil)
+	require.Len(t, be.Events, 1)
+	require.Equal(t, TypeSlash, be.Events[0].EventType())
+
+	beOut, err := ConsumeBlockExecution(&StreamEvents{StreamEvents: be.StreamEvents()})
+	require.NoError(t, err)
+	require.Equal(t, be.Events, beOut.Events)
+	require.Empty(t, beOut.TxExecutions)
+}
@@ -27,6 +27,8 @@ const (
 	TypeEnvelope
 	TypeEndTx
 	TypeEndBlock
+	TypeSlash
+	TypePayout
 )
 
 var nameFromType = map[EventType]string{
@@ -40,6 +42,8 @@ var nameFromType = map[EventType]string{
 	TypeGovernAccount:  "GovernAccountEvent",
 	TypeBeginBlock:     "BeginBlockEvent",
 	TypeEndBlock:       "EndBlockEvent",
+	TypeSlash:          "SlashEvent",
+	TypePayout:         "PayoutEvent",
 }
 
 var typeFromName = make(map[string]EventType)
@@ -94,6 +98,12 @@ func (ev *Event) Body() string {
 	if ev.Call != nil {
 		return ev.Call.String()
 	}
+	if ev.Slash != nil {
+		return ev.Slash.String()
+	}
+	if ev.Payout != nil {
+		return ev.Payout.String()
+	}
 	return "<empty>"
 }
 
@@ -18,6 +18,7 @@ import (
 	github_com_hyperledger_burrow_crypto "github.com/hyperledger/burrow/crypto"
 	errors "github.com/hyperledger/burrow/execution/errors"
 	names "github.com/hyperledger/burrow/execution/names"
+	slashing "github.com/hyperledger/burrow/execution/slashing"
 	spec "github.com/hyperledger/burrow/genesis/spec"
 	permission "github.com/hyperledger/burrow/permission"
 	github_com_hyperledger_burrow_txs "github.com/hyperledger/burrow/txs"
@@ -455,12 +456,14 @@ func (*TxHeader) XXX_MessageName() string {
 
 type BlockExecution struct {
 	// The height of this block
-	Height               uint64         `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
-	Header               *types.Header  `protobuf:"bytes,2,opt,name=Header,proto3" json:"Header,omitempty"`
-	TxExecutions         []*TxExecution `protobuf:"bytes,3,rep,name=TxExecutions,proto3" json:"TxExecutions,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	Height       uint64         `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
+	Header       *types.Header  `protobuf:"bytes,2,opt,name=Header,proto3" json:"Header,omitempty"`
+	TxExecutions []*TxExecution `protobuf:"bytes,3,rep,name=TxExecutions,proto3" json:"TxExecutions,omitempty"`
+	// Events that arise from the block itself rather than from any transaction (e.g. slashing)
+	Events               []*Event `protobuf:"bytes,4,rep,name=Events,proto3" json:"Events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *BlockExecution) Reset()         { *m = BlockExecution{} }
@@ -513,6 +516,13 @@ func (m *BlockExecution) GetTxExecutions() []*TxExecution {
 	return nil
 }
 
+func (m *BlockExecution) GetEvents() []*Event {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
 func (*BlockExecution) XXX_MessageName() string {
 	return "exec.BlockExecution"
 }
@@ -837,6 +847,8 @@ type Event struct {
 	Call                 *CallEvent          `protobuf:"bytes,4,opt,name=Call,proto3" json:"Call,omitempty"`
 	Log                  *LogEvent           `protobuf:"bytes,5,opt,name=Log,proto3" json:"Log,omitempty"`
 	GovernAccount        *GovernAccountEvent `protobuf:"bytes,6,opt,name=GovernAccount,proto3" json:"GovernAccount,omitempty"`
+	Slash                *slashing.Slash     `protobuf:"bytes,7,opt,name=Slash,proto3" json:"Slash,omitempty"`
+	Payout               *PayoutEvent        `protobuf:"bytes,8,opt,name=Payout,proto3" json:"Payout,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
 	XXX_unrecognized     []byte              `json:"-"`
 	XXX_sizecache        int32               `json:"-"`
@@ -912,6 +924,20 @@ func (m *Event) GetGovernAccount() *GovernAccountEvent {
 	return nil
 }
 
+func (m *Event) GetSlash() *slashing.Slash {
+	if m != nil {
+		return m.Slash
+	}
+	return nil
+}
+
+func (m *Event) GetPayout() *PayoutEvent {
+	if m != nil {
+		return m.Payout
+	}
+	return nil
+}
+
 func (*Event) XXX_MessageName() string {
 	return "exec.Event"
 }
@@ -1146,6 +1172,75 @@ func (*GovernAccountEvent) XXX_MessageName() string {
 	return "exec.GovernAccountEvent"
 }
 
+// PayoutEvent records the fees and block reward paid to a validator at the end of a block in proportion to its power
+type PayoutEvent struct {
+	// The validator paid
+	Address github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	// The validator's power at the end of the block
+	Power uint64 `protobuf:"varint,2,opt,name=Power,proto3" json:"Power,omitempty"`
+	// The validator's share of the fees charged by transactions in the block
+	Fees uint64 `protobuf:"varint,3,opt,name=Fees,proto3" json:"Fees,omitempty"`
+	// The validator's share of the block reward
+	Reward               uint64   `protobuf:"varint,4,opt,name=Reward,proto3" json:"Reward,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PayoutEvent) Reset()         { *m = PayoutEvent{} }
+func (m *PayoutEvent) String() string { return proto.CompactTextString(m) }
+func (*PayoutEvent) ProtoMessage()    {}
+func (*PayoutEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d737c7315c25422, []int{17}
+}
+func (m *PayoutEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PayoutEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PayoutEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayoutEvent.Merge(m, src)
+}
+func (m *PayoutEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *PayoutEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayoutEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayoutEvent proto.InternalMessageInfo
+
+func (m *PayoutEvent) GetPower() uint64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+func (m *PayoutEvent) GetFees() uint64 {
+	if m != nil {
+		return m.Fees
+	}
+	return 0
+}
+
+func (m *PayoutEvent) GetReward() uint64 {
+	if m != nil {
+		return m.Reward
+	}
+	return 0
+}
+
+func (*PayoutEvent) XXX_MessageName() string {
+	return "exec.PayoutEvent"
+}
+
 type InputEvent struct {
 	Address              github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
 	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
@@ -1157,7 +1252,7 @@ func (m *InputEvent) Reset()         { *m = InputEvent{} }
 func (m *InputEvent) String() string { return proto.CompactTextString(m) }
 func (*InputEvent) ProtoMessage()    {}
 func (*InputEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4d737c7315c25422, []int{17}
+	return fileDescriptor_4d737c7315c25422, []int{18}
 }
 func (m *InputEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1197,7 +1292,7 @@ func (m *OutputEvent) Reset()         { *m = OutputEvent{} }
 func (m *OutputEvent) String() string { return proto.CompactTextString(m) }
 func (*OutputEvent) ProtoMessage()    {}
 func (*OutputEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4d737c7315c25422, []int{18}
+	return fileDescriptor_4d737c7315c25422, []int{19}
 }
 func (m *OutputEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1241,7 +1336,7 @@ func (m *CallData) Reset()         { *m = CallData{} }
 func (m *CallData) String() string { return proto.CompactTextString(m) }
 func (*CallData) ProtoMessage()    {}
 func (*CallData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4d737c7315c25422, []int{19}
+	return fileDescriptor_4d737c7315c25422, []int{20}
 }
 func (m *CallData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1318,6 +1413,8 @@ func init() {
 	golang_proto.RegisterType((*CallEvent)(nil), "exec.CallEvent")
 	proto.RegisterType((*GovernAccountEvent)(nil), "exec.GovernAccountEvent")
 	golang_proto.RegisterType((*GovernAccountEvent)(nil), "exec.GovernAccountEvent")
+	proto.RegisterType((*PayoutEvent)(nil), "exec.PayoutEvent")
+	golang_proto.RegisterType((*PayoutEvent)(nil), "exec.PayoutEvent")
 	proto.RegisterType((*InputEvent)(nil), "exec.InputEvent")
 	golang_proto.RegisterType((*InputEvent)(nil), "exec.InputEvent")
 	proto.RegisterType((*OutputEvent)(nil), "exec.OutputEvent")
@@ -1330,87 +1427,93 @@ func init() { proto.RegisterFile("exec.proto", fileDescriptor_4d737c7315c25422)
 func init() { golang_proto.RegisterFile("exec.proto", fileDescriptor_4d737c7315c25422) }
 
 var fileDescriptor_4d737c7315c25422 = []byte{
-	// 1275 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcf, 0x93, 0x13, 0xc5,
-	0x17, 0x67, 0x92, 0xc9, 0xaf, 0x4e, 0x96, 0x2f, 0x74, 0xf1, 0xa5, 0x52, 0x1c, 0x12, 0x1c, 0x14,
-	0x11, 0x61, 0x42, 0xad, 0xa2, 0x16, 0x56, 0x59, 0x12, 0x36, 0xc2, 0x0a, 0x82, 0x36, 0x01, 0x4b,
-	0x4b, 0x0f, 0x93, 0x99, 0x66, 0x32, 0x45, 0x32, 0x33, 0x35, 0xd3, 0xc1, 0xc9, 0x3f, 0xe0, 0xc1,
-	0xf2, 0xa0, 0x37, 0xbc, 0x58, 0xfc, 0x1f, 0x5e, 0x3c, 0xee, 0x4d, 0x8e, 0x16, 0x87, 0x68, 0x2d,
-	0x7f, 0x81, 0xe5, 0xc9, 0x3d, 0x59, 0xdd, 0xfd, 0x7a, 0xd2, 0x23, 0xcb, 0x2e, 0x65, 0xf6, 0xe0,
-	0x25, 0xd5, 0xef, 0xbd, 0x4f, 0xbf, 0xbc, 0x7e, 0xef, 0xf3, 0xde, 0x1b, 0x84, 0x68, 0x46, 0x5d,
-	0x3b, 0x4e, 0x22, 0x16, 0x61, 0x93, 0x9f, 0x4f, 0x9c, 0xf7, 0x03, 0x36, 0x9e, 0x8d, 0x6c, 0x37,
-	0x9a, 0xf6, 0xfc, 0xc8, 0x8f, 0x7a, 0xc2, 0x38, 0x9a, 0xdd, 0x13, 0x92, 0x10, 0xc4, 0x49, 0x5e,
-	0x3a, 0xf1, 0xb6, 0x06, 0x67, 0x34, 0xf4, 0x68, 0x32, 0x0d, 0x42, 0xa6, 0x1f, 0x9d, 0x91, 0x1b,
-	0xf4, 0xd8, 0x3c, 0xa6, 0xa9, 0xfc, 0x85, 0x8b, 0x5d, 0x3f, 0x8a, 0xfc, 0x09, 0x5d, 0xba, 0x67,
-	0xc1, 0x94, 0xa6, 0xcc, 0x99, 0xc6, 0x00, 0x68, 0xd1, 0x24, 0x89, 0x12, 0x05, 0x6f, 0x86, 0xce,
-	0x34, 0xbf, 0xdb, 0x60, 0x99, 0x3a, 0x1e, 0x89, 0xf9, 0xdf, 0xa4, 0x69, 0x10, 0x85, 0xa0, 0x41,
-	0x69, 0xac, 0x9e, 0x64, 0x0d, 0x50, 0xeb, 0x36, 0x4b, 0xa8, 0x33, 0x1d, 0x3c, 0xa0, 0x21, 0x4b,
-	0xf1, 0xc5, 0xa2, 0xdc, 0x36, 0x4e, 0x96, 0xcf, 0x34, 0xd7, 0x8f, 0xda, 0x22, 0x0b, 0x9a, 0x85,
-	0x14, 0x60, 0xd6, 0x4f, 0x25, 0xd4, 0xd4, 0x14, 0xf8, 0x02, 0x42, 0x7d, 0xea, 0x07, 0x61, 0x7f,
-	0x12, 0xb9, 0xf7, 0xdb, 0xc6, 0x49, 0xe3, 0x4c, 0x73, 0xfd, 0x88, 0x74, 0xb2, 0xd4, 0x13, 0x0d,
-	0x83, 0x5f, 0x45, 0x35, 0x21, 0x0d, 0xb3, 0x76, 0x49, 0xc0, 0xd7, 0x34, 0xf8, 0x30, 0x23, 0xca,
-	0x8a, 0x3f, 0x43, 0xf5, 0x41, 0xf8, 0x80, 0x4e, 0xa2, 0x98, 0xb6, 0xcb, 0x80, 0xe4, 0xaf, 0x55,
-	0xca, 0xbe, 0xfd, 0x64, 0xd1, 0x3d, 0xab, 0x25, 0x7d, 0x3c, 0x8f, 0x69, 0x32, 0xa1, 0x9e, 0x4f,
-	0x93, 0xde, 0x68, 0x96, 0x24, 0xd1, 0x57, 0x3d, 0x1d, 0x4f, 0x72, 0x77, 0xf8, 0x25, 0x54, 0x11,
-	0xe1, 0xb7, 0x4d, 0xe1, 0xb7, 0x29, 0x23, 0x90, 0xef, 0x95, 0x16, 0x01, 0x09, 0xbd, 0x61, 0xd6,
-	0xae, 0x14, 0x20, 0x5c, 0x45, 0xa4, 0x05, 0x9f, 0xe5, 0x01, 0x7a, 0xf2, 0xe5, 0x55, 0x81, 0x3a,
-	0x9c, 0xa3, 0xe4, 0xbb, 0x73, 0xfb, 0x25, 0x73, 0xeb, 0x51, 0xd7, 0xb0, 0xae, 0xeb, 0xd9, 0xc2,
-	0xc7, 0x51, 0xf5, 0x1a, 0x0d, 0xfc, 0x31, 0x13, 0x79, 0x33, 0x09, 0x48, 0xf8, 0x15, 0xae, 0x77,
-	0x3c, 0x9a, 0xe4, 0x09, 0x92, 0x6c, 0x91, 0x4a, 0x02, 0x46, 0xcb, 0x5a, 0xfe, 0xfd, 0xf3, 0x5c,
-	0x59, 0xdf, 0x1a, 0x79, 0xb6, 0x79, 0xb8, 0xc3, 0x0c, 0x1c, 0x1b, 0x7a, 0xb8, 0x4a, 0x4b, 0x72,
-	0x3b, 0x7e, 0x19, 0x55, 0x09, 0x4d, 0x67, 0x13, 0x06, 0x21, 0xb4, 0x24, 0x52, 0xea, 0x08, 0xd8,
-	0x70, 0x0f, 0x35, 0x06, 0x99, 0x4b, 0x63, 0x16, 0x44, 0x21, 0xa4, 0xf2, 0xa8, 0x0d, 0x5c, 0xcd,
-	0x0d, 0x64, 0x89, 0xb1, 0xee, 0x42, 0x52, 0xf1, 0x47, 0xa8, 0x3a, 0xcc, 0xae, 0x39, 0xe9, 0x58,
-	0x54, 0xb6, 0xd5, 0xbf, 0xb8, 0xb5, 0xe8, 0x1e, 0x7a, 0xb2, 0xe8, 0x9e, 0xdf, 0xbb, 0x9c, 0xa3,
-	0x20, 0x74, 0x92, 0xb9, 0x7d, 0x8d, 0x66, 0xfd, 0x39, 0xa3, 0x29, 0x01, 0x27, 0xd6, 0x5f, 0xc6,
-	0xf2, 0x6d, 0xf8, 0x43, 0xee, 0x7b, 0x38, 0x8f, 0xa9, 0x78, 0xe5, 0x5a, 0x7f, 0x7d, 0x67, 0xd1,
-	0xb5, 0xf7, 0xa5, 0x49, 0x2f, 0x76, 0xe6, 0x93, 0xc8, 0xf1, 0x6c, 0x7e, 0x93, 0x80, 0x07, 0x2d,
-	0xce, 0xd2, 0x01, 0xc4, 0xa9, 0x95, 0xa9, 0x5c, 0xa8, 0xf8, 0x31, 0x54, 0xd9, 0x0c, 0x3d, 0x9a,
-	0x89, 0x24, 0x9a, 0x44, 0x0a, 0xbc, 0x08, 0xb7, 0x92, 0xc0, 0x0f, 0x42, 0xe0, 0x20, 0x14, 0x41,
-	0xea, 0x08, 0xd8, 0xac, 0xaf, 0x0d, 0x74, 0x58, 0x90, 0x60, 0x90, 0x51, 0x77, 0xc6, 0xd3, 0xbc,
-	0x22, 0xb1, 0xf8, 0x68, 0x18, 0x66, 0xb9, 0xb7, 0xb4, 0x5d, 0xd6, 0x47, 0x83, 0x66, 0x21, 0x05,
-	0x98, 0xf5, 0x3e, 0x3a, 0xac, 0xc9, 0xd7, 0xe9, 0xfc, 0xb9, 0x71, 0x1c, 0x47, 0xd5, 0x5b, 0xf7,
-	0xee, 0xa5, 0x54, 0xb2, 0xcb, 0x24, 0x20, 0x59, 0x7f, 0x94, 0x50, 0x53, 0x73, 0x81, 0xcf, 0xe5,
-	0xf1, 0xee, 0xca, 0xd7, 0xbe, 0xf9, 0x78, 0xd1, 0x35, 0xf2, 0xb0, 0xf5, 0x79, 0x51, 0x3d, 0xd8,
-	0x79, 0x71, 0x0a, 0x55, 0x61, 0x4c, 0xd6, 0x44, 0x2e, 0x0a, 0x03, 0x03, 0x4c, 0x5a, 0xcf, 0xd4,
-	0xf7, 0xe8, 0x99, 0xd3, 0xa8, 0x46, 0xa8, 0x4b, 0x83, 0x98, 0xb5, 0x1b, 0x00, 0xe3, 0x7f, 0x0a,
-	0x3a, 0xa2, 0x8c, 0xc5, 0xde, 0x42, 0xfb, 0xf7, 0xd6, 0x33, 0x55, 0x6b, 0xbe, 0x58, 0xd5, 0xbe,
-	0x31, 0x14, 0xcb, 0x70, 0x1b, 0xd5, 0xae, 0x8c, 0x9d, 0x20, 0xdc, 0xdc, 0x10, 0xf9, 0x6e, 0x10,
-	0x25, 0x6a, 0x85, 0x2c, 0xed, 0xce, 0xdb, 0xb2, 0xce, 0xdb, 0x77, 0x90, 0x39, 0x0c, 0xa6, 0x14,
-	0x26, 0xc2, 0x09, 0x5b, 0xae, 0x37, 0x5b, 0xad, 0x37, 0x7b, 0xa8, 0xd6, 0x5b, 0xbf, 0xce, 0xdb,
-	0xe9, 0xbb, 0xdf, 0xba, 0x06, 0x11, 0x37, 0xac, 0x5f, 0x4a, 0xaa, 0xe2, 0xff, 0xe5, 0x2e, 0x7e,
-	0x1d, 0x35, 0x44, 0xc9, 0x45, 0x74, 0x65, 0x11, 0xdd, 0xda, 0xce, 0xa2, 0xbb, 0x54, 0x92, 0xe5,
-	0x91, 0x27, 0x55, 0x08, 0x9b, 0x1b, 0x22, 0x1f, 0x0d, 0xa2, 0x44, 0x2d, 0xa9, 0x95, 0xdd, 0x93,
-	0x5a, 0xd5, 0x93, 0x5a, 0xe0, 0x43, 0x6d, 0x7f, 0x3e, 0x5c, 0x32, 0x1f, 0x3e, 0xea, 0x1e, 0xb2,
-	0xbe, 0x2f, 0xc1, 0xaa, 0xe3, 0xf4, 0x2c, 0x34, 0x13, 0xd0, 0xf3, 0x1f, 0xbd, 0x7f, 0x9a, 0xff,
-	0x79, 0x3c, 0x53, 0x73, 0x1f, 0x56, 0xb9, 0x50, 0xc1, 0x7a, 0x14, 0x67, 0xfc, 0x1a, 0xaa, 0xde,
-	0x9a, 0x31, 0x0e, 0x2c, 0xab, 0x58, 0xc4, 0x6c, 0x12, 0x3a, 0xe8, 0x0b, 0x29, 0xe0, 0x53, 0xc8,
-	0xbc, 0xe2, 0x4c, 0x26, 0x40, 0x87, 0xff, 0x49, 0x20, 0xd7, 0x48, 0x98, 0x30, 0xe2, 0x93, 0xa8,
-	0x7c, 0x23, 0xf2, 0x61, 0xd0, 0x41, 0x9f, 0xdf, 0x88, 0x7c, 0x09, 0xe1, 0x26, 0xfc, 0x1e, 0x5a,
-	0xbb, 0x1a, 0x3d, 0xa0, 0x49, 0x78, 0xd9, 0x75, 0xa3, 0x59, 0xc8, 0xa0, 0xc7, 0xdb, 0x12, 0x5b,
-	0x30, 0xc9, 0x5b, 0x45, 0xf8, 0xa5, 0x3a, 0xcf, 0x87, 0xd8, 0xc2, 0x0f, 0x0d, 0xd5, 0xa9, 0xbc,
-	0x06, 0x84, 0xb2, 0x59, 0x12, 0x8a, 0xa4, 0xb4, 0x08, 0x48, 0xbc, 0x6a, 0x57, 0x9d, 0xf4, 0x4e,
-	0x4a, 0x3d, 0x60, 0xbc, 0x12, 0xf1, 0x59, 0xd4, 0xb8, 0xe9, 0x4c, 0xe9, 0x20, 0x64, 0xc9, 0x1c,
-	0xde, 0xde, 0xb2, 0xe5, 0x17, 0x99, 0xd0, 0x91, 0xa5, 0x19, 0x5f, 0x40, 0xf5, 0x8f, 0x69, 0x32,
-	0xbd, 0x9c, 0xf8, 0x29, 0xbc, 0xfe, 0x98, 0xad, 0x7d, 0xa4, 0x29, 0x1b, 0xc9, 0x51, 0xd6, 0x9f,
-	0x06, 0xaa, 0xab, 0x67, 0xe3, 0x9b, 0xa8, 0x76, 0xd9, 0xf3, 0x12, 0x9a, 0xa6, 0x32, 0xba, 0xfe,
-	0x9b, 0xc0, 0xdb, 0x73, 0x7b, 0xf3, 0xd6, 0x4d, 0xe6, 0x31, 0x8b, 0x6c, 0xb8, 0x4b, 0x94, 0x13,
-	0xbc, 0x89, 0xcc, 0x0d, 0x87, 0x39, 0xab, 0x35, 0x81, 0x70, 0x81, 0x6f, 0xa0, 0xea, 0x30, 0x8a,
-	0x03, 0x57, 0x2e, 0x87, 0x17, 0x8e, 0x0c, 0x9c, 0x7d, 0x1a, 0x25, 0xde, 0xfa, 0xc5, 0xb7, 0x08,
-	0xf8, 0xb0, 0x7e, 0x2c, 0xa1, 0x46, 0x4e, 0x08, 0x7c, 0x06, 0xd5, 0xb9, 0x20, 0xba, 0xab, 0x22,
-	0xba, 0xab, 0xb5, 0xb3, 0xe8, 0xe6, 0x3a, 0x92, 0x9f, 0xf8, 0x17, 0x0d, 0x3f, 0x8b, 0x47, 0x15,
-	0x36, 0x84, 0xd2, 0x92, 0xdc, 0xce, 0x23, 0x86, 0x65, 0x5a, 0x5a, 0x21, 0x97, 0x6a, 0x54, 0x76,
-	0x10, 0xba, 0xcd, 0x1c, 0xf7, 0xfe, 0x06, 0x8d, 0xd9, 0x18, 0xa6, 0x9f, 0xa6, 0xe1, 0x13, 0x07,
-	0x78, 0x65, 0xae, 0x34, 0x71, 0xa4, 0x13, 0xeb, 0x13, 0x84, 0x9f, 0x25, 0x38, 0x7e, 0x17, 0xad,
-	0x81, 0x7c, 0x27, 0xf6, 0x1c, 0x46, 0x21, 0x07, 0xff, 0xb7, 0xc5, 0x67, 0xff, 0x90, 0x4e, 0xe3,
-	0x89, 0xc3, 0x28, 0x40, 0x48, 0x11, 0x6b, 0x7d, 0x81, 0xd0, 0xb2, 0xab, 0x0f, 0x9a, 0x6a, 0xd6,
-	0x97, 0xa8, 0xa9, 0x8d, 0x82, 0x03, 0x77, 0xff, 0x43, 0x09, 0x15, 0x2a, 0xcb, 0xcf, 0x30, 0xd8,
-	0xfe, 0x75, 0x65, 0xa5, 0x8f, 0xdc, 0x1b, 0x5d, 0x8d, 0x27, 0xd2, 0x47, 0xde, 0x72, 0xe5, 0xd5,
-	0x5b, 0xee, 0x18, 0xaa, 0xdc, 0x75, 0x26, 0x33, 0xaa, 0xbe, 0x11, 0x85, 0x80, 0x8f, 0xa0, 0xf2,
-	0x55, 0x27, 0x85, 0x0d, 0xc2, 0x8f, 0xfd, 0x0f, 0xb6, 0xb6, 0x3b, 0xc6, 0xe3, 0xed, 0x8e, 0xf1,
-	0xeb, 0x76, 0xc7, 0xf8, 0x7d, 0xbb, 0x63, 0xfc, 0xfc, 0xb4, 0x63, 0x6c, 0x3d, 0xed, 0x18, 0x9f,
-	0xef, 0xf3, 0x04, 0xaa, 0x3e, 0x0a, 0xc4, 0x69, 0x54, 0x15, 0xfb, 0xfa, 0x8d, 0xbf, 0x03, 0x00,
-	0x00, 0xff, 0xff, 0xf5, 0x7c, 0x70, 0x97, 0x18, 0x0f, 0x00, 0x00,
+	// 1364 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4f, 0x8f, 0x13, 0xc7,
+	0x12, 0x67, 0xec, 0xb1, 0xd7, 0x2e, 0x7b, 0x17, 0x68, 0xed, 0x43, 0xd6, 0x1e, 0xec, 0x7d, 0xc3,
+	0x9f, 0xc7, 0xe3, 0xc1, 0x18, 0xed, 0x0b, 0x49, 0x44, 0xa4, 0x28, 0x98, 0x5d, 0x60, 0xc3, 0x06,
+	0x48, 0x63, 0x88, 0x12, 0x25, 0x87, 0x59, 0x4f, 0x33, 0x1e, 0x61, 0xcf, 0x8c, 0x66, 0xda, 0x60,
+	0x7f, 0x85, 0x28, 0x87, 0x1c, 0xc9, 0x25, 0xe1, 0x9e, 0x8f, 0x90, 0x4b, 0x8e, 0x7b, 0x0b, 0xc7,
+	0x88, 0x83, 0x13, 0x2d, 0x9f, 0x20, 0xca, 0x29, 0x9c, 0xa2, 0xee, 0xae, 0x1e, 0xb7, 0xc3, 0x9f,
+	0x45, 0xd9, 0x3d, 0xe4, 0x62, 0x75, 0x55, 0xfd, 0xba, 0xa6, 0xea, 0xd7, 0x55, 0xd5, 0x6d, 0x00,
+	0x36, 0x66, 0x3d, 0x37, 0x49, 0x63, 0x1e, 0x13, 0x5b, 0xac, 0x57, 0xce, 0x05, 0x21, 0xef, 0x8f,
+	0xb6, 0xdd, 0x5e, 0x3c, 0x6c, 0x07, 0x71, 0x10, 0xb7, 0xa5, 0x71, 0x7b, 0x74, 0x4f, 0x4a, 0x52,
+	0x90, 0x2b, 0xb5, 0x69, 0xe5, 0x1d, 0x03, 0xce, 0x59, 0xe4, 0xb3, 0x74, 0x18, 0x46, 0xdc, 0x5c,
+	0x7a, 0xdb, 0xbd, 0xb0, 0xcd, 0x27, 0x09, 0xcb, 0xd4, 0x2f, 0x6e, 0x6c, 0x05, 0x71, 0x1c, 0x0c,
+	0xd8, 0xcc, 0x3d, 0x0f, 0x87, 0x2c, 0xe3, 0xde, 0x30, 0x41, 0x40, 0x9d, 0xa5, 0x69, 0x9c, 0x6a,
+	0x78, 0x2d, 0xf2, 0x86, 0xf9, 0xde, 0x2a, 0x1f, 0xeb, 0xe5, 0x91, 0x44, 0x7c, 0x26, 0xcb, 0xc2,
+	0x38, 0x42, 0x0d, 0x64, 0x89, 0x4e, 0x69, 0x65, 0x29, 0x1b, 0x78, 0x59, 0x3f, 0x8c, 0x02, 0x25,
+	0x3b, 0x1b, 0x50, 0xbf, 0xcd, 0x53, 0xe6, 0x0d, 0x37, 0x1e, 0xb0, 0x88, 0x67, 0xe4, 0xc2, 0xbc,
+	0xdc, 0xb0, 0x56, 0x8b, 0xa7, 0x6b, 0x6b, 0x47, 0x5d, 0xc9, 0x8a, 0x61, 0xa1, 0x73, 0x30, 0xe7,
+	0x87, 0x02, 0xd4, 0x0c, 0x05, 0x39, 0x0f, 0xd0, 0x61, 0x41, 0x18, 0x75, 0x06, 0x71, 0xef, 0x7e,
+	0xc3, 0x5a, 0xb5, 0x4e, 0xd7, 0xd6, 0x8e, 0x28, 0x27, 0x33, 0x3d, 0x35, 0x30, 0xe4, 0x3f, 0xb0,
+	0x20, 0xa5, 0xee, 0xb8, 0x51, 0x90, 0xf0, 0x45, 0x03, 0xde, 0x1d, 0x53, 0x6d, 0x25, 0x9f, 0x42,
+	0x65, 0x23, 0x7a, 0xc0, 0x06, 0x71, 0xc2, 0x1a, 0x45, 0x44, 0x8a, 0xec, 0xb5, 0xb2, 0xe3, 0x3e,
+	0x9d, 0xb6, 0xce, 0x18, 0x87, 0xd0, 0x9f, 0x24, 0x2c, 0x1d, 0x30, 0x3f, 0x60, 0x69, 0x7b, 0x7b,
+	0x94, 0xa6, 0xf1, 0xc3, 0xb6, 0x89, 0xa7, 0xb9, 0x3b, 0xf2, 0x6f, 0x28, 0xc9, 0xf0, 0x1b, 0xb6,
+	0xf4, 0x5b, 0x53, 0x11, 0xa8, 0x7c, 0x95, 0x45, 0x42, 0x22, 0xbf, 0x3b, 0x6e, 0x94, 0xe6, 0x20,
+	0x42, 0x45, 0x95, 0x85, 0x9c, 0x11, 0x01, 0xfa, 0x2a, 0xf3, 0xb2, 0x44, 0x2d, 0xe5, 0x28, 0x95,
+	0x77, 0x6e, 0xbf, 0x68, 0xef, 0x3c, 0x6e, 0x59, 0xce, 0x75, 0x93, 0x2d, 0x72, 0x0c, 0xca, 0xd7,
+	0x58, 0x18, 0xf4, 0xb9, 0xe4, 0xcd, 0xa6, 0x28, 0x91, 0x93, 0x42, 0xef, 0xf9, 0x2c, 0xcd, 0x09,
+	0x52, 0xd5, 0xa3, 0x94, 0x14, 0x8d, 0x8e, 0x33, 0xfb, 0xfc, 0xab, 0x5c, 0x39, 0x5f, 0x59, 0x39,
+	0xdb, 0x22, 0xdc, 0xee, 0x18, 0x1d, 0x5b, 0x66, 0xb8, 0x5a, 0x4b, 0x73, 0x3b, 0x39, 0x01, 0x65,
+	0xca, 0xb2, 0xd1, 0x80, 0x63, 0x08, 0x75, 0x85, 0x54, 0x3a, 0x8a, 0x36, 0xd2, 0x86, 0xea, 0xc6,
+	0xb8, 0xc7, 0x12, 0x1e, 0xc6, 0x11, 0x52, 0x79, 0xd4, 0xc5, 0xda, 0xcd, 0x0d, 0x74, 0x86, 0x71,
+	0xee, 0x22, 0xa9, 0xe4, 0x23, 0x28, 0x77, 0xc7, 0xd7, 0xbc, 0xac, 0x2f, 0x4f, 0xb6, 0xde, 0xb9,
+	0xb0, 0x33, 0x6d, 0x1d, 0x7a, 0x3a, 0x6d, 0x9d, 0x7b, 0xfd, 0x71, 0x6e, 0x87, 0x91, 0x97, 0x4e,
+	0xdc, 0x6b, 0x6c, 0xdc, 0x99, 0x70, 0x96, 0x51, 0x74, 0xe2, 0xfc, 0x61, 0xcd, 0x72, 0x23, 0x1f,
+	0x0a, 0xdf, 0xdd, 0x49, 0xc2, 0x64, 0x96, 0x8b, 0x9d, 0xb5, 0xe7, 0xd3, 0x96, 0xbb, 0x67, 0x99,
+	0xb4, 0x13, 0x6f, 0x32, 0x88, 0x3d, 0xdf, 0x15, 0x3b, 0x29, 0x7a, 0x30, 0xe2, 0x2c, 0x1c, 0x40,
+	0x9c, 0xc6, 0x31, 0x15, 0xe7, 0x4e, 0x7c, 0x19, 0x4a, 0x9b, 0x91, 0xcf, 0xc6, 0x92, 0x44, 0x9b,
+	0x2a, 0x41, 0x1c, 0xc2, 0xcd, 0x34, 0x0c, 0xc2, 0xa8, 0x51, 0x32, 0x0f, 0x41, 0xe9, 0x28, 0xda,
+	0x9c, 0xef, 0x2d, 0x58, 0x92, 0x45, 0xb0, 0x31, 0x66, 0xbd, 0x91, 0xa0, 0x79, 0x9f, 0x85, 0x25,
+	0x46, 0x43, 0x77, 0x9c, 0x7b, 0xcb, 0x1a, 0x45, 0x73, 0x34, 0x18, 0x16, 0x3a, 0x07, 0x23, 0xc7,
+	0xa1, 0x8c, 0xb3, 0xc4, 0x5e, 0x2d, 0x1a, 0x2d, 0x23, 0xbb, 0x0a, 0x4d, 0xce, 0x07, 0xb0, 0x64,
+	0x6c, 0xba, 0xce, 0x26, 0xaf, 0x0c, 0xf6, 0x18, 0x94, 0x6f, 0xde, 0xbb, 0x97, 0x31, 0x55, 0x82,
+	0x36, 0x45, 0xc9, 0xf9, 0xad, 0x00, 0x35, 0xc3, 0x05, 0x39, 0x9b, 0x27, 0xf5, 0xd2, 0xa2, 0xee,
+	0xd8, 0x4f, 0xa6, 0x2d, 0x2b, 0xcf, 0xcd, 0x1c, 0x2a, 0xe5, 0x83, 0x1d, 0x2a, 0xb3, 0xfc, 0x17,
+	0x5e, 0x99, 0xbf, 0xd1, 0x58, 0x95, 0xd7, 0x34, 0xd6, 0x29, 0x58, 0xa0, 0xac, 0xc7, 0xc2, 0x84,
+	0x37, 0xaa, 0x08, 0x13, 0x1f, 0x45, 0x1d, 0xd5, 0xc6, 0xf9, 0x06, 0x84, 0xbd, 0x1b, 0xf0, 0x85,
+	0xa3, 0xad, 0xbd, 0xd1, 0xd1, 0x3a, 0x5f, 0x5a, 0xba, 0x14, 0x49, 0x03, 0x16, 0x2e, 0xf7, 0xbd,
+	0x30, 0xda, 0x5c, 0x97, 0x7c, 0x57, 0xa9, 0x16, 0x8d, 0x83, 0x2c, 0xbc, 0xbc, 0xb8, 0x8b, 0x66,
+	0x71, 0xbf, 0x0b, 0x76, 0x37, 0x1c, 0x32, 0x1c, 0x1b, 0x2b, 0xae, 0xba, 0x13, 0x5d, 0x7d, 0x27,
+	0xba, 0x5d, 0x7d, 0x27, 0x76, 0x2a, 0xa2, 0xe7, 0xbe, 0xfe, 0xa5, 0x65, 0x51, 0xb9, 0xc3, 0xf9,
+	0xa9, 0x00, 0xe5, 0x7f, 0x7e, 0xab, 0xff, 0x0f, 0xaa, 0xf2, 0xc8, 0x65, 0x74, 0x45, 0x19, 0xdd,
+	0xe2, 0xf3, 0x69, 0x6b, 0xa6, 0xa4, 0xb3, 0xa5, 0x20, 0x55, 0x0a, 0x9b, 0xeb, 0x92, 0x8f, 0x2a,
+	0xd5, 0xa2, 0x41, 0x6a, 0xe9, 0xe5, 0xa4, 0x96, 0x4d, 0x52, 0xe7, 0xea, 0x61, 0x61, 0xef, 0x7a,
+	0xb8, 0x68, 0x3f, 0x7a, 0xdc, 0x3a, 0xe4, 0x3c, 0x2b, 0xe0, 0x7d, 0x48, 0x4e, 0x68, 0x6a, 0x1b,
+	0x96, 0x59, 0x9e, 0x7f, 0x19, 0x10, 0xa7, 0xc4, 0xc7, 0x93, 0x91, 0xbe, 0x1c, 0xf0, 0xbe, 0x97,
+	0x2a, 0xbc, 0x43, 0xe5, 0x9a, 0xfc, 0x17, 0xca, 0x37, 0x47, 0x5c, 0x00, 0x8b, 0x3a, 0x16, 0x39,
+	0xc0, 0x46, 0x3c, 0x47, 0x22, 0x80, 0x1c, 0x07, 0xfb, 0xb2, 0x37, 0x18, 0x60, 0x39, 0x1c, 0x56,
+	0x40, 0xa1, 0x51, 0x30, 0x69, 0x24, 0xab, 0x50, 0xdc, 0x8a, 0x83, 0x46, 0xc9, 0xec, 0xf3, 0xad,
+	0x38, 0x50, 0x10, 0x61, 0x22, 0xef, 0xc3, 0xe2, 0xd5, 0xf8, 0x01, 0x4b, 0xa3, 0x4b, 0xbd, 0x5e,
+	0x3c, 0x8a, 0x38, 0xf6, 0x78, 0x43, 0x61, 0xe7, 0x4c, 0x6a, 0xd7, 0x3c, 0x9c, 0x9c, 0x84, 0xd2,
+	0x6d, 0xf1, 0x6e, 0x42, 0xf2, 0x0e, 0xbb, 0xf9, 0x2b, 0x4a, 0xaa, 0xa9, 0xb2, 0x8a, 0xc4, 0x6e,
+	0x79, 0x93, 0x78, 0xa4, 0xbb, 0x18, 0x13, 0x53, 0x3a, 0x4c, 0x4c, 0x09, 0x17, 0x2b, 0x82, 0x61,
+	0x79, 0xf9, 0x3f, 0xb2, 0x74, 0xef, 0x8b, 0x53, 0xa5, 0x8c, 0x8f, 0xd2, 0x48, 0xd2, 0x5c, 0xa7,
+	0x28, 0x89, 0x3a, 0xb8, 0xea, 0x65, 0x77, 0x32, 0xe6, 0x63, 0x0f, 0x69, 0x91, 0x9c, 0x81, 0xea,
+	0x0d, 0x6f, 0xc8, 0x36, 0x22, 0x9e, 0x4e, 0x90, 0xcd, 0xba, 0xab, 0x1e, 0x86, 0x52, 0x47, 0x67,
+	0x66, 0x72, 0x1e, 0x2a, 0xb7, 0x58, 0x3a, 0xbc, 0x94, 0x06, 0x19, 0xf2, 0xb9, 0xec, 0x1a, 0x6f,
+	0x45, 0x6d, 0xa3, 0x39, 0xca, 0xf9, 0xdd, 0x82, 0x8a, 0x26, 0x92, 0xdc, 0x80, 0x85, 0x4b, 0xbe,
+	0x9f, 0xb2, 0x2c, 0x53, 0xd1, 0x75, 0xde, 0xc2, 0x4e, 0x38, 0xfb, 0xfa, 0x4e, 0xe8, 0xa5, 0x93,
+	0x84, 0xc7, 0x2e, 0xee, 0xa5, 0xda, 0x09, 0xd9, 0x04, 0x7b, 0xdd, 0xe3, 0xde, 0xfe, 0xda, 0x4a,
+	0xba, 0x20, 0x5b, 0x50, 0xee, 0xc6, 0x49, 0xd8, 0x53, 0x77, 0xd2, 0x1b, 0x47, 0x86, 0xce, 0x3e,
+	0x89, 0x53, 0x7f, 0xed, 0xc2, 0xdb, 0x14, 0x7d, 0x38, 0xdf, 0x16, 0xa0, 0x9a, 0x97, 0x18, 0x39,
+	0x0d, 0x15, 0x21, 0xc8, 0x7e, 0x2d, 0xc9, 0x7e, 0xad, 0x3f, 0x9f, 0xb6, 0x72, 0x1d, 0xcd, 0x57,
+	0xe2, 0x21, 0x25, 0xd6, 0x32, 0xa9, 0xb9, 0x3b, 0x47, 0x6b, 0x69, 0x6e, 0x27, 0x5b, 0x7a, 0x70,
+	0x62, 0xfa, 0x7f, 0x8f, 0x4b, 0x3d, 0x7c, 0x9b, 0x00, 0xb7, 0xb9, 0xd7, 0xbb, 0xbf, 0xce, 0x12,
+	0xde, 0xc7, 0x79, 0x6a, 0x68, 0xc4, 0x0c, 0xc3, 0xba, 0xb2, 0xf7, 0x35, 0xc3, 0x94, 0x13, 0xe7,
+	0x63, 0x20, 0x2f, 0xb6, 0x0c, 0x79, 0x0f, 0x16, 0x51, 0xbe, 0x93, 0xf8, 0x1e, 0x67, 0xc8, 0xc1,
+	0xbf, 0x5c, 0xf9, 0xef, 0xa3, 0xcb, 0x86, 0xc9, 0xc0, 0xe3, 0x0c, 0x21, 0x74, 0x1e, 0xeb, 0x7c,
+	0x67, 0x41, 0xcd, 0x68, 0x93, 0x03, 0x2f, 0xb6, 0x65, 0x28, 0xdd, 0x8a, 0x1f, 0xe2, 0x0b, 0xc7,
+	0xa6, 0x4a, 0x20, 0x04, 0xec, 0x2b, 0x8c, 0x65, 0xc8, 0x98, 0x5c, 0xab, 0x1e, 0x7c, 0xe8, 0xa5,
+	0x3e, 0x3e, 0xba, 0x50, 0x72, 0x3e, 0x07, 0x98, 0x4d, 0xb2, 0x83, 0x8e, 0xcf, 0xf9, 0x02, 0x6a,
+	0xc6, 0xf8, 0x3b, 0x70, 0xf7, 0xdf, 0x14, 0x60, 0xae, 0xf6, 0xc4, 0x9a, 0xa5, 0xfb, 0xf2, 0x8d,
+	0x3e, 0x72, 0x6f, 0x6c, 0x7f, 0x95, 0xac, 0x7c, 0xe4, 0x43, 0xa1, 0xb8, 0xff, 0xa1, 0xb0, 0x0c,
+	0xa5, 0xbb, 0xde, 0x60, 0xc4, 0xf4, 0xe3, 0x59, 0x0a, 0xe4, 0x08, 0x14, 0xaf, 0x7a, 0x19, 0xde,
+	0x9a, 0x62, 0xd9, 0xb9, 0xb2, 0xb3, 0xdb, 0xb4, 0x9e, 0xec, 0x36, 0xad, 0x9f, 0x77, 0x9b, 0xd6,
+	0xaf, 0xbb, 0x4d, 0xeb, 0xc7, 0x67, 0x4d, 0x6b, 0xe7, 0x59, 0xd3, 0xfa, 0x6c, 0x8f, 0x14, 0x98,
+	0x7e, 0x08, 0xc9, 0xd5, 0x76, 0x59, 0xbe, 0x51, 0xfe, 0xff, 0xe7, 0x00, 0x77, 0x5f, 0xd5, 0xfe,
+	0x41, 0x10, 0x00, 0x00,
 }
 
 func (m *StreamEvents) Marshal() (dAtA []byte, err error) {
@@ -1765,6 +1868,18 @@ func (m *BlockExecution) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.Events) > 0 {
+		for _, msg := range m.Events {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintExec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -2082,6 +2197,26 @@ func (m *Event) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n28
 	}
+	if m.Slash != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintExec(dAtA, i, uint64(m.Slash.Size()))
+		n29, err := m.Slash.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n29
+	}
+	if m.Payout != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintExec(dAtA, i, uint64(m.Payout.Size()))
+		n30, err := m.Payout.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n30
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -2118,21 +2253,21 @@ func (m *Result) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintExec(dAtA, i, uint64(m.NameEntry.Size()))
-		n29, err := m.NameEntry.MarshalTo(dAtA[i:])
+		n31, err := m.NameEntry.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n29
+		i += n31
 	}
 	if m.PermArgs != nil {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintExec(dAtA, i, uint64(m.PermArgs.Size()))
-		n30, err := m.PermArgs.MarshalTo(dAtA[i:])
+		n32, err := m.PermArgs.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n30
+		i += n32
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2158,19 +2293,19 @@ func (m *LogEvent) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintExec(dAtA, i, uint64(m.Address.Size()))
-	n31, err := m.Address.MarshalTo(dAtA[i:])
+	n33, err := m.Address.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n31
+	i += n33
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintExec(dAtA, i, uint64(m.Data.Size()))
-	n32, err := m.Data.MarshalTo(dAtA[i:])
+	n34, err := m.Data.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n32
+	i += n34
 	if len(m.Topics) > 0 {
 		for _, msg := range m.Topics {
 			dAtA[i] = 0x1a
@@ -2208,20 +2343,20 @@ func (m *CallEvent) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintExec(dAtA, i, uint64(m.CallData.Size()))
-		n33, err := m.CallData.MarshalTo(dAtA[i:])
+		n35, err := m.CallData.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n33
+		i += n35
 	}
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintExec(dAtA, i, uint64(m.Origin.Size()))
-	n34, err := m.Origin.MarshalTo(dAtA[i:])
+	n36, err := m.Origin.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n34
+	i += n36
 	if m.StackDepth != 0 {
 		dAtA[i] = 0x18
 		i++
@@ -2230,11 +2365,11 @@ func (m *CallEvent) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x22
 	i++
 	i = encodeVarintExec(dAtA, i, uint64(m.Return.Size()))
-	n35, err := m.Return.MarshalTo(dAtA[i:])
+	n37, err := m.Return.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n35
+	i += n37
 	if m.CallType != 0 {
 		dAtA[i] = 0x28
 		i++
@@ -2265,11 +2400,55 @@ func (m *GovernAccountEvent) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintExec(dAtA, i, uint64(m.AccountUpdate.Size()))
-		n36, err := m.AccountUpdate.MarshalTo(dAtA[i:])
+		n38, err := m.AccountUpdate.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n36
+		i += n38
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *PayoutEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayoutEvent) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintExec(dAtA, i, uint64(m.Address.Size()))
+	n39, err := m.Address.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n39
+	if m.Power != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintExec(dAtA, i, uint64(m.Power))
+	}
+	if m.Fees != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintExec(dAtA, i, uint64(m.Fees))
+	}
+	if m.Reward != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintExec(dAtA, i, uint64(m.Reward))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2295,11 +2474,11 @@ func (m *InputEvent) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintExec(dAtA, i, uint64(m.Address.Size()))
-	n37, err := m.Address.MarshalTo(dAtA[i:])
+	n40, err := m.Address.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n37
+	i += n40
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -2324,11 +2503,11 @@ func (m *OutputEvent) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintExec(dAtA, i, uint64(m.Address.Size()))
-	n38, err := m.Address.MarshalTo(dAtA[i:])
+	n41, err := m.Address.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n38
+	i += n41
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -2353,27 +2532,27 @@ func (m *CallData) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintExec(dAtA, i, uint64(m.Caller.Size()))
-	n39, err := m.Caller.MarshalTo(dAtA[i:])
+	n42, err := m.Caller.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n39
+	i += n42
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintExec(dAtA, i, uint64(m.Callee.Size()))
-	n40, err := m.Callee.MarshalTo(dAtA[i:])
+	n43, err := m.Callee.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n40
+	i += n43
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintExec(dAtA, i, uint64(m.Data.Size()))
-	n41, err := m.Data.MarshalTo(dAtA[i:])
+	n44, err := m.Data.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n41
+	i += n44
 	if m.Value != 0 {
 		dAtA[i] = 0x20
 		i++
@@ -2571,6 +2750,12 @@ func (m *BlockExecution) Size() (n int) {
 			n += 1 + l + sovExec(uint64(l))
 		}
 	}
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovExec(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2727,6 +2912,14 @@ func (m *Event) Size() (n int) {
 		l = m.GovernAccount.Size()
 		n += 1 + l + sovExec(uint64(l))
 	}
+	if m.Slash != nil {
+		l = m.Slash.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.Payout != nil {
+		l = m.Payout.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2824,6 +3017,29 @@ func (m *GovernAccountEvent) Size() (n int) {
 	return n
 }
 
+func (m *PayoutEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Address.Size()
+	n += 1 + l + sovExec(uint64(l))
+	if m.Power != 0 {
+		n += 1 + sovExec(uint64(m.Power))
+	}
+	if m.Fees != 0 {
+		n += 1 + sovExec(uint64(m.Fees))
+	}
+	if m.Reward != 0 {
+		n += 1 + sovExec(uint64(m.Reward))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *InputEvent) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2954,6 +3170,12 @@ func (this *Event) GetValue() interface{} {
 	if this.GovernAccount != nil {
 		return this.GovernAccount
 	}
+	if this.Slash != nil {
+		return this.Slash
+	}
+	if this.Payout != nil {
+		return this.Payout
+	}
 	return nil
 }
 
@@ -2971,6 +3193,10 @@ func (this *Event) SetValue(value interface{}) bool {
 		this.Log = vt
 	case *GovernAccountEvent:
 		this.GovernAccount = vt
+	case *slashing.Slash:
+		this.Slash = vt
+	case *PayoutEvent:
+		this.Payout = vt
 	default:
 		return false
 	}
@@ -4063,6 +4289,40 @@ func (m *BlockExecution) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, &Event{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExec(dAtA[iNdEx:])
@@ -5115,6 +5375,78 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slash", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Slash == nil {
+				m.Slash = &slashing.Slash{}
+			}
+			if err := m.Slash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Payout == nil {
+				m.Payout = &PayoutEvent{}
+			}
+			if err := m.Payout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExec(dAtA[iNdEx:])
@@ -5758,6 +6090,150 @@ func (m *GovernAccountEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PayoutEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PayoutEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PayoutEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			m.Fees = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Fees |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+			}
+			m.Reward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthExec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *InputEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
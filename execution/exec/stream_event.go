@@ -86,6 +86,9 @@ func (ba *BlockAccumulator) Consume(ev *StreamEvent) (*BlockExecution, error) {
 			Height: ev.BeginBlock.Height,
 			Header: ev.BeginBlock.Header,
 		}
+	case ev.Event != nil && len(ba.stack) == 0:
+		// Events outside of any transaction belong to the block
+		ba.block.Events = append(ba.block.Events, ev.Event)
 	case ev.BeginTx != nil, ev.Envelope != nil, ev.Event != nil, ev.EndTx != nil:
 		txe, err := ba.stack.Consume(ev)
 		if err != nil {
@@ -31,11 +31,13 @@ import (
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/event"
 	"github.com/hyperledger/burrow/execution/contexts"
+	"github.com/hyperledger/burrow/execution/delegation"
 	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/execution/evm"
 	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/execution/names"
 	"github.com/hyperledger/burrow/execution/proposal"
+	"github.com/hyperledger/burrow/execution/slashing"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/logging/structure"
 	"github.com/hyperledger/burrow/permission"
@@ -60,6 +62,8 @@ type ExecutorState interface {
 	proposal.Reader
 	acmstate.IterableReader
 	validator.IterableReader
+	slashing.IterableReader
+	delegation.IterableReader
 }
 
 type BatchExecutor interface {
@@ -78,6 +82,10 @@ type BatchCommitter interface {
 	BatchExecutor
 	// Commit execution results to underlying State and provide opportunity to mutate state before it is saved
 	Commit(header *abciTypes.Header) (stateHash []byte, err error)
+	// Apply any changes Tendermint requires at the beginning of a block (e.g. punishing misbehaving validators)
+	BeginBlock(block *abciTypes.RequestBeginBlock) error
+	// Apply any changes due at the end of a block once its transactions have run (e.g. paying validators)
+	EndBlock(block *abciTypes.RequestEndBlock) error
 }
 
 type executor struct {
@@ -89,6 +97,11 @@ type executor struct {
 	nameRegCache     *names.Cache
 	proposalRegCache *proposal.Cache
 	validatorCache   *validator.Cache
+	slashingCache    *slashing.Cache
+	delegationCache  *delegation.Cache
+	slashingContext  *contexts.SlashingContext
+	fees             *contexts.Fees
+	payoutContext    *contexts.PayoutContext
 	emitter          *event.Emitter
 	block            *exec.BlockExecution
 	logger           *logging.Logger
@@ -99,13 +112,64 @@ type executor struct {
 type Params struct {
 	ChainID           string
 	ProposalThreshold uint64
+	// Nil for the VM default
+	GasSchedule *evm.GasSchedule
+	Hardfork    evm.Hardfork
+	Slashing    slashing.Params
+	// Whether fees are charged to NameTx and paid, along with BlockReward, to the validators at the end of each block
+	PayoutFees bool
+	// Native token minted for the validators at the end of each block
+	BlockReward uint64
+	// Limits on the validator set enforced when bonding and governing power
+	ValidatorPolicy validator.Policy
 }
 
-func ParamsFromGenesis(genesisDoc *genesis.GenesisDoc) Params {
+func ParamsFromGenesis(genesisDoc *genesis.GenesisDoc) (Params, error) {
+	hardfork := evm.Hardfork(genesisDoc.Params.Hardfork)
+	err := hardfork.Validate()
+	if err != nil {
+		return Params{}, err
+	}
+	gasSchedule, err := GasScheduleFromGenesis(genesisDoc)
+	if err != nil {
+		return Params{}, err
+	}
+	slashFraction, err := slashing.ParseFraction(genesisDoc.Params.SlashFraction)
+	if err != nil {
+		return Params{}, err
+	}
+	maxPowerFraction, err := slashing.ParseFraction(genesisDoc.Params.MaxPowerFraction)
+	if err != nil {
+		return Params{}, err
+	}
+	if genesisDoc.Params.BlockReward > 0 && !genesisDoc.Params.PayoutFees {
+		return Params{}, fmt.Errorf("BlockReward of %d is set but would never be paid since PayoutFees is not set",
+			genesisDoc.Params.BlockReward)
+	}
 	return Params{
 		ChainID:           genesisDoc.ChainID(),
 		ProposalThreshold: genesisDoc.Params.ProposalThreshold,
-	}
+		GasSchedule:       gasSchedule,
+		Hardfork:          hardfork,
+		Slashing: slashing.Params{
+			Fraction:        slashFraction,
+			JailBlocks:      genesisDoc.Params.JailBlocks,
+			UnbondingBlocks: genesisDoc.Params.UnbondingBlocks,
+		},
+		PayoutFees:  genesisDoc.Params.PayoutFees,
+		BlockReward: genesisDoc.Params.BlockReward,
+		ValidatorPolicy: validator.Policy{
+			MaxValidators:      genesisDoc.Params.MaxValidators,
+			MinBond:            genesisDoc.Params.MinBond,
+			MaxPowerFraction:   maxPowerFraction,
+			MaxChangesPerBlock: genesisDoc.Params.MaxValidatorChanges,
+		},
+	}, nil
+}
+
+// Returns the gas schedule named in genesis (legacy if none is named) as it applies under the genesis hardfork
+func GasScheduleFromGenesis(genesisDoc *genesis.GenesisDoc) (*evm.GasSchedule, error) {
+	return evm.GasScheduleByName(genesisDoc.Params.GasSchedule, evm.Hardfork(genesisDoc.Params.Hardfork))
 }
 
 var _ BatchExecutor = (*executor)(nil)
@@ -135,7 +199,9 @@ func newExecutor(name string, runCall bool, params Params, backend ExecutorState
 		stateCache:       acmstate.NewCache(backend, acmstate.Named(name)),
 		nameRegCache:     names.NewCache(backend),
 		proposalRegCache: proposal.NewCache(backend),
-		validatorCache:   validator.NewCache(backend),
+		validatorCache:   validator.NewCache(backend, validator.WithPolicy(params.ValidatorPolicy)),
+		slashingCache:    slashing.NewCache(backend),
+		delegationCache:  delegation.NewCache(backend),
 		emitter:          emitter,
 		block: &exec.BlockExecution{
 			Height: blockchain.LastBlockHeight() + 1,
@@ -146,6 +212,32 @@ func newExecutor(name string, runCall bool, params Params, backend ExecutorState
 		option(exe)
 	}
 
+	// Changes to stake that is tracked by delegations must accumulate within a block to stay in step with them
+	pending := exe.validatorCache.Pending()
+
+	exe.slashingContext = &contexts.SlashingContext{
+		StateWriter:  exe.stateCache,
+		ValidatorSet: pending,
+		Policy:       exe.validatorCache,
+		Slashing:     exe.slashingCache,
+		Delegations:  exe.delegationCache,
+		Params:       params.Slashing,
+		Logger:       exe.logger,
+	}
+
+	// Paying out fees changes the state computed for a chain so must be enabled from genesis, otherwise fees are not
+	// collected and there is no payout
+	if params.PayoutFees {
+		exe.fees = new(contexts.Fees)
+		exe.payoutContext = &contexts.PayoutContext{
+			StateWriter:  exe.stateCache,
+			ValidatorSet: exe.validatorCache,
+			Fees:         exe.fees,
+			BlockReward:  params.BlockReward,
+			Logger:       exe.logger,
+		}
+	}
+
 	baseContexts := map[payload.Type]contexts.Context{
 		payload.TypeSend: &contexts.SendContext{
 			StateWriter: exe.stateCache,
@@ -156,12 +248,16 @@ func newExecutor(name string, runCall bool, params Params, backend ExecutorState
 			StateWriter: exe.stateCache,
 			RunCall:     runCall,
 			VMOptions:   exe.vmOptions,
+			GasSchedule: params.GasSchedule,
+			Hardfork:    params.Hardfork,
+			Fees:        exe.fees,
 			Logger:      exe.logger,
 		},
 		payload.TypeName: &contexts.NameContext{
 			Blockchain:  blockchain,
 			StateWriter: exe.stateCache,
 			NameReg:     exe.nameRegCache,
+			Fees:        exe.fees,
 			Logger:      exe.logger,
 		},
 		payload.TypePermissions: &contexts.PermissionsContext{
@@ -170,19 +266,45 @@ func newExecutor(name string, runCall bool, params Params, backend ExecutorState
 		},
 		payload.TypeGovernance: &contexts.GovernanceContext{
 			ValidatorSet: exe.validatorCache,
+			Policy:       exe.validatorCache,
 			StateWriter:  exe.stateCache,
 			Logger:       exe.logger,
 		},
 		payload.TypeBond: &contexts.BondContext{
 			ValidatorSet: exe.validatorCache,
+			Policy:       exe.validatorCache,
+			Slashing:     exe.slashingCache,
 			StateWriter:  exe.stateCache,
 			Logger:       exe.logger,
 		},
 		payload.TypeUnbond: &contexts.UnbondContext{
-			ValidatorSet: exe.validatorCache,
+			Blockchain:      blockchain,
+			ValidatorSet:    pending,
+			Policy:          exe.validatorCache,
+			StateWriter:     exe.stateCache,
+			Slashing:        exe.slashingCache,
+			Delegations:     exe.delegationCache,
+			UnbondingBlocks: params.Slashing.UnbondingBlocks,
+			Logger:          exe.logger,
+		},
+		payload.TypeDelegate: &contexts.DelegateContext{
+			ValidatorSet: pending,
+			Policy:       exe.validatorCache,
 			StateWriter:  exe.stateCache,
+			Slashing:     exe.slashingCache,
+			Delegations:  exe.delegationCache,
 			Logger:       exe.logger,
 		},
+		payload.TypeUndelegate: &contexts.UndelegateContext{
+			Blockchain:      blockchain,
+			ValidatorSet:    pending,
+			Policy:          exe.validatorCache,
+			StateWriter:     exe.stateCache,
+			Slashing:        exe.slashingCache,
+			Delegations:     exe.delegationCache,
+			UnbondingBlocks: params.Slashing.UnbondingBlocks,
+			Logger:          exe.logger,
+		},
 	}
 
 	exe.contexts = map[payload.Type]contexts.Context{
@@ -364,6 +486,14 @@ func (exe *executor) Commit(header *abciTypes.Header) (stateHash []byte, err err
 		if err != nil {
 			return err
 		}
+		err = exe.slashingCache.Flush(ws, exe.state)
+		if err != nil {
+			return err
+		}
+		err = exe.delegationCache.Flush(ws, exe.state)
+		if err != nil {
+			return err
+		}
 		err = ws.AddBlock(blockExecution)
 		if err != nil {
 			return err
@@ -373,6 +503,9 @@ func (exe *executor) Commit(header *abciTypes.Header) (stateHash []byte, err err
 	if err != nil {
 		return nil, err
 	}
+	// Unlike the other caches the validator cache reads its backend eagerly so must be reset again once the writes
+	// above are readable from state
+	exe.validatorCache.Reset(exe.state)
 	expectedHeight := HeightAtVersion(version)
 	if expectedHeight != height {
 		return nil, fmt.Errorf("expected height at state tree version %d is %d but actual height is %d",
@@ -389,9 +522,47 @@ func (exe *executor) Reset() error {
 	exe.nameRegCache.Reset(exe.state)
 	exe.proposalRegCache.Reset(exe.state)
 	exe.validatorCache.Reset(exe.state)
+	exe.slashingCache.Reset(exe.state)
+	exe.delegationCache.Reset(exe.state)
+	exe.fees.Reset()
 	return nil
 }
 
+// BeginBlock slashes any validators Tendermint has reported evidence against and releases those whose jail time is
+// up. The results are recorded as events against the block rather than any transaction.
+func (exe *executor) BeginBlock(block *abciTypes.RequestBeginBlock) (err error) {
+	// As with Commit() the write lock is controlled by the caller
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("recovered from panic in executor.BeginBlock(): %v\n%s", r, debug.Stack())
+		}
+	}()
+	if uint64(block.Header.Height) != exe.block.Height {
+		return fmt.Errorf("trying to begin block execution with height %v but passed Tendermint "+
+			"block header at height %v", exe.block.Height, block.Header.Height)
+	}
+	return exe.slashingContext.Execute(exe.block, block.ByzantineValidators)
+}
+
+// EndBlock pays the fees collected during the block along with any block reward to the validators when PayoutFees is
+// set. The payouts are recorded as events against the block rather than any transaction.
+func (exe *executor) EndBlock(block *abciTypes.RequestEndBlock) (err error) {
+	// As with Commit() the write lock is controlled by the caller
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("recovered from panic in executor.EndBlock(): %v\n%s", r, debug.Stack())
+		}
+	}()
+	if uint64(block.Height) != exe.block.Height {
+		return fmt.Errorf("trying to end block execution with height %v but passed Tendermint "+
+			"block at height %v", exe.block.Height, block.Height)
+	}
+	if exe.payoutContext == nil {
+		return nil
+	}
+	return exe.payoutContext.Execute(exe.block)
+}
+
 // executor exposes access to the underlying state cache protected by a RWMutex that prevents access while locked
 // (during an ABCI commit). while access can occur (and needs to continue for CheckTx/DeliverTx to make progress)
 // through calls to Execute() external readers will be blocked until the executor is unlocked that allows the Transactor
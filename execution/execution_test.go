@@ -1583,10 +1583,13 @@ type testExecutor struct {
 func makeExecutor(state *state.State) *testExecutor {
 	blockchain := newBlockchain(testGenesisDoc)
 	blockchain.CommitBlockAtHeight(time.Now(), []byte("hashily"), state.Hash(), HeightAtVersion(state.Version()))
+	params, err := ParamsFromGenesis(testGenesisDoc)
+	if err != nil {
+		panic(err)
+	}
 	return &testExecutor{
 		Blockchain: blockchain,
-		executor: newExecutor("makeExecutorCache", true, ParamsFromGenesis(testGenesisDoc), state,
-			blockchain, nil, logger),
+		executor:   newExecutor("makeExecutorCache", true, params, state, blockchain, nil, logger),
 	}
 }
 
@@ -0,0 +1,107 @@
+package execution
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/genesis"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/abci/types"
+)
+
+func TestPayout(t *testing.T) {
+	exe, st := makeSlashingExecutor(t, func(genDoc *genesis.GenesisDoc) {
+		genDoc.Params.PayoutFees = true
+		genDoc.Params.BlockReward = 10
+		genDoc.Validators[0].Amount = 300
+	})
+	sender := users[4]
+	height := exe.block.Height
+	balances := make(map[int]uint64)
+	for i := range users[:5] {
+		balances[i] = getAccount(st, users[i].GetAddress()).Balance
+	}
+
+	require.NoError(t, exe.BeginBlock(&types.RequestBeginBlock{Header: types.Header{Height: int64(height)}}))
+	tx := &payload.NameTx{
+		Input: &payload.TxInput{
+			Address:  sender.GetAddress(),
+			Amount:   10060,
+			Sequence: 1,
+		},
+		Name: "payout",
+		Data: "fees",
+		Fee:  60,
+	}
+	txEnv := txs.Enclose(exe.params.ChainID, tx)
+	require.NoError(t, txEnv.Sign(sender))
+	_, err := exe.Execute(txEnv)
+	require.NoError(t, err)
+	require.NoError(t, exe.EndBlock(&types.RequestEndBlock{Height: int64(height)}))
+	payouts := make(map[string]*exec.PayoutEvent)
+	for _, ev := range exe.block.Events {
+		require.Equal(t, exec.TypePayout, ev.Header.EventType)
+		payouts[ev.Payout.Address.String()] = ev.Payout
+	}
+	_, err = exe.Commit(nil)
+	require.NoError(t, err)
+
+	// The fee is charged to the sender
+	assert.Equal(t, balances[4]-10060, getAccount(st, sender.GetAddress()).Balance)
+
+	// The fees and block reward are shared in proportion to power with the remainder going to the most powerful
+	expected := map[int]*exec.PayoutEvent{
+		0: {Address: users[0].GetAddress(), Power: 300, Fees: 30, Reward: 7},
+		1: {Address: users[1].GetAddress(), Power: 100, Fees: 10, Reward: 1},
+		2: {Address: users[2].GetAddress(), Power: 100, Fees: 10, Reward: 1},
+		3: {Address: users[3].GetAddress(), Power: 100, Fees: 10, Reward: 1},
+	}
+	require.Len(t, payouts, len(expected))
+	for i, payout := range expected {
+		assert.Equal(t, payout, payouts[payout.Address.String()])
+		assert.Equal(t, balances[i]+payout.Fees+payout.Reward, getAccount(st, users[i].GetAddress()).Balance)
+	}
+}
+
+func TestPayout_Disabled(t *testing.T) {
+	exe, st := makeSlashingExecutor(t, func(genDoc *genesis.GenesisDoc) {})
+	sender := users[4]
+	height := exe.block.Height
+	balance := getAccount(st, sender.GetAddress()).Balance
+
+	require.NoError(t, exe.BeginBlock(&types.RequestBeginBlock{Header: types.Header{Height: int64(height)}}))
+	tx := &payload.NameTx{
+		Input: &payload.TxInput{
+			Address:  sender.GetAddress(),
+			Amount:   10060,
+			Sequence: 1,
+		},
+		Name: "payout",
+		Data: "fees",
+		Fee:  60,
+	}
+	txEnv := txs.Enclose(exe.params.ChainID, tx)
+	require.NoError(t, txEnv.Sign(sender))
+	_, err := exe.Execute(txEnv)
+	require.NoError(t, err)
+	require.NoError(t, exe.EndBlock(&types.RequestEndBlock{Height: int64(height)}))
+	require.Empty(t, exe.block.Events)
+	_, err = exe.Commit(nil)
+	require.NoError(t, err)
+
+	// Without PayoutFees only the name cost is charged
+	assert.Equal(t, balance-10000, getAccount(st, sender.GetAddress()).Balance)
+}
+
+func TestPayout_RewardRequiresPayoutFees(t *testing.T) {
+	genDoc := &genesis.GenesisDoc{}
+	genDoc.Params.BlockReward = 10
+	_, err := ParamsFromGenesis(genDoc)
+	require.Error(t, err)
+	genDoc.Params.PayoutFees = true
+	_, err = ParamsFromGenesis(genDoc)
+	require.NoError(t, err)
+}
@@ -1,39 +1,195 @@
 package execution
 
 import (
+	"fmt"
+
 	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/acm/acmstate"
 	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/crypto/sha3"
 	"github.com/hyperledger/burrow/execution/contexts"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/evm"
 	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/state"
 	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/permission"
 	"github.com/hyperledger/burrow/txs"
 	"github.com/hyperledger/burrow/txs/payload"
 )
 
+// Upper bound on the GasLimit tried by EstimateGas when a CallTx sets none
+const MaxEstimateGas = uint64(1) << 32
+
 // Run a contract's code on an isolated and unpersisted state
 // Cannot be used to create new contracts
 func CallSim(reader acmstate.Reader, blockchain bcm.BlockchainInfo, fromAddress, address crypto.Address, data []byte,
-	logger *logging.Logger) (*exec.TxExecution, error) {
+	logger *logging.Logger, options ...func(*evm.VM)) (*exec.TxExecution, error) {
+
+	return simulateCallTx(reader, blockchain, &payload.CallTx{
+		Input: &payload.TxInput{
+			Address: fromAddress,
+		},
+		Address:  &address,
+		Data:     data,
+		GasLimit: contexts.GasLimit,
+	}, logger, options...)
+}
+
+// A change made to an account before a simulated call, only the fields set are overridden
+type AccountOverride struct {
+	Address crypto.Address
+	// Replaces the balance when not nil
+	Balance *uint64
+	// Replaces the account's code when non-empty
+	EVMCode  acm.Bytecode
+	WASMCode acm.Bytecode
+	// Storage slots to set
+	Storage map[binary.Word256][]byte
+	// Replaces the account's permissions when not nil
+	Permissions *permission.AccountPermissions
+}
+
+// Run tx on an isolated and unpersisted copy of the state as of the end of the block at height (or the current state
+// when height is 0) after making the changes in overrides. Accounts that are overridden but do not exist are created.
+// As with the current state, the start of the following block is not run first so jails or unbonded stake due for
+// release then are not yet released.
+func CallTxSim(st *state.State, blockchain bcm.BlockchainInfo, tx *payload.CallTx, height uint64,
+	overrides []*AccountOverride, logger *logging.Logger, options ...func(*evm.VM)) (*exec.TxExecution, error) {
+
+	var reader acmstate.Reader = st
+	if height > 0 {
+		backend, historical, err := historicalState(st, blockchain, height)
+		if err != nil {
+			return nil, err
+		}
+		reader, blockchain = backend, historical
+	}
+	cache := acmstate.NewCache(reader)
+	for _, override := range overrides {
+		err := override.apply(cache)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return simulateCallTx(cache, blockchain, tx, logger, options...)
+}
+
+func (ao *AccountOverride) apply(cache *acmstate.Cache) error {
+	acc, err := cache.GetAccount(ao.Address)
+	if err != nil {
+		return err
+	}
+	if acc == nil {
+		acc = &acm.Account{Address: ao.Address}
+	}
+	if ao.Balance != nil {
+		acc.Balance = *ao.Balance
+	}
+	if len(ao.EVMCode) > 0 {
+		acc.EVMCode = ao.EVMCode
+		acc.WASMCode = nil
+		acc.CodeHash = sha3.Sha3(ao.EVMCode)
+	}
+	if len(ao.WASMCode) > 0 {
+		acc.EVMCode = nil
+		acc.WASMCode = ao.WASMCode
+		acc.CodeHash = sha3.Sha3(ao.WASMCode)
+	}
+	if ao.Permissions != nil {
+		acc.Permissions = *ao.Permissions
+	}
+	err = cache.UpdateAccount(acc)
+	if err != nil {
+		return err
+	}
+	for key, value := range ao.Storage {
+		err = cache.SetStorage(ao.Address, key, value)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Find the lowest GasLimit with which tx succeeds on an isolated and unpersisted state by binary search, returning the
+// gas used by tx when run with that limit. If tx has no GasLimit then MaxEstimateGas is used as an upper bound.
+func EstimateGas(reader acmstate.Reader, blockchain bcm.BlockchainInfo, tx *payload.CallTx,
+	logger *logging.Logger) (gasUsed, gasLimit uint64, err error) {
+
+	if tx.Input == nil {
+		return 0, 0, fmt.Errorf("EstimateGas requires a CallTx with an input")
+	}
+	// Returns the gas used if tx succeeds with the given limit
+	run := func(limit uint64) (uint64, *errors.Exception, error) {
+		candidate := *tx
+		candidate.GasLimit = limit
+		txe, err := simulateCallTx(reader, blockchain, &candidate, logger)
+		if err != nil {
+			return 0, nil, err
+		}
+		if txe.Exception != nil {
+			return 0, txe.Exception, nil
+		}
+		return txe.Result.GasUsed, nil, nil
+	}
+
+	hi := tx.GasLimit
+	if hi == 0 {
+		hi = MaxEstimateGas
+	}
+	gasUsed, exception, err := run(hi)
+	if err != nil {
+		return 0, 0, err
+	}
+	if exception != nil {
+		return 0, 0, errors.Wrap(exception, fmt.Sprintf("CallTx fails with GasLimit %d", hi))
+	}
+	if gasUsed == 0 {
+		return 0, 0, nil
+	}
+	// A limit below the gas used must fail
+	lo := gasUsed - 1
+	// Invariant: lo fails and hi succeeds
+	for hi-lo > 1 {
+		mid := lo + (hi-lo)/2
+		used, exception, err := run(mid)
+		if err != nil {
+			return 0, 0, err
+		}
+		if exception != nil {
+			lo = mid
+		} else {
+			hi = mid
+			gasUsed = used
+		}
+	}
+	return gasUsed, hi, nil
+}
+
+func simulateCallTx(reader acmstate.Reader, blockchain bcm.BlockchainInfo, tx *payload.CallTx, logger *logging.Logger,
+	options ...func(*evm.VM)) (*exec.TxExecution, error) {
 
+	genesisDoc := blockchain.GenesisDoc()
+	params, err := ParamsFromGenesis(&genesisDoc)
+	if err != nil {
+		return nil, err
+	}
 	cache := acmstate.NewCache(reader)
 	exe := contexts.CallContext{
 		RunCall:     true,
 		StateWriter: cache,
 		Blockchain:  blockchain,
+		VMOptions:   options,
+		GasSchedule: params.GasSchedule,
+		Hardfork:    params.Hardfork,
 		Logger:      logger,
 	}
 
-	txe := exec.NewTxExecution(txs.Enclose(blockchain.ChainID(), &payload.CallTx{
-		Input: &payload.TxInput{
-			Address: fromAddress,
-		},
-		Address:  &address,
-		Data:     data,
-		GasLimit: contexts.GasLimit,
-	}))
-	err := exe.Execute(txe, txe.Envelope.Tx.Payload)
+	txe := exec.NewTxExecution(txs.Enclose(blockchain.ChainID(), tx))
+	err = exe.Execute(txe, txe.Envelope.Tx.Payload)
 	if err != nil {
 		return nil, err
 	}
@@ -43,7 +199,7 @@ func CallSim(reader acmstate.Reader, blockchain bcm.BlockchainInfo, fromAddress,
 // Run the given code on an isolated and unpersisted state
 // Cannot be used to create new contracts.
 func CallCodeSim(reader acmstate.Reader, blockchain bcm.BlockchainInfo, fromAddress, address crypto.Address, code, data []byte,
-	logger *logging.Logger) (*exec.TxExecution, error) {
+	logger *logging.Logger, options ...func(*evm.VM)) (*exec.TxExecution, error) {
 
 	// Attach code to target account (overwriting target)
 	cache := acmstate.NewCache(reader)
@@ -55,5 +211,5 @@ func CallCodeSim(reader acmstate.Reader, blockchain bcm.BlockchainInfo, fromAddr
 	if err != nil {
 		return nil, err
 	}
-	return CallSim(cache, blockchain, fromAddress, address, data, logger)
+	return CallSim(cache, blockchain, fromAddress, address, data, logger, options...)
 }
@@ -0,0 +1,165 @@
+package execution
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/acmstate"
+	. "github.com/hyperledger/burrow/binary"
+	. "github.com/hyperledger/burrow/execution/evm/asm"
+	"github.com/hyperledger/burrow/execution/evm/asm/bc"
+	"github.com/hyperledger/burrow/permission"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEstimateGas(t *testing.T) {
+	st, privAccounts := makeGenesisState(1, true, 1000, 1, true, 1000)
+	blockchain := newBlockchain(testGenesisDoc)
+	cache := acmstate.NewCache(st)
+	input := &payload.TxInput{Address: privAccounts[0].GetAddress()}
+
+	// Uses a fixed amount of gas
+	simple := newAddress("simple")
+	require.NoError(t, cache.UpdateAccount(&acm.Account{
+		Address: simple,
+		EVMCode: bc.MustSplice(PUSH1, 0x2A, PUSH1, 0x05, SSTORE, STOP),
+	}))
+	gasUsed, gasLimit, err := EstimateGas(cache, blockchain, &payload.CallTx{Input: input, Address: &simple}, logger)
+	require.NoError(t, err)
+	assert.NotZero(t, gasUsed)
+	assert.Equal(t, gasUsed, gasLimit)
+
+	// Reverts unless more gas than it uses remains
+	greedy := newAddress("greedy")
+	require.NoError(t, cache.UpdateAccount(&acm.Account{
+		Address: greedy,
+		EVMCode: bc.MustSplice(GAS, PUSH2, 0x13, 0x88, GT, PUSH1, 0x09, JUMPI, STOP,
+			JUMPDEST, PUSH1, 0x00, DUP1, REVERT),
+	}))
+	gasUsed, gasLimit, err = EstimateGas(cache, blockchain, &payload.CallTx{Input: input, Address: &greedy}, logger)
+	require.NoError(t, err)
+	assert.True(t, gasLimit >= 5000, "gas limit %d should leave 5000 gas at GAS", gasLimit)
+	assert.True(t, gasUsed < gasLimit)
+	txe, err := simulateCallTx(cache, blockchain, &payload.CallTx{Input: input, Address: &greedy, GasLimit: gasLimit - 1},
+		logger)
+	require.NoError(t, err)
+	assert.NotNil(t, txe.Exception)
+
+	// Fails whatever the limit
+	_, _, err = EstimateGas(cache, blockchain, &payload.CallTx{Input: input, Address: &greedy, GasLimit: 4000}, logger)
+	assert.Error(t, err)
+}
+
+func TestCallTxSim(t *testing.T) {
+	st, privAccounts := makeGenesisState(1, true, 1000, 1, true, 1000)
+	exe := makeExecutor(st)
+	_, err := exe.Commit(nil)
+	require.NoError(t, err)
+	acc0 := getAccount(st, privAccounts[0].GetAddress())
+
+	// Returns storage slot 5
+	contractCode := bc.MustSplice(PUSH1, 0x05, SLOAD, PUSH1, 0x00, MSTORE, PUSH1, 0x20, PUSH1, 0x00, RETURN)
+	create := &payload.CallTx{
+		Input: &payload.TxInput{
+			Address:  acc0.Address,
+			Amount:   1,
+			Sequence: acc0.Sequence + 1,
+		},
+		GasLimit: 100000,
+		Data:     wrapContractForCreate(contractCode),
+	}
+	txEnv := txs.Enclose(testChainID, create)
+	require.NoError(t, txEnv.Sign(privAccounts[0]))
+	txe, err := exe.Execute(txEnv)
+	require.NoError(t, err)
+	require.Nil(t, txe.Exception)
+	_, err = exe.Commit(nil)
+	require.NoError(t, err)
+	contractAddress := txe.Receipt.ContractAddress
+
+	blockchain := exe.Blockchain
+
+	call := &payload.CallTx{
+		Input:    &payload.TxInput{Address: acc0.Address},
+		Address:  &contractAddress,
+		GasLimit: 100000,
+	}
+	txe, err = CallTxSim(st, blockchain, call, 0, nil, logger)
+	require.NoError(t, err)
+	require.Nil(t, txe.Exception)
+	assert.Equal(t, Zero256.Bytes(), txe.Result.Return)
+
+	// Storage override
+	txe, err = CallTxSim(st, blockchain, call, 0, []*AccountOverride{{
+		Address: contractAddress,
+		Storage: map[Word256][]byte{Int64ToWord256(5): Int64ToWord256(42).Bytes()},
+	}}, logger)
+	require.NoError(t, err)
+	require.Nil(t, txe.Exception)
+	assert.Equal(t, Int64ToWord256(42).Bytes(), txe.Result.Return)
+	// Nothing was persisted
+	value, err := st.GetStorage(contractAddress, Int64ToWord256(5))
+	require.NoError(t, err)
+	assert.Empty(t, value)
+
+	// The contract did not exist before its block
+	txe, err = CallTxSim(st, blockchain, call, 1, nil, logger)
+	require.NoError(t, err)
+	assert.NotNil(t, txe.Exception)
+
+	// Unless we deploy it with an override
+	txe, err = CallTxSim(st, blockchain, call, 1, []*AccountOverride{{
+		Address: contractAddress,
+		EVMCode: contractCode,
+	}}, logger)
+	require.NoError(t, err)
+	require.Nil(t, txe.Exception)
+
+	// An account that does not exist can be funded to call from
+	pauper := newAddress("pauper")
+	balance := uint64(1000)
+	poorCall := &payload.CallTx{
+		Input:    &payload.TxInput{Address: pauper, Amount: 10},
+		Address:  &contractAddress,
+		GasLimit: 100000,
+	}
+	_, err = CallTxSim(st, blockchain, poorCall, 0, nil, logger)
+	require.Error(t, err)
+	txe, err = CallTxSim(st, blockchain, poorCall, 0, []*AccountOverride{{
+		Address: pauper,
+		Balance: &balance,
+		Permissions: &permission.AccountPermissions{
+			Base: permission.BasePermissions{Perms: permission.Call, SetBit: permission.Call},
+		},
+	}}, logger)
+	require.NoError(t, err)
+	require.Nil(t, txe.Exception)
+
+	_, err = CallTxSim(st, blockchain, call, 5, nil, logger)
+	require.Error(t, err)
+}
+
+func TestCallSim_Hardfork(t *testing.T) {
+	st, privAccounts := makeGenesisState(1, true, 1000, 1, true, 1000)
+	cache := acmstate.NewCache(st)
+	// CHAINID was introduced by Istanbul
+	contract := newAddress("chainid")
+	require.NoError(t, cache.UpdateAccount(&acm.Account{
+		Address: contract,
+		EVMCode: bc.MustSplice(CHAINID, STOP),
+	}))
+	caller := privAccounts[0].GetAddress()
+
+	txe, err := CallSim(cache, newBlockchain(testGenesisDoc), caller, contract, nil, logger)
+	require.NoError(t, err)
+	assert.Nil(t, txe.Exception)
+
+	genesisDoc := *testGenesisDoc
+	genesisDoc.Params.Hardfork = "byzantium"
+	txe, err = CallSim(cache, newBlockchain(&genesisDoc), caller, contract, nil, logger)
+	require.NoError(t, err)
+	assert.NotNil(t, txe.Exception)
+}
@@ -0,0 +1,345 @@
+package slashing
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+
+	"github.com/hyperledger/burrow/crypto"
+)
+
+// The Cache buffers the slashes, jail changes and unbondings made during a block until they are synced to state
+type Cache struct {
+	sync.RWMutex
+	backend    IterableReader
+	slashes    []*Slash
+	jails      map[crypto.Address]*jailInfo
+	unbondings map[unbondingKey]*unbondingInfo
+}
+
+type unbondingKey struct {
+	releaseHeight uint64
+	validator     crypto.Address
+	address       crypto.Address
+}
+
+func keyOf(unbonding *Unbonding) unbondingKey {
+	return unbondingKey{
+		releaseHeight: unbonding.ReleaseHeight,
+		validator:     unbonding.Validator,
+		address:       unbonding.Address,
+	}
+}
+
+type unbondingInfo struct {
+	unbonding *Unbonding
+	removed   bool
+	updated   bool
+}
+
+type jailInfo struct {
+	jail    *Jail
+	removed bool
+	updated bool
+}
+
+var _ IterableReaderWriter = &Cache{}
+
+func NewCache(backend IterableReader) *Cache {
+	return &Cache{
+		backend:    backend,
+		jails:      make(map[crypto.Address]*jailInfo),
+		unbondings: make(map[unbondingKey]*unbondingInfo),
+	}
+}
+
+func (cache *Cache) GetJail(address crypto.Address) (*Jail, error) {
+	cache.Lock()
+	defer cache.Unlock()
+	info, err := cache.get(address)
+	if err != nil {
+		return nil, err
+	}
+	if info.removed {
+		return nil, nil
+	}
+	return info.jail, nil
+}
+
+func (cache *Cache) AddSlash(slash *Slash) error {
+	cache.Lock()
+	defer cache.Unlock()
+	cache.slashes = append(cache.slashes, slash)
+	return nil
+}
+
+func (cache *Cache) UpdateJail(jail *Jail) error {
+	cache.Lock()
+	defer cache.Unlock()
+	info, err := cache.get(jail.GetAddress())
+	if err != nil {
+		return err
+	}
+	info.jail = jail
+	info.removed = false
+	info.updated = true
+	return nil
+}
+
+func (cache *Cache) RemoveJail(address crypto.Address) error {
+	cache.Lock()
+	defer cache.Unlock()
+	info, err := cache.get(address)
+	if err != nil {
+		return err
+	}
+	info.jail = nil
+	info.removed = true
+	info.updated = false
+	return nil
+}
+
+func (cache *Cache) GetUnbonding(releaseHeight uint64, validator, address crypto.Address) (*Unbonding, error) {
+	cache.Lock()
+	defer cache.Unlock()
+	info, err := cache.getUnbonding(unbondingKey{releaseHeight: releaseHeight, validator: validator, address: address})
+	if err != nil {
+		return nil, err
+	}
+	if info.removed {
+		return nil, nil
+	}
+	return info.unbonding, nil
+}
+
+func (cache *Cache) UpdateUnbonding(unbonding *Unbonding) error {
+	cache.Lock()
+	defer cache.Unlock()
+	info, err := cache.getUnbonding(keyOf(unbonding))
+	if err != nil {
+		return err
+	}
+	info.unbonding = unbonding
+	info.removed = false
+	info.updated = true
+	return nil
+}
+
+func (cache *Cache) RemoveUnbonding(releaseHeight uint64, validator, address crypto.Address) error {
+	cache.Lock()
+	defer cache.Unlock()
+	info, err := cache.getUnbonding(unbondingKey{releaseHeight: releaseHeight, validator: validator, address: address})
+	if err != nil {
+		return err
+	}
+	info.unbonding = nil
+	info.removed = true
+	info.updated = false
+	return nil
+}
+
+// Iterates over the backend's slashes followed by those added to the cache
+func (cache *Cache) IterateSlashes(consumer func(*Slash) error) error {
+	cache.RLock()
+	defer cache.RUnlock()
+	err := cache.backend.IterateSlashes(consumer)
+	if err != nil {
+		return err
+	}
+	for _, slash := range cache.slashes {
+		err = consumer(slash)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Iterates over the jails in the backend as modified by the cache in order of address
+func (cache *Cache) IterateJails(consumer func(*Jail) error) error {
+	cache.RLock()
+	defer cache.RUnlock()
+	jails := make(map[crypto.Address]*Jail)
+	err := cache.backend.IterateJails(func(jail *Jail) error {
+		jails[jail.GetAddress()] = jail
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for address, info := range cache.jails {
+		if info.removed {
+			delete(jails, address)
+		} else if info.updated {
+			jails[address] = info.jail
+		}
+	}
+	for _, address := range sortedAddresses(jails) {
+		err = consumer(jails[address])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Iterates over the unbondings in the backend as modified by the cache in order of release height, validator, then
+// address
+func (cache *Cache) IterateUnbondings(consumer func(*Unbonding) error) error {
+	cache.RLock()
+	defer cache.RUnlock()
+	unbondings := make(map[unbondingKey]*Unbonding)
+	err := cache.backend.IterateUnbondings(func(unbonding *Unbonding) error {
+		unbondings[keyOf(unbonding)] = unbonding
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for key, info := range cache.unbondings {
+		if info.removed {
+			delete(unbondings, key)
+		} else if info.updated {
+			unbondings[key] = info.unbonding
+		}
+	}
+	keys := make([]unbondingKey, 0, len(unbondings))
+	for key := range unbondings {
+		keys = append(keys, key)
+	}
+	sortUnbondingKeys(keys)
+	for _, key := range keys {
+		err = consumer(unbondings[key])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Writes whatever is in the cache to the output Writer state. Does not flush the cache, to do that call Reset()
+// after Sync or use Flush if you wish to use the output state as your next backend
+func (cache *Cache) Sync(state Writer) error {
+	cache.RLock()
+	defer cache.RUnlock()
+	for _, slash := range cache.slashes {
+		err := state.AddSlash(slash)
+		if err != nil {
+			return err
+		}
+	}
+	addresses := make([]crypto.Address, 0, len(cache.jails))
+	for address := range cache.jails {
+		addresses = append(addresses, address)
+	}
+	sortAddresses(addresses)
+	for _, address := range addresses {
+		info := cache.jails[address]
+		if info.removed {
+			err := state.RemoveJail(address)
+			if err != nil {
+				return err
+			}
+		} else if info.updated {
+			err := state.UpdateJail(info.jail)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	keys := make([]unbondingKey, 0, len(cache.unbondings))
+	for key := range cache.unbondings {
+		keys = append(keys, key)
+	}
+	sortUnbondingKeys(keys)
+	for _, key := range keys {
+		info := cache.unbondings[key]
+		if info.removed {
+			err := state.RemoveUnbonding(key.releaseHeight, key.validator, key.address)
+			if err != nil {
+				return err
+			}
+		} else if info.updated {
+			err := state.UpdateUnbonding(info.unbonding)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Resets the cache to empty
+func (cache *Cache) Reset(backend IterableReader) {
+	cache.Lock()
+	defer cache.Unlock()
+	cache.backend = backend
+	cache.slashes = nil
+	cache.jails = make(map[crypto.Address]*jailInfo)
+	cache.unbondings = make(map[unbondingKey]*unbondingInfo)
+}
+
+// Syncs the Cache and Resets it to use backend as the backend IterableReader
+func (cache *Cache) Flush(output Writer, backend IterableReader) error {
+	err := cache.Sync(output)
+	if err != nil {
+		return err
+	}
+	cache.Reset(backend)
+	return nil
+}
+
+// Must be called with the write lock held
+func (cache *Cache) get(address crypto.Address) (*jailInfo, error) {
+	info := cache.jails[address]
+	if info == nil {
+		jail, err := cache.backend.GetJail(address)
+		if err != nil {
+			return nil, err
+		}
+		info = &jailInfo{jail: jail}
+		cache.jails[address] = info
+	}
+	return info, nil
+}
+
+// Must be called with the write lock held
+func (cache *Cache) getUnbonding(key unbondingKey) (*unbondingInfo, error) {
+	info := cache.unbondings[key]
+	if info == nil {
+		unbonding, err := cache.backend.GetUnbonding(key.releaseHeight, key.validator, key.address)
+		if err != nil {
+			return nil, err
+		}
+		info = &unbondingInfo{unbonding: unbonding}
+		cache.unbondings[key] = info
+	}
+	return info, nil
+}
+
+func sortedAddresses(jails map[crypto.Address]*Jail) []crypto.Address {
+	addresses := make([]crypto.Address, 0, len(jails))
+	for address := range jails {
+		addresses = append(addresses, address)
+	}
+	sortAddresses(addresses)
+	return addresses
+}
+
+func sortAddresses(addresses []crypto.Address) {
+	sort.Slice(addresses, func(i, j int) bool {
+		return bytes.Compare(addresses[i][:], addresses[j][:]) < 0
+	})
+}
+
+func sortUnbondingKeys(keys []unbondingKey) {
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].releaseHeight != keys[j].releaseHeight {
+			return keys[i].releaseHeight < keys[j].releaseHeight
+		}
+		if keys[i].validator != keys[j].validator {
+			return bytes.Compare(keys[i].validator[:], keys[j].validator[:]) < 0
+		}
+		return bytes.Compare(keys[i].address[:], keys[j].address[:]) < 0
+	})
+}
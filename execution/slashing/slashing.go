@@ -0,0 +1,123 @@
+package slashing
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/event/query"
+)
+
+// Params determine how validators are punished for the misbehaviour Tendermint reports evidence of
+type Params struct {
+	// The fraction of its power a validator loses, nil (or zero) disables slashing
+	Fraction *big.Rat
+	// The number of blocks for which a slashed validator is jailed with no power, zero to not jail validators
+	JailBlocks uint64
+	// The number of blocks for which unbonded stake is held (and may still be slashed) before it is released, zero to
+	// release it immediately
+	UnbondingBlocks uint64
+}
+
+// ParseFraction parses a fraction between 0 and 1 written as a decimal (e.g. 0.05) or a ratio (e.g. 1/20), the empty
+// string is taken to be zero
+func ParseFraction(fraction string) (*big.Rat, error) {
+	if fraction == "" {
+		return new(big.Rat), nil
+	}
+	rat, ok := new(big.Rat).SetString(fraction)
+	if !ok {
+		return nil, fmt.Errorf("could not parse slashing fraction '%s'", fraction)
+	}
+	if rat.Sign() < 0 || rat.Cmp(big.NewRat(1, 1)) > 0 {
+		return nil, fmt.Errorf("slashing fraction %s must be between 0 and 1", fraction)
+	}
+	return rat, nil
+}
+
+// Enabled returns whether validators are slashed at all
+func (params Params) Enabled() bool {
+	return params.Fraction != nil && params.Fraction.Sign() > 0 || params.JailBlocks > 0
+}
+
+// Slashed returns the power removed from a validator with power
+func (params Params) Slashed(power uint64) uint64 {
+	if params.Fraction == nil {
+		return 0
+	}
+	slashed := new(big.Int).SetUint64(power)
+	slashed.Mul(slashed, params.Fraction.Num())
+	return slashed.Quo(slashed, params.Fraction.Denom()).Uint64()
+}
+
+func (s *Slash) String() string {
+	return fmt.Sprintf("Slash{%v at height %d for %s at height %d; Slashed: %d, Power: %d, JailedUntil: %d, "+
+		"UnbondingSlashed: %d}", s.Address, s.Height, s.EvidenceType, s.EvidenceHeight, s.Slashed, s.Power,
+		s.JailedUntil, s.UnbondingSlashed)
+}
+
+func (s *Slash) Get(key string) (value interface{}, ok bool) {
+	return query.GetReflect(reflect.ValueOf(s), key)
+}
+
+func (j *Jail) String() string {
+	return fmt.Sprintf("Jail{%v with power %d until height %d}", j.PublicKey.GetAddress(), j.Power, j.Until)
+}
+
+func (j *Jail) GetAddress() crypto.Address {
+	return j.PublicKey.GetAddress()
+}
+
+func (u *Unbonding) String() string {
+	return fmt.Sprintf("Unbonding{%v unbonded %d from %v at height %d, released at height %d}", u.Address, u.Amount,
+		u.Validator, u.Height, u.ReleaseHeight)
+}
+
+func (u *Unbonding) Get(key string) (value interface{}, ok bool) {
+	return query.GetReflect(reflect.ValueOf(u), key)
+}
+
+type Reader interface {
+	// Returns the jail holding the validator or nil if it is not jailed
+	GetJail(address crypto.Address) (*Jail, error)
+	// Returns the stake address has unbonded from validator pending release at releaseHeight or nil if there is none
+	GetUnbonding(releaseHeight uint64, validator, address crypto.Address) (*Unbonding, error)
+}
+
+type Writer interface {
+	// Records a slash
+	AddSlash(slash *Slash) error
+	// Jails a validator (or updates its jail if already jailed)
+	UpdateJail(jail *Jail) error
+	// Releases a validator from jail
+	RemoveJail(address crypto.Address) error
+	// Adds or updates pending unbonded stake
+	UpdateUnbonding(unbonding *Unbonding) error
+	// Removes pending unbonded stake once it is released
+	RemoveUnbonding(releaseHeight uint64, validator, address crypto.Address) error
+}
+
+type ReaderWriter interface {
+	Reader
+	Writer
+}
+
+type Iterable interface {
+	// Iterates over slashes in order of height
+	IterateSlashes(consumer func(*Slash) error) error
+	// Iterates over jailed validators in order of address
+	IterateJails(consumer func(*Jail) error) error
+	// Iterates over pending unbonded stake in order of release height, validator, then address
+	IterateUnbondings(consumer func(*Unbonding) error) error
+}
+
+type IterableReader interface {
+	Iterable
+	Reader
+}
+
+type IterableReaderWriter interface {
+	Iterable
+	ReaderWriter
+}
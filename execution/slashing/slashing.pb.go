@@ -0,0 +1,1216 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: slashing.proto
+
+package slashing
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	golang_proto "github.com/golang/protobuf/proto"
+	crypto "github.com/hyperledger/burrow/crypto"
+	github_com_hyperledger_burrow_crypto "github.com/hyperledger/burrow/crypto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = golang_proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+// Slash records the power removed from a validator in response to evidence from Tendermint that it misbehaved
+type Slash struct {
+	// The validator slashed
+	Address github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	// The kind of misbehaviour, e.g. duplicate/vote for double signing
+	EvidenceType string `protobuf:"bytes,2,opt,name=EvidenceType,proto3" json:"EvidenceType,omitempty"`
+	// The height at which the validator misbehaved
+	EvidenceHeight uint64 `protobuf:"varint,3,opt,name=EvidenceHeight,proto3" json:"EvidenceHeight,omitempty"`
+	// The height at which the validator was slashed
+	Height uint64 `protobuf:"varint,4,opt,name=Height,proto3" json:"Height,omitempty"`
+	// The power removed from the validator
+	Slashed uint64 `protobuf:"varint,5,opt,name=Slashed,proto3" json:"Slashed,omitempty"`
+	// The power remaining to the validator (held while it is jailed)
+	Power uint64 `protobuf:"varint,6,opt,name=Power,proto3" json:"Power,omitempty"`
+	// The height at which the validator is released from jail, zero if it was not jailed
+	JailedUntil uint64 `protobuf:"varint,7,opt,name=JailedUntil,proto3" json:"JailedUntil,omitempty"`
+	// The stake removed from the validator's pending unbondings
+	UnbondingSlashed     uint64   `protobuf:"varint,8,opt,name=UnbondingSlashed,proto3" json:"UnbondingSlashed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Slash) Reset()      { *m = Slash{} }
+func (*Slash) ProtoMessage() {}
+func (*Slash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31f622956ca78100, []int{0}
+}
+func (m *Slash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Slash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Slash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Slash.Merge(m, src)
+}
+func (m *Slash) XXX_Size() int {
+	return m.Size()
+}
+func (m *Slash) XXX_DiscardUnknown() {
+	xxx_messageInfo_Slash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Slash proto.InternalMessageInfo
+
+func (m *Slash) GetEvidenceType() string {
+	if m != nil {
+		return m.EvidenceType
+	}
+	return ""
+}
+
+func (m *Slash) GetEvidenceHeight() uint64 {
+	if m != nil {
+		return m.EvidenceHeight
+	}
+	return 0
+}
+
+func (m *Slash) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Slash) GetSlashed() uint64 {
+	if m != nil {
+		return m.Slashed
+	}
+	return 0
+}
+
+func (m *Slash) GetPower() uint64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+func (m *Slash) GetJailedUntil() uint64 {
+	if m != nil {
+		return m.JailedUntil
+	}
+	return 0
+}
+
+func (m *Slash) GetUnbondingSlashed() uint64 {
+	if m != nil {
+		return m.UnbondingSlashed
+	}
+	return 0
+}
+
+func (*Slash) XXX_MessageName() string {
+	return "slashing.Slash"
+}
+
+// Jail holds the power of a jailed validator until it is released
+type Jail struct {
+	PublicKey crypto.PublicKey `protobuf:"bytes,1,opt,name=PublicKey,proto3" json:"PublicKey"`
+	// The power restored to the validator on its release
+	Power uint64 `protobuf:"varint,2,opt,name=Power,proto3" json:"Power,omitempty"`
+	// The height at which the validator is released
+	Until                uint64   `protobuf:"varint,3,opt,name=Until,proto3" json:"Until,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Jail) Reset()      { *m = Jail{} }
+func (*Jail) ProtoMessage() {}
+func (*Jail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31f622956ca78100, []int{1}
+}
+func (m *Jail) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Jail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Jail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Jail.Merge(m, src)
+}
+func (m *Jail) XXX_Size() int {
+	return m.Size()
+}
+func (m *Jail) XXX_DiscardUnknown() {
+	xxx_messageInfo_Jail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Jail proto.InternalMessageInfo
+
+func (m *Jail) GetPublicKey() crypto.PublicKey {
+	if m != nil {
+		return m.PublicKey
+	}
+	return crypto.PublicKey{}
+}
+
+func (m *Jail) GetPower() uint64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+func (m *Jail) GetUntil() uint64 {
+	if m != nil {
+		return m.Until
+	}
+	return 0
+}
+
+func (*Jail) XXX_MessageName() string {
+	return "slashing.Jail"
+}
+
+// Unbonding holds stake unbonded from a validator until the end of the unbonding period, during which it may still be
+// slashed
+type Unbonding struct {
+	// The account to which the stake is released - the validator itself or one of its delegators
+	Address github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	// The stake to be released
+	Amount uint64 `protobuf:"varint,2,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	// The height at which the stake was unbonded
+	Height uint64 `protobuf:"varint,3,opt,name=Height,proto3" json:"Height,omitempty"`
+	// The height at which the stake is released
+	ReleaseHeight uint64 `protobuf:"varint,4,opt,name=ReleaseHeight,proto3" json:"ReleaseHeight,omitempty"`
+	// The validator the stake was bonded to
+	Validator            github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,5,opt,name=Validator,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Validator"`
+	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
+	XXX_unrecognized     []byte                                       `json:"-"`
+	XXX_sizecache        int32                                        `json:"-"`
+}
+
+func (m *Unbonding) Reset()      { *m = Unbonding{} }
+func (*Unbonding) ProtoMessage() {}
+func (*Unbonding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31f622956ca78100, []int{2}
+}
+func (m *Unbonding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Unbonding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Unbonding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Unbonding.Merge(m, src)
+}
+func (m *Unbonding) XXX_Size() int {
+	return m.Size()
+}
+func (m *Unbonding) XXX_DiscardUnknown() {
+	xxx_messageInfo_Unbonding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Unbonding proto.InternalMessageInfo
+
+func (m *Unbonding) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *Unbonding) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Unbonding) GetReleaseHeight() uint64 {
+	if m != nil {
+		return m.ReleaseHeight
+	}
+	return 0
+}
+
+func (*Unbonding) XXX_MessageName() string {
+	return "slashing.Unbonding"
+}
+func init() {
+	proto.RegisterType((*Slash)(nil), "slashing.Slash")
+	golang_proto.RegisterType((*Slash)(nil), "slashing.Slash")
+	proto.RegisterType((*Jail)(nil), "slashing.Jail")
+	golang_proto.RegisterType((*Jail)(nil), "slashing.Jail")
+	proto.RegisterType((*Unbonding)(nil), "slashing.Unbonding")
+	golang_proto.RegisterType((*Unbonding)(nil), "slashing.Unbonding")
+}
+
+func init() { proto.RegisterFile("slashing.proto", fileDescriptor_31f622956ca78100) }
+func init() { golang_proto.RegisterFile("slashing.proto", fileDescriptor_31f622956ca78100) }
+
+var fileDescriptor_31f622956ca78100 = []byte{
+	// 436 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x4f, 0x8b, 0xd3, 0x40,
+	0x18, 0xc6, 0x77, 0xba, 0x49, 0xbb, 0x7d, 0xb7, 0x2e, 0x3a, 0x2c, 0xcb, 0xb0, 0x87, 0xb4, 0x14,
+	0x91, 0x22, 0xda, 0x88, 0x7f, 0x2e, 0xde, 0xb6, 0x20, 0xc8, 0x0a, 0xb2, 0x44, 0xd7, 0x83, 0xb7,
+	0x24, 0xf3, 0x9a, 0x0e, 0x64, 0x67, 0xca, 0x64, 0xe2, 0xda, 0xcf, 0xe0, 0x17, 0xf0, 0xe8, 0x37,
+	0xd1, 0x63, 0x8f, 0x1e, 0xc5, 0xc3, 0x22, 0xdd, 0x2f, 0x22, 0x9d, 0x4c, 0xda, 0x46, 0xc1, 0x8b,
+	0xde, 0xf2, 0x7b, 0xde, 0x99, 0x27, 0x0f, 0xcf, 0xcb, 0xc0, 0x41, 0x91, 0xc7, 0xc5, 0x54, 0xc8,
+	0x6c, 0x3c, 0xd3, 0xca, 0x28, 0xba, 0x57, 0xf3, 0xf1, 0xfd, 0x4c, 0x98, 0x69, 0x99, 0x8c, 0x53,
+	0x75, 0x11, 0x66, 0x2a, 0x53, 0xa1, 0x3d, 0x90, 0x94, 0xef, 0x2c, 0x59, 0xb0, 0x5f, 0xd5, 0xc5,
+	0xe3, 0x5e, 0xaa, 0xe7, 0x33, 0xe3, 0x68, 0xf8, 0xa5, 0x05, 0xfe, 0xab, 0x95, 0x13, 0x7d, 0x09,
+	0x9d, 0x13, 0xce, 0x35, 0x16, 0x05, 0x23, 0x03, 0x32, 0xea, 0x4d, 0x1e, 0x2f, 0xae, 0xfa, 0x3b,
+	0x3f, 0xae, 0xfa, 0xf7, 0xb6, 0xfc, 0xa7, 0xf3, 0x19, 0xea, 0x1c, 0x79, 0x86, 0x3a, 0x4c, 0x4a,
+	0xad, 0xd5, 0x65, 0xe8, 0xec, 0xdc, 0xdd, 0xa8, 0x36, 0xa1, 0x43, 0xe8, 0x3d, 0x7b, 0x2f, 0x38,
+	0xca, 0x14, 0x5f, 0xcf, 0x67, 0xc8, 0x5a, 0x03, 0x32, 0xea, 0x46, 0x0d, 0x8d, 0xde, 0x81, 0x83,
+	0x9a, 0x9f, 0xa3, 0xc8, 0xa6, 0x86, 0xed, 0x0e, 0xc8, 0xc8, 0x8b, 0x7e, 0x53, 0xe9, 0x11, 0xb4,
+	0xdd, 0xdc, 0xb3, 0x73, 0x47, 0x94, 0x41, 0xc7, 0x86, 0x47, 0xce, 0x7c, 0x3b, 0xa8, 0x91, 0x1e,
+	0x82, 0x7f, 0xa6, 0x2e, 0x51, 0xb3, 0xb6, 0xd5, 0x2b, 0xa0, 0x03, 0xd8, 0x3f, 0x8d, 0x45, 0x8e,
+	0xfc, 0x5c, 0x1a, 0x91, 0xb3, 0x8e, 0x9d, 0x6d, 0x4b, 0xf4, 0x2e, 0xdc, 0x3c, 0x97, 0x89, 0x92,
+	0x5c, 0xc8, 0xac, 0xb6, 0xde, 0xb3, 0xc7, 0xfe, 0xd0, 0x9f, 0x7a, 0x9f, 0x3e, 0xf7, 0x77, 0x86,
+	0x0a, 0xbc, 0x95, 0x01, 0x7d, 0x02, 0xdd, 0xb3, 0x32, 0xc9, 0x45, 0xfa, 0x02, 0xe7, 0xb6, 0xc1,
+	0xfd, 0x87, 0xb7, 0xc6, 0xae, 0x9c, 0xf5, 0x60, 0xe2, 0xad, 0x4a, 0x8d, 0x36, 0x27, 0x37, 0x41,
+	0x5b, 0xdb, 0x41, 0x0f, 0xc1, 0xaf, 0x22, 0x56, 0x7d, 0x54, 0xe0, 0x7e, 0xf8, 0xb1, 0x05, 0xdd,
+	0x75, 0x96, 0xff, 0xbe, 0xb6, 0x23, 0x68, 0x9f, 0x5c, 0xa8, 0x52, 0x1a, 0x17, 0xc8, 0xd1, 0xd6,
+	0x0a, 0x76, 0x1b, 0x2b, 0xb8, 0x0d, 0x37, 0x22, 0xcc, 0x31, 0x2e, 0xb0, 0xb1, 0xa1, 0xa6, 0x48,
+	0x23, 0xe8, 0xbe, 0x89, 0x73, 0xc1, 0x63, 0xa3, 0x34, 0xf3, 0xff, 0x21, 0xe7, 0xc6, 0xa6, 0x6a,
+	0x63, 0x72, 0xba, 0x58, 0x06, 0xe4, 0xdb, 0x32, 0x20, 0xdf, 0x97, 0x01, 0xf9, 0xb9, 0x0c, 0xc8,
+	0xd7, 0xeb, 0x80, 0x2c, 0xae, 0x03, 0xf2, 0xf6, 0xc1, 0xdf, 0x8d, 0xf1, 0x03, 0xa6, 0xa5, 0x11,
+	0x4a, 0x86, 0xf5, 0x4b, 0x4a, 0xda, 0xf6, 0x4d, 0x3c, 0xfa, 0x35, 0x00, 0x70, 0x72, 0x8d, 0x4d,
+	0x6c, 0x03, 0x00, 0x00,
+}
+
+func (m *Slash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Slash) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintSlashing(dAtA, i, uint64(m.Address.Size()))
+	n1, err := m.Address.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n1
+	if len(m.EvidenceType) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.EvidenceType)))
+		i += copy(dAtA[i:], m.EvidenceType)
+	}
+	if m.EvidenceHeight != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintSlashing(dAtA, i, uint64(m.EvidenceHeight))
+	}
+	if m.Height != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Height))
+	}
+	if m.Slashed != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Slashed))
+	}
+	if m.Power != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Power))
+	}
+	if m.JailedUntil != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintSlashing(dAtA, i, uint64(m.JailedUntil))
+	}
+	if m.UnbondingSlashed != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintSlashing(dAtA, i, uint64(m.UnbondingSlashed))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Jail) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Jail) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintSlashing(dAtA, i, uint64(m.PublicKey.Size()))
+	n2, err := m.PublicKey.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n2
+	if m.Power != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Power))
+	}
+	if m.Until != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Until))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Unbonding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Unbonding) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintSlashing(dAtA, i, uint64(m.Address.Size()))
+	n3, err := m.Address.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n3
+	if m.Amount != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Amount))
+	}
+	if m.Height != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Height))
+	}
+	if m.ReleaseHeight != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintSlashing(dAtA, i, uint64(m.ReleaseHeight))
+	}
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintSlashing(dAtA, i, uint64(m.Validator.Size()))
+	n4, err := m.Validator.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n4
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Slash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Address.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = len(m.EvidenceType)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.EvidenceHeight != 0 {
+		n += 1 + sovSlashing(uint64(m.EvidenceHeight))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSlashing(uint64(m.Height))
+	}
+	if m.Slashed != 0 {
+		n += 1 + sovSlashing(uint64(m.Slashed))
+	}
+	if m.Power != 0 {
+		n += 1 + sovSlashing(uint64(m.Power))
+	}
+	if m.JailedUntil != 0 {
+		n += 1 + sovSlashing(uint64(m.JailedUntil))
+	}
+	if m.UnbondingSlashed != 0 {
+		n += 1 + sovSlashing(uint64(m.UnbondingSlashed))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Jail) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PublicKey.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.Power != 0 {
+		n += 1 + sovSlashing(uint64(m.Power))
+	}
+	if m.Until != 0 {
+		n += 1 + sovSlashing(uint64(m.Until))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Unbonding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Address.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.Amount != 0 {
+		n += 1 + sovSlashing(uint64(m.Amount))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSlashing(uint64(m.Height))
+	}
+	if m.ReleaseHeight != 0 {
+		n += 1 + sovSlashing(uint64(m.ReleaseHeight))
+	}
+	l = m.Validator.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovSlashing(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozSlashing(x uint64) (n int) {
+	return sovSlashing(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Slash) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Slash: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Slash: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvidenceType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceHeight", wireType)
+			}
+			m.EvidenceHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EvidenceHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashed", wireType)
+			}
+			m.Slashed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slashed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			m.JailedUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailedUntil |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingSlashed", wireType)
+			}
+			m.UnbondingSlashed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingSlashed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Jail) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Jail: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Jail: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PublicKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Until", wireType)
+			}
+			m.Until = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Until |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Unbonding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Unbonding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Unbonding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseHeight", wireType)
+			}
+			m.ReleaseHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReleaseHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSlashing(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSlashing
+			}
+			iNdEx += length
+			if iNdEx < 0 {
+				return 0, ErrInvalidLengthSlashing
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowSlashing
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipSlashing(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+				if iNdEx < 0 {
+					return 0, ErrInvalidLengthSlashing
+				}
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthSlashing = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSlashing   = fmt.Errorf("proto: integer overflow")
+)
@@ -0,0 +1,287 @@
+package execution
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/slashing"
+	"github.com/hyperledger/burrow/execution/state"
+	"github.com/hyperledger/burrow/genesis"
+	"github.com/hyperledger/burrow/permission"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestSlashing(t *testing.T) {
+	exe, st := makeSlashingExecutor(t, func(genDoc *genesis.GenesisDoc) {
+		genDoc.Params.SlashFraction = "1/10"
+		genDoc.Params.JailBlocks = 2
+	})
+
+	offender := users[1].GetAddress()
+	height := exe.block.Height
+	beginBlock := func(evidence ...types.Evidence) {
+		require.NoError(t, exe.BeginBlock(&types.RequestBeginBlock{
+			Header:              types.Header{Height: int64(exe.block.Height)},
+			ByzantineValidators: evidence,
+		}))
+	}
+
+	// Slash and jail
+	beginBlock(types.Evidence{
+		Type:      "duplicate/vote",
+		Validator: types.Validator{Address: offender.Bytes(), Power: 100},
+		Height:    int64(height - 1),
+	})
+	expected := &slashing.Slash{
+		Address:        offender,
+		EvidenceType:   "duplicate/vote",
+		EvidenceHeight: height - 1,
+		Height:         height,
+		Slashed:        10,
+		Power:          90,
+		JailedUntil:    height + 2,
+	}
+	require.Len(t, exe.block.Events, 1)
+	assert.Equal(t, expected, exe.block.Events[0].Slash)
+	assert.Nil(t, exe.block.Events[0].Header.Exception)
+	_, err := exe.Commit(nil)
+	require.NoError(t, err)
+
+	var slashes []*slashing.Slash
+	require.NoError(t, st.IterateSlashes(func(slash *slashing.Slash) error {
+		slashes = append(slashes, slash)
+		return nil
+	}))
+	assert.Equal(t, []*slashing.Slash{expected}, slashes)
+	assertPower(t, st, offender, 0)
+	jail, err := st.GetJail(offender)
+	require.NoError(t, err)
+	require.NotNil(t, jail)
+	assert.Equal(t, uint64(90), jail.Power)
+
+	// Serve time
+	beginBlock()
+	_, err = exe.Commit(nil)
+	require.NoError(t, err)
+	assertPower(t, st, offender, 0)
+
+	// Release
+	beginBlock()
+	assert.Len(t, exe.block.Events, 0)
+	_, err = exe.Commit(nil)
+	require.NoError(t, err)
+	assertPower(t, st, offender, 90)
+	jail, err = st.GetJail(offender)
+	require.NoError(t, err)
+	assert.Nil(t, jail)
+}
+
+func TestSlashing_PowerUnchanged(t *testing.T) {
+	exe, st := makeSlashingExecutor(t, func(genDoc *genesis.GenesisDoc) {
+		genDoc.Params.SlashFraction = "1/10"
+		genDoc.Params.JailBlocks = 2
+		genDoc.Params.UnbondingBlocks = 5
+	})
+	offender := users[2]
+	delegator := users[4]
+	height := exe.block.Height
+	beginBlock := func(evidence ...types.Evidence) {
+		require.NoError(t, exe.BeginBlock(&types.RequestBeginBlock{
+			Header:              types.Header{Height: int64(exe.block.Height)},
+			ByzantineValidators: evidence,
+		}))
+	}
+	execute := func(tx payload.Payload, signer acm.AddressableSigner) {
+		txEnv := txs.Enclose(exe.params.ChainID, tx)
+		require.NoError(t, txEnv.Sign(signer))
+		txe, err := exe.Execute(txEnv)
+		require.NoError(t, err)
+		require.Nil(t, txe.Exception)
+	}
+	commit := func() {
+		_, err := exe.Commit(nil)
+		require.NoError(t, err)
+	}
+
+	// The offender has stake delegated to it and unbonding from it
+	beginBlock()
+	delegateTx := payload.NewDelegateTx(delegator.GetAddress(), offender.GetAddress(), 20)
+	delegateTx.Input.Sequence = 1
+	execute(delegateTx, delegator)
+	unbondTx := payload.NewUnbondTx(offender.GetAddress(), 50)
+	unbondTx.Input.Sequence = 1
+	execute(unbondTx, offender)
+	commit()
+	assertPower(t, st, offender.GetAddress(), 70)
+
+	// Jailing another validator first leaves too little flow to remove the offender this block
+	evidence := func(validator crypto.Address) types.Evidence {
+		return types.Evidence{
+			Type:      "duplicate/vote",
+			Validator: types.Validator{Address: validator.Bytes()},
+			Height:    int64(height),
+		}
+	}
+	beginBlock(evidence(users[1].GetAddress()), evidence(offender.GetAddress()))
+	require.Len(t, exe.block.Events, 2)
+	assert.Equal(t, &slashing.Slash{
+		Address:          offender.GetAddress(),
+		EvidenceType:     "duplicate/vote",
+		EvidenceHeight:   height,
+		Height:           height + 1,
+		Slashed:          7,
+		UnbondingSlashed: 5,
+		Power:            63,
+		JailedUntil:      height + 3,
+	}, exe.block.Events[1].Slash)
+	commit()
+	// The stake is slashed even though the offender keeps its power for now
+	assertPower(t, st, users[1].GetAddress(), 0)
+	assertPower(t, st, offender.GetAddress(), 70)
+	assert.Equal(t, uint64(18), delegations(t, st)[0].Amount)
+	assert.Equal(t, uint64(45), unbondings(t, st)[0].Amount)
+	jail, err := st.GetJail(offender.GetAddress())
+	require.NoError(t, err)
+	require.NotNil(t, jail)
+	assert.Equal(t, uint64(63), jail.Power)
+
+	// It is removed as soon as the flow allows
+	beginBlock()
+	commit()
+	assertPower(t, st, offender.GetAddress(), 0)
+
+	// And released with the slashed power
+	beginBlock()
+	commit()
+	assertPower(t, st, offender.GetAddress(), 63)
+	jail, err = st.GetJail(offender.GetAddress())
+	require.NoError(t, err)
+	assert.Nil(t, jail)
+}
+
+func TestUnbonding(t *testing.T) {
+	exe, st := makeSlashingExecutor(t, func(genDoc *genesis.GenesisDoc) {
+		genDoc.Params.SlashFraction = "1/10"
+		genDoc.Params.UnbondingBlocks = 2
+	})
+	validator := users[1]
+	balance := getAccount(st, validator.GetAddress()).Balance
+	height := exe.block.Height
+
+	// Unbond half the stake
+	tx := &payload.UnbondTx{
+		Input:  &payload.TxInput{Address: validator.GetAddress(), Sequence: 1},
+		Output: &payload.TxOutput{Address: validator.GetAddress(), Amount: 50},
+	}
+	txEnv := txs.Enclose(exe.params.ChainID, tx)
+	require.NoError(t, txEnv.Sign(validator))
+	require.NoError(t, exe.BeginBlock(&types.RequestBeginBlock{Header: types.Header{Height: int64(height)}}))
+	txe, err := exe.Execute(txEnv)
+	require.NoError(t, err)
+	require.Nil(t, txe.Exception)
+	_, err = exe.Commit(nil)
+	require.NoError(t, err)
+	assertPower(t, st, validator.GetAddress(), 50)
+	assert.Equal(t, balance, getAccount(st, validator.GetAddress()).Balance)
+	assert.Equal(t, []*slashing.Unbonding{{
+		Address:       validator.GetAddress(),
+		Validator:     validator.GetAddress(),
+		Amount:        50,
+		Height:        height,
+		ReleaseHeight: height + 2,
+	}}, unbondings(t, st))
+
+	// Misbehaviour from before the unbonding comes to light during the unbonding period
+	require.NoError(t, exe.BeginBlock(&types.RequestBeginBlock{
+		Header: types.Header{Height: int64(height + 1)},
+		ByzantineValidators: []types.Evidence{{
+			Type:      "duplicate/vote",
+			Validator: types.Validator{Address: validator.GetAddress().Bytes()},
+			Height:    int64(height),
+		}},
+	}))
+	require.Len(t, exe.block.Events, 1)
+	assert.Equal(t, uint64(5), exe.block.Events[0].Slash.Slashed)
+	assert.Equal(t, uint64(5), exe.block.Events[0].Slash.UnbondingSlashed)
+	_, err = exe.Commit(nil)
+	require.NoError(t, err)
+	assertPower(t, st, validator.GetAddress(), 45)
+	require.Len(t, unbondings(t, st), 1)
+	assert.Equal(t, uint64(45), unbondings(t, st)[0].Amount)
+
+	// Release
+	require.NoError(t, exe.BeginBlock(&types.RequestBeginBlock{Header: types.Header{Height: int64(height + 2)}}))
+	_, err = exe.Commit(nil)
+	require.NoError(t, err)
+	assert.Equal(t, balance+45, getAccount(st, validator.GetAddress()).Balance)
+	assert.Empty(t, unbondings(t, st))
+}
+
+func TestSlashing_ParseFraction(t *testing.T) {
+	for fraction, expected := range map[string]*big.Rat{
+		"":     new(big.Rat),
+		"0.05": big.NewRat(1, 20),
+		"1/3":  big.NewRat(1, 3),
+		"1":    big.NewRat(1, 1),
+	} {
+		rat, err := slashing.ParseFraction(fraction)
+		require.NoError(t, err)
+		assert.Equal(t, 0, expected.Cmp(rat), "%s should parse to %v", fraction, expected)
+	}
+	for _, fraction := range []string{"1.5", "-1/2", "half"} {
+		_, err := slashing.ParseFraction(fraction)
+		assert.Error(t, err, "%s should not parse", fraction)
+	}
+}
+
+func assertPower(t *testing.T, st *state.State, address crypto.Address, power int64) {
+	actual, err := st.Power(address)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(power), actual)
+}
+
+func makeSlashingExecutor(t *testing.T, setParams func(genDoc *genesis.GenesisDoc)) (*testExecutor, *state.State) {
+	genDoc := newBaseGenDoc(permission.AllAccountPermissions, permission.AllAccountPermissions)
+	genDoc.Validators = nil
+	for _, user := range users[:4] {
+		validatorAccount := genesis.BasicAccount{
+			Address:   user.GetAddress(),
+			PublicKey: user.GetPublicKey(),
+			Amount:    100,
+		}
+		genDoc.Validators = append(genDoc.Validators, genesis.Validator{
+			BasicAccount: validatorAccount,
+			UnbondTo:     []genesis.BasicAccount{validatorAccount},
+		})
+	}
+	setParams(&genDoc)
+
+	st, err := state.MakeGenesisState(dbm.NewMemDB(), &genDoc)
+	require.NoError(t, err)
+	require.NoError(t, st.InitialCommit())
+	params, err := ParamsFromGenesis(&genDoc)
+	require.NoError(t, err)
+	blockchain := newBlockchain(&genDoc)
+	blockchain.CommitBlockAtHeight(time.Now(), []byte("hashily"), st.Hash(), HeightAtVersion(st.Version()))
+	return &testExecutor{
+		Blockchain: blockchain,
+		executor:   newExecutor("TestSlashing", true, params, st, blockchain, nil, logger),
+	}, st
+}
+
+func unbondings(t *testing.T, st *state.State) []*slashing.Unbonding {
+	var unbondings []*slashing.Unbonding
+	require.NoError(t, st.IterateUnbondings(func(unbonding *slashing.Unbonding) error {
+		unbondings = append(unbondings, unbonding)
+		return nil
+	}))
+	return unbondings
+}
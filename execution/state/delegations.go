@@ -0,0 +1,61 @@
+package state
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/encoding"
+	"github.com/hyperledger/burrow/execution/delegation"
+)
+
+var _ delegation.IterableReader = &State{}
+
+func (s *ReadState) GetDelegation(validator, delegator crypto.Address) (*delegation.Delegation, error) {
+	tree, err := s.Forest.Reader(keys.Delegation.Prefix())
+	if err != nil {
+		return nil, err
+	}
+	delegationBytes := tree.Get(keys.Delegation.KeyNoPrefix(validator, delegator))
+	if delegationBytes == nil {
+		return nil, nil
+	}
+	d := new(delegation.Delegation)
+	return d, encoding.Decode(delegationBytes, d)
+}
+
+func (ws *writeState) UpdateDelegation(d *delegation.Delegation) error {
+	tree, err := ws.forest.Writer(keys.Delegation.Prefix())
+	if err != nil {
+		return err
+	}
+	bs, err := encoding.Encode(d)
+	if err != nil {
+		return err
+	}
+	tree.Set(keys.Delegation.KeyNoPrefix(d.Validator, d.Delegator), bs)
+	return nil
+}
+
+func (ws *writeState) RemoveDelegation(validator, delegator crypto.Address) error {
+	tree, err := ws.forest.Writer(keys.Delegation.Prefix())
+	if err != nil {
+		return err
+	}
+	tree.Delete(keys.Delegation.KeyNoPrefix(validator, delegator))
+	return nil
+}
+
+func (s *ReadState) IterateDelegations(consumer func(*delegation.Delegation) error) error {
+	tree, err := s.Forest.Reader(keys.Delegation.Prefix())
+	if err != nil {
+		return err
+	}
+	return tree.Iterate(nil, nil, true, func(key []byte, value []byte) error {
+		d := new(delegation.Delegation)
+		err := encoding.Decode(value, d)
+		if err != nil {
+			return fmt.Errorf("State.IterateDelegations() could not iterate over delegations: %v", err)
+		}
+		return consumer(d)
+	})
+}
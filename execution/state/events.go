@@ -10,9 +10,10 @@ import (
 )
 
 func (ws *writeState) AddBlock(be *exec.BlockExecution) error {
-	// If there are no transactions, do not store anything. This reduces the amount of data we store and
-	// prevents the iavl tree from changing, which means the AppHash does not change.
-	if len(be.TxExecutions) == 0 {
+	// If there are no transactions and no events of the block itself (slashes and payouts), do not store anything. This
+	// reduces the amount of data we store and prevents the iavl tree from changing, which means the AppHash does not
+	// change. Events of the block are only emitted when they change state so the AppHash changes with them anyway.
+	if len(be.TxExecutions) == 0 && len(be.Events) == 0 {
 		return nil
 	}
 
@@ -87,6 +88,10 @@ func (s *ReadState) TxsAtHeight(height uint64) ([]*exec.TxExecution, error) {
 	var txExecutions []*exec.TxExecution
 	err := s.IterateStreamEvents(&height, &height,
 		func(ev *exec.StreamEvent) error {
+			// Events outside of any transaction belong to the block
+			if ev.Event != nil && len(stack) == 0 {
+				return nil
+			}
 			// Keep trying to consume TxExecutions at from events at this height
 			txe, err := stack.Consume(ev)
 			if err != nil {
@@ -137,6 +142,10 @@ func (s *ReadState) TxByHash(txHash []byte) (*exec.TxExecution, error) {
 			return nil, err
 		}
 
+		// Events outside of any transaction belong to the block
+		if ev.Event != nil && len(stack) == 0 {
+			continue
+		}
 		txe, err := stack.Consume(ev)
 		if err != nil {
 			return nil, fmt.Errorf("%s: %v", errHeader, err)
@@ -10,6 +10,7 @@ import (
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/crypto/sha3"
 	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/slashing"
 	"github.com/stretchr/testify/require"
 	dbm "github.com/tendermint/tm-db"
 )
@@ -56,6 +57,71 @@ func TestWriteState_AddBlock(t *testing.T) {
 	require.Equal(t, numTxs, uint64(len(txExecutions)))
 }
 
+func TestWriteState_AddBlockWithSlash(t *testing.T) {
+	s := NewState(dbm.NewMemDB())
+	numTxs := uint64(3)
+	events := uint64(2)
+	// A block with nothing but a slash must still be stored
+	slashOnly := mkBlock(1, 0, 0)
+	slashOnly.Slash(&slashing.Slash{Address: crypto.Address{1}, Height: 1, Slashed: 10}, nil)
+	withTxs := mkBlock(2, numTxs, events)
+	withTxs.Slash(&slashing.Slash{Address: crypto.Address{2}, Height: 2, Slashed: 10}, nil)
+	for _, block := range []*exec.BlockExecution{slashOnly, withTxs} {
+		block := block
+		_, _, err := s.Update(func(ws Updatable) error {
+			return ws.AddBlock(block)
+		})
+		require.NoError(t, err)
+	}
+
+	var slashes []*slashing.Slash
+	start, end := uint64(1), uint64(2)
+	err := s.IterateStreamEvents(&start, &end, func(ev *exec.StreamEvent) error {
+		if ev.Event != nil && ev.Event.Slash != nil {
+			slashes = append(slashes, ev.Event.Slash)
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, slashes, 2)
+	require.Equal(t, crypto.Address{1}, slashes[0].Address)
+
+	txExecutions, err := s.TxsAtHeight(1)
+	require.NoError(t, err)
+	require.Len(t, txExecutions, 0)
+
+	txExecutions, err = s.TxsAtHeight(2)
+	require.NoError(t, err)
+	require.Len(t, txExecutions, int(numTxs))
+
+	tx := mkTx(2, numTxs-1, events)
+	txOut, err := s.TxByHash(tx.TxHash)
+	require.NoError(t, err)
+	require.Equal(t, source.JSONString(tx), source.JSONString(txOut))
+}
+
+func TestReadState_TxsAtHeightWithPayout(t *testing.T) {
+	s := NewState(dbm.NewMemDB())
+	height := uint64(3)
+	numTxs := uint64(2)
+	events := uint64(2)
+	block := mkBlock(height, numTxs, events)
+	block.Payout(&exec.PayoutEvent{Address: crypto.Address{1}, Power: 100, Fees: 2, Reward: 5})
+	_, _, err := s.Update(func(ws Updatable) error {
+		return ws.AddBlock(block)
+	})
+	require.NoError(t, err)
+
+	txExecutions, err := s.TxsAtHeight(height)
+	require.NoError(t, err)
+	require.Len(t, txExecutions, int(numTxs))
+
+	tx := mkTx(height, 0, events)
+	txOut, err := s.TxByHash(tx.TxHash)
+	require.NoError(t, err)
+	require.Equal(t, source.JSONString(tx), source.JSONString(txOut))
+}
+
 func TestNestedTxs(t *testing.T) {
 	s := NewState(dbm.NewMemDB())
 	height := uint64(2)
@@ -0,0 +1,210 @@
+package state
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/logging/structure"
+)
+
+type PruningStrategy string
+
+const (
+	// Keep every version of state (the default)
+	PruningKeepAll PruningStrategy = "keep-all"
+	// Keep only the last KeepLast versions of state
+	PruningKeepLast PruningStrategy = "keep-last"
+	// Keep the last KeepLast versions of state and the version at every height that is a multiple of KeepEvery
+	PruningKeepEvery PruningStrategy = "keep-every"
+)
+
+// Maximum number of versions of state deleted while holding the state lock so that pruning does not hold up commits
+const pruningBatchSize = 100
+
+type PruningConfig struct {
+	Strategy PruningStrategy
+	// Number of most recent versions of state to keep, defaults to (and must be at least) MinRetainedVersions
+	KeepLast uint64 `json:",omitempty" toml:",omitempty"`
+	// Interval between heights whose state is kept for the keep-every strategy
+	KeepEvery uint64 `json:",omitempty" toml:",omitempty"`
+}
+
+func DefaultPruningConfig() *PruningConfig {
+	return &PruningConfig{
+		Strategy: PruningKeepAll,
+	}
+}
+
+// Returns true if some versions of state should be pruned
+func (pc *PruningConfig) Enabled() bool {
+	return pc != nil && pc.Strategy != "" && pc.Strategy != PruningKeepAll
+}
+
+func (pc *PruningConfig) Validate() error {
+	if pc == nil {
+		return nil
+	}
+	switch pc.Strategy {
+	case "", PruningKeepAll:
+		return nil
+	case PruningKeepLast:
+	case PruningKeepEvery:
+		if pc.KeepEvery == 0 {
+			return fmt.Errorf("pruning strategy %s requires a positive KeepEvery", pc.Strategy)
+		}
+	default:
+		return fmt.Errorf("pruning strategy '%s' not recognised, must be one of %s, %s, or %s", pc.Strategy,
+			PruningKeepAll, PruningKeepLast, PruningKeepEvery)
+	}
+	if pc.KeepLast != 0 && pc.KeepLast < MinRetainedVersions {
+		return fmt.Errorf("pruning must keep at least the last %d versions of state but KeepLast is %d",
+			MinRetainedVersions, pc.KeepLast)
+	}
+	return nil
+}
+
+type PruningStats struct {
+	// Number of versions of state deleted since starting
+	VersionsPruned uint64
+	// Versions of state below this height have been pruned (other than those kept by the keep-every strategy)
+	PrunedToHeight uint64
+	// Time taken by the last complete pruning run
+	LastDuration time.Duration
+}
+
+// Pruner deletes old versions of state in the background according to a PruningConfig. It is notified of each commit
+// but prunes in batches holding the state lock only for a batch at a time. Note that a query holding a ReadState at a
+// version that is pruned beneath it may fail, so queries should not expect to read at heights close to being pruned.
+type Pruner struct {
+	state     *State
+	keepLast  int64
+	keepEvery uint64
+	// The next version of state to consider for pruning
+	next    int64
+	trigger chan struct{}
+	mtx     sync.RWMutex
+	stats   PruningStats
+	logger  *logging.Logger
+}
+
+// Create a Pruner for s, the Pruner is notified of commits to s from here on
+func NewPruner(s *State, config *PruningConfig, logger *logging.Logger) (*Pruner, error) {
+	err := config.Validate()
+	if err != nil {
+		return nil, err
+	}
+	if !config.Enabled() {
+		return nil, fmt.Errorf("NewPruner() called with pruning strategy %s that prunes nothing", config.Strategy)
+	}
+	keepLast := config.KeepLast
+	if keepLast == 0 {
+		keepLast = MinRetainedVersions
+	}
+	p := &Pruner{
+		state:    s,
+		keepLast: int64(keepLast),
+		next:     VersionOffset,
+		trigger:  make(chan struct{}, 1),
+		logger:   logger.WithScope("Pruner").With(structure.ComponentKey, "Pruner"),
+	}
+	if config.Strategy == PruningKeepEvery {
+		p.keepEvery = config.KeepEvery
+	}
+	s.Lock()
+	s.pruner = p
+	s.Unlock()
+	return p, nil
+}
+
+// Run prunes after each commit until ctx is done
+func (p *Pruner) Run(ctx context.Context) {
+	// Catch up with any backlog from before we were started
+	p.notify()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-p.trigger:
+			err := p.Prune(ctx)
+			if err != nil {
+				p.logger.InfoMsg("could not prune state", structure.ErrorKey, err)
+			}
+		}
+	}
+}
+
+// Prune all versions of state due to be pruned
+func (p *Pruner) Prune(ctx context.Context) error {
+	target := p.state.Version() - p.keepLast + 1
+	if target <= p.next {
+		return nil
+	}
+	start := time.Now()
+	total := 0
+	for p.next < target {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		until := p.next + pruningBatchSize
+		if until > target {
+			until = target
+		}
+		p.state.Lock()
+		pruned, err := p.state.writeState.forest.Prune(p.next, until, p.keep)
+		p.state.Unlock()
+		p.mtx.Lock()
+		p.stats.VersionsPruned += uint64(pruned)
+		p.mtx.Unlock()
+		total += pruned
+		if err != nil {
+			return err
+		}
+		p.next = until
+		p.mtx.Lock()
+		p.stats.PrunedToHeight = HeightAtVersion(until)
+		p.mtx.Unlock()
+		p.logger.TraceMsg("pruned batch of state versions", "versions_pruned", pruned,
+			"pruned_to_height", HeightAtVersion(until))
+	}
+	duration := time.Since(start)
+	p.mtx.Lock()
+	p.stats.LastDuration = duration
+	p.mtx.Unlock()
+	if total > 0 {
+		p.logger.InfoMsg("pruned old versions of state", "versions_pruned", total,
+			"pruned_to_height", HeightAtVersion(target), "duration", duration.String())
+	}
+	return nil
+}
+
+func (p *Pruner) Stats() PruningStats {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+	return p.stats
+}
+
+// Wake the pruner without blocking, a pending wake up covers any further commits
+func (p *Pruner) notify() {
+	select {
+	case p.trigger <- struct{}{}:
+	default:
+	}
+}
+
+func (p *Pruner) keep(version int64) bool {
+	return p.keepEvery > 0 && HeightAtVersion(version)%p.keepEvery == 0
+}
+
+// Returns the stats of the pruner for this state, zero if pruning is not enabled
+func (s *State) GetPruningStats() PruningStats {
+	s.Lock()
+	pruner := s.pruner
+	s.Unlock()
+	if pruner == nil {
+		return PruningStats{}
+	}
+	return pruner.Stats()
+}
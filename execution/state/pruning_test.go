@@ -0,0 +1,66 @@
+package state
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestPruningConfig_Validate(t *testing.T) {
+	require.NoError(t, (*PruningConfig)(nil).Validate())
+	require.NoError(t, DefaultPruningConfig().Validate())
+	require.NoError(t, (&PruningConfig{Strategy: PruningKeepLast}).Validate())
+	require.NoError(t, (&PruningConfig{Strategy: PruningKeepEvery, KeepEvery: 100, KeepLast: 1000}).Validate())
+	require.Error(t, (&PruningConfig{Strategy: PruningKeepEvery}).Validate())
+	require.Error(t, (&PruningConfig{Strategy: PruningKeepLast, KeepLast: MinRetainedVersions - 1}).Validate())
+	require.Error(t, (&PruningConfig{Strategy: "keep-some"}).Validate())
+	assert.False(t, DefaultPruningConfig().Enabled())
+	assert.True(t, (&PruningConfig{Strategy: PruningKeepLast}).Enabled())
+}
+
+func TestPruner(t *testing.T) {
+	const keepEvery = 7
+	const blocks = 250
+	db := dbm.NewMemDB()
+	s := NewState(db)
+	require.NoError(t, s.InitialCommit())
+	pruner, err := NewPruner(s, &PruningConfig{Strategy: PruningKeepEvery, KeepEvery: keepEvery},
+		logging.NewNoopLogger())
+	require.NoError(t, err)
+
+	account := acm.NewAccountFromSecret("Foo")
+	for balance := uint64(1); balance <= blocks; balance++ {
+		account.Balance = balance
+		_, _, err := s.Update(func(ws Updatable) error {
+			return ws.UpdateAccount(account)
+		})
+		require.NoError(t, err)
+	}
+	require.NoError(t, pruner.Prune(context.Background()))
+
+	prunedTo := uint64(blocks - MinRetainedVersions + 1)
+	stats := s.GetPruningStats()
+	assert.Equal(t, prunedTo, stats.PrunedToHeight)
+	assert.Equal(t, prunedTo-prunedTo/keepEvery-1, stats.VersionsPruned)
+
+	for height := uint64(1); height <= blocks; height++ {
+		rs, err := s.AtHeight(height)
+		if height < prunedTo && height%keepEvery != 0 {
+			require.Error(t, err, "state at height %d should have been pruned", height)
+			continue
+		}
+		require.NoError(t, err, "state at height %d should have been kept", height)
+		accountOut, err := rs.GetAccount(account.Address)
+		require.NoError(t, err)
+		assert.Equal(t, height, accountOut.Balance)
+	}
+
+	// We can restart from the retained versions
+	_, err = LoadState(db, s.Version())
+	require.NoError(t, err)
+}
@@ -0,0 +1,139 @@
+package state
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/encoding"
+	"github.com/hyperledger/burrow/execution/slashing"
+)
+
+var _ slashing.IterableReader = &State{}
+
+func (s *ReadState) GetJail(address crypto.Address) (*slashing.Jail, error) {
+	tree, err := s.Forest.Reader(keys.Jail.Prefix())
+	if err != nil {
+		return nil, err
+	}
+	jailBytes := tree.Get(keys.Jail.KeyNoPrefix(address))
+	if jailBytes == nil {
+		return nil, nil
+	}
+	jail := new(slashing.Jail)
+	return jail, encoding.Decode(jailBytes, jail)
+}
+
+func (ws *writeState) AddSlash(slash *slashing.Slash) error {
+	tree, err := ws.forest.Writer(keys.Slash.Prefix())
+	if err != nil {
+		return err
+	}
+	bs, err := encoding.Encode(slash)
+	if err != nil {
+		return err
+	}
+	tree.Set(keys.Slash.KeyNoPrefix(slash.Height, slash.Address, slash.EvidenceHeight), bs)
+	return nil
+}
+
+func (ws *writeState) UpdateJail(jail *slashing.Jail) error {
+	tree, err := ws.forest.Writer(keys.Jail.Prefix())
+	if err != nil {
+		return err
+	}
+	bs, err := encoding.Encode(jail)
+	if err != nil {
+		return err
+	}
+	tree.Set(keys.Jail.KeyNoPrefix(jail.GetAddress()), bs)
+	return nil
+}
+
+func (ws *writeState) RemoveJail(address crypto.Address) error {
+	tree, err := ws.forest.Writer(keys.Jail.Prefix())
+	if err != nil {
+		return err
+	}
+	tree.Delete(keys.Jail.KeyNoPrefix(address))
+	return nil
+}
+
+func (s *ReadState) IterateSlashes(consumer func(*slashing.Slash) error) error {
+	tree, err := s.Forest.Reader(keys.Slash.Prefix())
+	if err != nil {
+		return err
+	}
+	return tree.Iterate(nil, nil, true, func(key []byte, value []byte) error {
+		slash := new(slashing.Slash)
+		err := encoding.Decode(value, slash)
+		if err != nil {
+			return fmt.Errorf("State.IterateSlashes() could not iterate over slashes: %v", err)
+		}
+		return consumer(slash)
+	})
+}
+
+func (s *ReadState) IterateJails(consumer func(*slashing.Jail) error) error {
+	tree, err := s.Forest.Reader(keys.Jail.Prefix())
+	if err != nil {
+		return err
+	}
+	return tree.Iterate(nil, nil, true, func(key []byte, value []byte) error {
+		jail := new(slashing.Jail)
+		err := encoding.Decode(value, jail)
+		if err != nil {
+			return fmt.Errorf("State.IterateJails() could not iterate over jails: %v", err)
+		}
+		return consumer(jail)
+	})
+}
+
+func (s *ReadState) GetUnbonding(releaseHeight uint64, validator, address crypto.Address) (*slashing.Unbonding, error) {
+	tree, err := s.Forest.Reader(keys.Unbonding.Prefix())
+	if err != nil {
+		return nil, err
+	}
+	unbondingBytes := tree.Get(keys.Unbonding.KeyNoPrefix(releaseHeight, validator, address))
+	if unbondingBytes == nil {
+		return nil, nil
+	}
+	unbonding := new(slashing.Unbonding)
+	return unbonding, encoding.Decode(unbondingBytes, unbonding)
+}
+
+func (ws *writeState) UpdateUnbonding(unbonding *slashing.Unbonding) error {
+	tree, err := ws.forest.Writer(keys.Unbonding.Prefix())
+	if err != nil {
+		return err
+	}
+	bs, err := encoding.Encode(unbonding)
+	if err != nil {
+		return err
+	}
+	tree.Set(keys.Unbonding.KeyNoPrefix(unbonding.ReleaseHeight, unbonding.Validator, unbonding.Address), bs)
+	return nil
+}
+
+func (ws *writeState) RemoveUnbonding(releaseHeight uint64, validator, address crypto.Address) error {
+	tree, err := ws.forest.Writer(keys.Unbonding.Prefix())
+	if err != nil {
+		return err
+	}
+	tree.Delete(keys.Unbonding.KeyNoPrefix(releaseHeight, validator, address))
+	return nil
+}
+
+func (s *ReadState) IterateUnbondings(consumer func(*slashing.Unbonding) error) error {
+	tree, err := s.Forest.Reader(keys.Unbonding.Prefix())
+	if err != nil {
+		return err
+	}
+	return tree.Iterate(nil, nil, true, func(key []byte, value []byte) error {
+		unbonding := new(slashing.Unbonding)
+		err := encoding.Decode(value, unbonding)
+		if err != nil {
+			return fmt.Errorf("State.IterateUnbondings() could not iterate over unbondings: %v", err)
+		}
+		return consumer(unbonding)
+	})
+}
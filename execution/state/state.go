@@ -24,9 +24,11 @@ import (
 	"github.com/hyperledger/burrow/acm/validator"
 	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/delegation"
 	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/execution/names"
 	"github.com/hyperledger/burrow/execution/proposal"
+	"github.com/hyperledger/burrow/execution/slashing"
 	"github.com/hyperledger/burrow/genesis"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/storage"
@@ -36,8 +38,10 @@ import (
 
 const (
 	DefaultValidatorsWindowSize = 10
-	defaultCacheCapacity        = 1024
-	uint64Length                = 8
+	// The fewest versions of state we may retain since LoadState rebuilds the validator ring from the versions it spans
+	MinRetainedVersions  = DefaultValidatorsWindowSize + 1
+	defaultCacheCapacity = 1024
+	uint64Length         = 8
 	// Prefix under which the versioned merkle state tree resides - tracking previous versions of history
 	forestPrefix = "f"
 	// Prefix for storage outside for the merkel tree - does not contribute to AppHash as a result
@@ -51,14 +55,18 @@ var _ names.IterableReader = &State{}
 var _ Updatable = &writeState{}
 
 type KeyFormatStore struct {
-	Account   *storage.MustKeyFormat
-	Storage   *storage.MustKeyFormat
-	Name      *storage.MustKeyFormat
-	Proposal  *storage.MustKeyFormat
-	Validator *storage.MustKeyFormat
-	Event     *storage.MustKeyFormat
-	TxHash    *storage.MustKeyFormat
-	Abi       *storage.MustKeyFormat
+	Account    *storage.MustKeyFormat
+	Storage    *storage.MustKeyFormat
+	Name       *storage.MustKeyFormat
+	Proposal   *storage.MustKeyFormat
+	Validator  *storage.MustKeyFormat
+	Event      *storage.MustKeyFormat
+	Slash      *storage.MustKeyFormat
+	Jail       *storage.MustKeyFormat
+	Unbonding  *storage.MustKeyFormat
+	Delegation *storage.MustKeyFormat
+	TxHash     *storage.MustKeyFormat
+	Abi        *storage.MustKeyFormat
 }
 
 var keys = KeyFormatStore{
@@ -75,6 +83,14 @@ var keys = KeyFormatStore{
 	Validator: storage.NewMustKeyFormat("v", crypto.AddressLength),
 	// Height -> StreamEvent
 	Event: storage.NewMustKeyFormat("e", uint64Length),
+	// Height, ValidatorAddress, EvidenceHeight -> Slash
+	Slash: storage.NewMustKeyFormat("x", uint64Length, crypto.AddressLength, uint64Length),
+	// ValidatorAddress -> Jail
+	Jail: storage.NewMustKeyFormat("j", crypto.AddressLength),
+	// ReleaseHeight, ValidatorAddress, Address -> Unbonding
+	Unbonding: storage.NewMustKeyFormat("u", uint64Length, crypto.AddressLength, crypto.AddressLength),
+	// ValidatorAddress, DelegatorAddress -> Delegation
+	Delegation: storage.NewMustKeyFormat("d", crypto.AddressLength, crypto.AddressLength),
 
 	// Stored on the plain
 	// TxHash -> TxHeight, TxIndex
@@ -98,6 +114,8 @@ type Updatable interface {
 	names.Writer
 	proposal.Writer
 	validator.Writer
+	slashing.Writer
+	delegation.Writer
 	AddBlock(blockExecution *exec.BlockExecution) error
 }
 
@@ -121,7 +139,9 @@ type State struct {
 	db dbm.DB
 	ReadState
 	writeState writeState
-	logger     *logging.Logger
+	// Deletes old versions of state in the background when set
+	pruner *Pruner
+	logger *logging.Logger
 }
 
 // Create a new State object
@@ -247,6 +267,23 @@ func (s *State) LoadHeight(height uint64) (*ReadState, error) {
 	}, nil
 }
 
+// Get a ReadState serving the accounts, storage, names, proposals and validators committed at height. Unlike LoadHeight
+// this does not load the validator history so only needs the single version of state at height to have been retained.
+func (s *State) AtHeight(height uint64) (*ReadState, error) {
+	version := VersionAtHeight(height)
+	if latest := s.Version(); version > latest {
+		return nil, fmt.Errorf("cannot read state at height %d since the latest committed height is %d",
+			height, HeightAtVersion(latest))
+	}
+	forest, err := s.writeState.forest.GetImmutable(version)
+	if err != nil {
+		return nil, fmt.Errorf("state at height %d is not available (it may have been pruned): %v", height, err)
+	}
+	return &ReadState{
+		Forest: forest,
+	}, nil
+}
+
 // Perform updates to state whilst holding the write lock, allows a commit to hold the write lock across multiple
 // operations while preventing interlaced reads and writes
 func (s *State) Update(updater func(up Updatable) error) ([]byte, int64, error) {
@@ -273,6 +310,9 @@ func (s *State) commit() ([]byte, int64, error) {
 		//noinspection ALL
 		s.logger.InfoMsg("validator set changes", "total_power_change", totalPowerChange, "total_flow", totalFlow)
 	}
+	if s.pruner != nil {
+		s.pruner.notify()
+	}
 	return hash, version, err
 }
 
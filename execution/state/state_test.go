@@ -40,3 +40,27 @@ func TestState_UpdateAccount(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, source.JSONString(account), source.JSONString(accountOut))
 }
+
+func TestState_AtHeight(t *testing.T) {
+	s := NewState(dbm.NewMemDB())
+	require.NoError(t, s.InitialCommit())
+	account := acm.NewAccountFromSecret("Foo")
+	const blocks = 20
+	for balance := uint64(1); balance <= blocks; balance++ {
+		account.Balance = balance
+		_, _, err := s.Update(func(ws Updatable) error {
+			return ws.UpdateAccount(account)
+		})
+		require.NoError(t, err)
+	}
+
+	for height := uint64(1); height <= blocks; height++ {
+		rs, err := s.AtHeight(height)
+		require.NoError(t, err)
+		accountOut, err := rs.GetAccount(account.Address)
+		require.NoError(t, err)
+		assert.Equal(t, height, accountOut.Balance)
+	}
+	_, err := s.AtHeight(blocks + 1)
+	require.Error(t, err)
+}
@@ -0,0 +1,161 @@
+package execution
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/execution/evm"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/state"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/logging/structure"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+)
+
+// Re-executes a committed transaction against the state it originally ran on, passing each EVM step to tracer.
+// The start of its block and the transactions preceding it in the block are replayed (untraced) first. Nothing is
+// persisted.
+func TraceTx(st *state.State, blockchain bcm.BlockchainInfo, params Params, txHash []byte, tracer evm.Tracer,
+	logger *logging.Logger) (*exec.TxExecution, error) {
+	const errHeader = "TraceTx():"
+
+	committed, err := st.TxByHash(txHash)
+	if err != nil {
+		return nil, fmt.Errorf("%s %v", errHeader, err)
+	}
+	if committed == nil {
+		return nil, fmt.Errorf("%s transaction with hash %X not found in state", errHeader, txHash)
+	}
+	height := committed.Height
+	block, err := st.TxsAtHeight(height)
+	if err != nil {
+		return nil, fmt.Errorf("%s %v", errHeader, err)
+	}
+	// State as of the end of the previous block
+	backend, historical, err := historicalState(st, blockchain, height-1)
+	if err != nil {
+		return nil, fmt.Errorf("%s %v", errHeader, err)
+	}
+
+	evidence, err := blockEvidence(st, height)
+	if err != nil {
+		return nil, fmt.Errorf("%s %v", errHeader, err)
+	}
+
+	gate := &tracerGate{tracer: tracer}
+	exe := newExecutor("TraceCache", true, params, readOnlyState{backend}, historical, nil,
+		logger.WithScope("TraceTx"), VMOptions(evm.TracerOption(gate)))
+
+	// Release jails and unbonded stake and slash as the block did before any of its transactions ran
+	err = exe.BeginBlock(&abciTypes.RequestBeginBlock{
+		Header:              abciTypes.Header{Height: int64(height)},
+		ByzantineValidators: evidence,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s %v", errHeader, err)
+	}
+
+	for _, txe := range block {
+		if bytes.Equal(txe.TxHash, txHash) {
+			gate.enabled = true
+			return exe.Execute(txe.Envelope)
+		}
+		_, err = exe.Execute(txe.Envelope)
+		if err != nil {
+			// This transaction failed when committed too, carry on as the committer did
+			logger.InfoMsg("Preceding transaction failed during replay", structure.ErrorKey, err,
+				structure.TxHashKey, txe.TxHash)
+		}
+	}
+	return nil, fmt.Errorf("%s transaction with hash %X not found in block at height %d", errHeader, txHash, height)
+}
+
+// Recovers the evidence acted on at the beginning of the block at height from the slashes it recorded. Any other
+// evidence Tendermint passed was against addresses with no stake so had no effect.
+func blockEvidence(st *state.State, height uint64) ([]abciTypes.Evidence, error) {
+	var evidence []abciTypes.Evidence
+	err := st.IterateStreamEvents(&height, &height, func(ev *exec.StreamEvent) error {
+		if ev.Event != nil && ev.Event.Slash != nil {
+			slash := ev.Event.Slash
+			evidence = append(evidence, abciTypes.Evidence{
+				Type:      slash.EvidenceType,
+				Validator: abciTypes.Validator{Address: slash.Address.Bytes()},
+				Height:    int64(slash.EvidenceHeight),
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return evidence, nil
+}
+
+// Passes steps through to tracer only once enabled
+type tracerGate struct {
+	tracer  evm.Tracer
+	enabled bool
+}
+
+func (tg *tracerGate) CaptureStep(step *evm.Step) {
+	if tg.enabled {
+		tg.tracer.CaptureStep(step)
+	}
+}
+
+// Load the state as of the end of the block at height along with the blockchain as it was when the following block
+// was being executed
+func historicalState(st *state.State, blockchain bcm.BlockchainInfo, height uint64) (*state.ReadState,
+	*historicalBlockchain, error) {
+
+	if height > blockchain.LastBlockHeight() {
+		return nil, nil, fmt.Errorf("height %d is beyond the last block height %d", height,
+			blockchain.LastBlockHeight())
+	}
+	backend, err := st.LoadHeight(height)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not load state at height %d: %v", height, err)
+	}
+	lastBlockTime := blockchain.GenesisDoc().GenesisTime
+	if height > 0 {
+		header, err := blockchain.GetBlockHeader(height)
+		if err == nil {
+			lastBlockTime = header.Time
+		} else {
+			// Without a block store (e.g. when running without consensus) we have no record of past block times so
+			// make do with the latest, which only shows through the block time seen by contracts
+			lastBlockTime = blockchain.LastBlockTime()
+		}
+	}
+	return backend, &historicalBlockchain{
+		BlockchainInfo:  blockchain,
+		lastBlockHeight: height,
+		lastBlockTime:   lastBlockTime,
+	}, nil
+}
+
+// The blockchain as it was when the block after lastBlockHeight was being executed
+type historicalBlockchain struct {
+	bcm.BlockchainInfo
+	lastBlockHeight uint64
+	lastBlockTime   time.Time
+}
+
+func (hb *historicalBlockchain) LastBlockHeight() uint64 {
+	return hb.lastBlockHeight
+}
+
+func (hb *historicalBlockchain) LastBlockTime() time.Time {
+	return hb.lastBlockTime
+}
+
+// Historical state that can be executed against but never committed to
+type readOnlyState struct {
+	*state.ReadState
+}
+
+func (readOnlyState) Update(updater func(ws state.Updatable) error) ([]byte, int64, error) {
+	return nil, 0, fmt.Errorf("cannot update historical state")
+}
@@ -0,0 +1,114 @@
+package execution
+
+import (
+	"testing"
+
+	. "github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/execution/evm"
+	. "github.com/hyperledger/burrow/execution/evm/asm"
+	"github.com/hyperledger/burrow/execution/evm/asm/bc"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/genesis"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/abci/types"
+)
+
+func TestTraceTx(t *testing.T) {
+	st, privAccounts := makeGenesisState(3, true, 1000, 1, true, 1000)
+	exe := makeExecutor(st)
+	acc0 := getAccount(st, privAccounts[0].GetAddress())
+
+	// Create a contract and call it in the same block so the call depends on the preceding transaction
+	contractCode := bc.MustSplice(PUSH1, 0x2A, PUSH1, 0x05, SSTORE, STOP)
+	create := &payload.CallTx{
+		Input: &payload.TxInput{
+			Address:  acc0.Address,
+			Amount:   1,
+			Sequence: acc0.Sequence + 1,
+		},
+		GasLimit: 100000,
+		Data:     wrapContractForCreate(contractCode),
+	}
+	txEnv := txs.Enclose(testChainID, create)
+	require.NoError(t, txEnv.Sign(privAccounts[0]))
+	txe, err := exe.Execute(txEnv)
+	require.NoError(t, err)
+	require.Nil(t, txe.Exception)
+
+	contractAddress := txe.Receipt.ContractAddress
+	call := &payload.CallTx{
+		Input: &payload.TxInput{
+			Address:  acc0.Address,
+			Amount:   1,
+			Sequence: acc0.Sequence + 2,
+		},
+		Address:  &contractAddress,
+		GasLimit: 100000,
+	}
+	txEnv = txs.Enclose(testChainID, call)
+	require.NoError(t, txEnv.Sign(privAccounts[0]))
+	txe, err = exe.Execute(txEnv)
+	require.NoError(t, err)
+	require.Nil(t, txe.Exception)
+	_, err = exe.Commit(nil)
+	require.NoError(t, err)
+
+	params, err := ParamsFromGenesis(testGenesisDoc)
+	require.NoError(t, err)
+	structLogger := evm.NewStructLogger()
+	traced, err := TraceTx(st, exe.Blockchain, params, txe.TxHash, structLogger, logger)
+	require.NoError(t, err)
+	assert.Nil(t, traced.Exception)
+	assert.Equal(t, txe.TxHash, traced.TxHash)
+
+	ops := make([]OpCode, len(structLogger.StructLogs))
+	for i, step := range structLogger.StructLogs {
+		ops[i] = step.Op
+		assert.Equal(t, uint64(1), step.Depth)
+		assert.Equal(t, contractAddress, step.Address)
+	}
+	assert.Equal(t, []OpCode{PUSH1, PUSH1, SSTORE, STOP}, ops)
+	assert.Equal(t, &evm.StorageDelta{Key: Int64ToWord256(0x05), Value: Int64ToWord256(0x2A)},
+		structLogger.StructLogs[2].StorageDelta)
+
+	_, err = TraceTx(st, exe.Blockchain, params, make([]byte, 32), structLogger, logger)
+	assert.Error(t, err)
+}
+
+func TestTraceTx_BeginBlock(t *testing.T) {
+	exe, st := makeSlashingExecutor(t, func(genDoc *genesis.GenesisDoc) {
+		genDoc.Params.UnbondingBlocks = 1
+	})
+	validator := users[1]
+	balance := getAccount(st, validator.GetAddress()).Balance
+	execute := func(tx payload.Payload) *exec.TxExecution {
+		txEnv := txs.Enclose(exe.params.ChainID, tx)
+		require.NoError(t, txEnv.Sign(validator))
+		txe, err := exe.Execute(txEnv)
+		require.NoError(t, err)
+		return txe
+	}
+	block := func(tx payload.Payload) *exec.TxExecution {
+		require.NoError(t, exe.BeginBlock(&types.RequestBeginBlock{Header: types.Header{Height: int64(exe.block.Height)}}))
+		txe := execute(tx)
+		_, err := exe.Commit(nil)
+		require.NoError(t, err)
+		return txe
+	}
+
+	unbondTx := payload.NewUnbondTx(validator.GetAddress(), 50)
+	unbondTx.Input.Sequence = 1
+	block(unbondTx)
+	// The send can only be made once the start of its block has released the unbonded stake
+	sendTx := payload.NewSendTx()
+	require.NoError(t, sendTx.AddInputWithSequence(validator.GetPublicKey(), balance+50, 2))
+	require.NoError(t, sendTx.AddOutput(users[5].GetAddress(), balance+50))
+	txe := block(sendTx)
+
+	traced, err := TraceTx(st, exe.Blockchain, exe.params, txe.TxHash, evm.NewStructLogger(), logger)
+	require.NoError(t, err)
+	assert.Nil(t, traced.Exception)
+}
@@ -0,0 +1,175 @@
+package execution
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/genesis"
+	"github.com/hyperledger/burrow/governance"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/abci/types"
+)
+
+func TestValidatorPolicy(t *testing.T) {
+	exe, st := makeSlashingExecutor(t, func(genDoc *genesis.GenesisDoc) {
+		genDoc.Params.MaxValidators = 4
+		genDoc.Params.MinBond = 50
+		genDoc.Params.MaxPowerFraction = "1/3"
+		genDoc.Params.MaxValidatorChanges = 2
+	})
+	height := exe.block.Height
+	sequences := make(map[crypto.Address]uint64)
+	execute := func(tx payload.Payload, signer acm.AddressableSigner) error {
+		for _, in := range tx.GetInputs() {
+			in.Sequence = sequences[in.Address] + 1
+		}
+		txEnv := txs.Enclose(exe.params.ChainID, tx)
+		require.NoError(t, txEnv.Sign(signer))
+		_, err := exe.Execute(txEnv)
+		if err == nil {
+			for _, in := range tx.GetInputs() {
+				sequences[in.Address]++
+			}
+		}
+		return err
+	}
+	bond := func(user acm.AddressableSigner, amount uint64) error {
+		return execute(payload.NewBondTx(user.GetAddress(), amount), user)
+	}
+	unbond := func(user acm.AddressableSigner, amount uint64) error {
+		return execute(payload.NewUnbondTx(user.GetAddress(), amount), user)
+	}
+	delegate := func(delegator, validator acm.AddressableSigner, amount uint64) error {
+		return execute(payload.NewDelegateTx(delegator.GetAddress(), validator.GetAddress(), amount), delegator)
+	}
+	undelegate := func(delegator, validator acm.AddressableSigner, amount uint64) error {
+		return execute(payload.NewUndelegateTx(delegator.GetAddress(), validator.GetAddress(), amount), delegator)
+	}
+	alterPower := func(user acm.AddressableSigner, power uint64) error {
+		return execute(governance.AlterPowerTx(users[0].GetAddress(), user, power), users[0])
+	}
+	assertCode := func(code errors.Code, err error) {
+		require.Error(t, err)
+		assert.Equal(t, code, errors.AsException(err).ErrorCode(), "unexpected error: %v", err)
+	}
+
+	// Four validators each with power 100
+	require.NoError(t, exe.BeginBlock(&types.RequestBeginBlock{Header: types.Header{Height: int64(height)}}))
+	assertCode(errors.ErrorCodeInsufficientBond, bond(users[4], 10))
+	assertCode(errors.ErrorCodeValidatorSetFull, bond(users[4], 60))
+	assertCode(errors.ErrorCodeInsufficientBond, alterPower(users[3], 20))
+	// 250 of 550 is more than a third
+	assertCode(errors.ErrorCodeValidatorPowerCapped, bond(users[1], 150))
+	// 150 of 450 is exactly a third
+	require.NoError(t, bond(users[1], 50))
+	require.NoError(t, bond(users[3], 50))
+	assertCode(errors.ErrorCodeTooManyValidatorChanges, bond(users[2], 50))
+	// A validator that has already changed this block can change again
+	require.NoError(t, alterPower(users[1], 160))
+	_, err := exe.Commit(nil)
+	require.NoError(t, err)
+	assertPower(t, st, users[1].GetAddress(), 160)
+	assertPower(t, st, users[2].GetAddress(), 100)
+	assertPower(t, st, users[3].GetAddress(), 150)
+	assertPower(t, st, users[4].GetAddress(), 0)
+
+	// A validator may always leave, making room for another
+	require.NoError(t, exe.BeginBlock(&types.RequestBeginBlock{Header: types.Header{Height: int64(height + 1)}}))
+	assertCode(errors.ErrorCodeValidatorSetFull, bond(users[4], 60))
+	require.NoError(t, alterPower(users[2], 0))
+	require.NoError(t, bond(users[4], 60))
+	_, err = exe.Commit(nil)
+	require.NoError(t, err)
+	assertPower(t, st, users[2].GetAddress(), 0)
+	assertPower(t, st, users[4].GetAddress(), 60)
+
+	// Stake a validator keeps after unbonding is subject to the policy too
+	require.NoError(t, exe.BeginBlock(&types.RequestBeginBlock{Header: types.Header{Height: int64(height + 2)}}))
+	assertCode(errors.ErrorCodeInsufficientBond, unbond(users[4], 20))
+	assertCode(errors.ErrorCodeInsufficientBond, unbond(users[3], 120))
+	require.NoError(t, unbond(users[3], 50))
+	require.NoError(t, unbond(users[0], 100))
+	assertCode(errors.ErrorCodeTooManyValidatorChanges, unbond(users[1], 10))
+	_, err = exe.Commit(nil)
+	require.NoError(t, err)
+	assertPower(t, st, users[0].GetAddress(), 0)
+	assertPower(t, st, users[1].GetAddress(), 160)
+	assertPower(t, st, users[3].GetAddress(), 100)
+
+	// As is stake a validator keeps after a delegator undelegates
+	require.NoError(t, exe.BeginBlock(&types.RequestBeginBlock{Header: types.Header{Height: int64(height + 3)}}))
+	require.NoError(t, delegate(users[2], users[4], 20))
+	require.NoError(t, unbond(users[4], 30))
+	_, err = exe.Commit(nil)
+	require.NoError(t, err)
+	assertPower(t, st, users[4].GetAddress(), 50)
+	require.NoError(t, exe.BeginBlock(&types.RequestBeginBlock{Header: types.Header{Height: int64(height + 4)}}))
+	assertCode(errors.ErrorCodeInsufficientBond, undelegate(users[2], users[4], 10))
+	_, err = exe.Commit(nil)
+	require.NoError(t, err)
+	assertPower(t, st, users[4].GetAddress(), 50)
+}
+
+func TestValidatorPolicy_Release(t *testing.T) {
+	exe, st := makeSlashingExecutor(t, func(genDoc *genesis.GenesisDoc) {
+		genDoc.Params.MaxValidators = 4
+		genDoc.Params.SlashFraction = "1/10"
+		genDoc.Params.JailBlocks = 2
+	})
+	offender := users[1].GetAddress()
+	sequence := uint64(0)
+	execute := func(tx payload.Payload) {
+		sequence++
+		tx.GetInputs()[0].Sequence = sequence
+		txEnv := txs.Enclose(exe.params.ChainID, tx)
+		require.NoError(t, txEnv.Sign(users[4]))
+		_, err := exe.Execute(txEnv)
+		require.NoError(t, err)
+	}
+	beginBlock := func(evidence ...types.Evidence) {
+		require.NoError(t, exe.BeginBlock(&types.RequestBeginBlock{
+			Header:              types.Header{Height: int64(exe.block.Height)},
+			ByzantineValidators: evidence,
+		}))
+	}
+	commit := func() {
+		_, err := exe.Commit(nil)
+		require.NoError(t, err)
+	}
+
+	// Jail the offender and fill its place
+	beginBlock(types.Evidence{
+		Type:      "duplicate/vote",
+		Validator: types.Validator{Address: offender.Bytes(), Power: 100},
+		Height:    int64(exe.block.Height - 1),
+	})
+	commit()
+	beginBlock()
+	execute(payload.NewBondTx(users[4].GetAddress(), 60))
+	commit()
+	assertPower(t, st, users[4].GetAddress(), 60)
+
+	// The validator set is full so the offender stays in jail
+	beginBlock()
+	commit()
+	assertPower(t, st, offender, 0)
+	jail, err := st.GetJail(offender)
+	require.NoError(t, err)
+	require.NotNil(t, jail)
+
+	// Until a place is free
+	beginBlock()
+	execute(payload.NewUnbondTx(users[4].GetAddress(), 60))
+	commit()
+	beginBlock()
+	commit()
+	assertPower(t, st, offender, 90)
+	jail, err = st.GetJail(offender)
+	require.NoError(t, err)
+	assert.Nil(t, jail)
+}
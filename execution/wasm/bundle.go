@@ -0,0 +1,117 @@
+package wasm
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/crypto/sha3"
+)
+
+// A WASM contract is deployed together with the code of any contracts it may create as a bundle. Each child is
+// stored in a custom section (which WASM runtimes ignore) named ChildSectionName appended to the parent module, so a
+// bundle is itself a valid WASM module. Bundles are flat: each child is stored without children of its own and a
+// child created from a bundle is given all the children of its parent, so contracts in the same bundle may create each
+// other (or themselves).
+const ChildSectionName = "burrow.child"
+
+var wasmHeader = []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+
+const customSectionID = 0
+
+// Bundle parent with children returning a single module that carries the children of parent, the children
+// passed, and any of their children, each included once
+func Bundle(parent []byte, children ...[]byte) ([]byte, error) {
+	module, bundled, err := unbundle(parent)
+	if err != nil {
+		return nil, fmt.Errorf("could not read parent module: %v", err)
+	}
+	for i, child := range children {
+		childModule, grandchildren, err := unbundle(child)
+		if err != nil {
+			return nil, fmt.Errorf("could not read child module %d: %v", i, err)
+		}
+		bundled = append(append(bundled, childModule), grandchildren...)
+	}
+	bundle := module
+	seen := make(map[acmstate.CodeHash]bool)
+	for _, child := range bundled {
+		hash := ChildHash(child)
+		if seen[hash] {
+			continue
+		}
+		seen[hash] = true
+		bundle = append(bundle, childSection(child)...)
+	}
+	return bundle, nil
+}
+
+// Returns the children bundled with code
+func Children(code []byte) ([][]byte, error) {
+	_, children, err := unbundle(code)
+	return children, err
+}
+
+// The hash by which a child is identified when created and from which its address is derived
+func ChildHash(child []byte) (hash acmstate.CodeHash) {
+	copy(hash[:], sha3.Sha3(child))
+	return
+}
+
+// Split a module into its own sections and the bundled children
+func unbundle(code []byte) (module []byte, children [][]byte, err error) {
+	if !bytes.HasPrefix(code, wasmHeader) {
+		return nil, nil, fmt.Errorf("not a WASM module: missing magic number and version")
+	}
+	module = append(module, wasmHeader...)
+	offset := len(wasmHeader)
+	for offset < len(code) {
+		start := offset
+		id := code[offset]
+		offset++
+		size, n := binary.Uvarint(code[offset:])
+		if n <= 0 || size > uint64(len(code)-offset-n) {
+			return nil, nil, fmt.Errorf("malformed section at offset %d", start)
+		}
+		offset += n
+		payload := code[offset : offset+int(size)]
+		offset += int(size)
+		if id == customSectionID {
+			name, content, ok := customSection(payload)
+			if !ok {
+				return nil, nil, fmt.Errorf("malformed custom section at offset %d", start)
+			}
+			if name == ChildSectionName {
+				if !bytes.HasPrefix(content, wasmHeader) {
+					return nil, nil, fmt.Errorf("bundled child at offset %d is not a WASM module", start)
+				}
+				children = append(children, content)
+				continue
+			}
+		}
+		module = append(module, code[start:offset]...)
+	}
+	return module, children, nil
+}
+
+func customSection(payload []byte) (name string, content []byte, ok bool) {
+	length, n := binary.Uvarint(payload)
+	if n <= 0 || length > uint64(len(payload)-n) {
+		return "", nil, false
+	}
+	return string(payload[n : n+int(length)]), payload[n+int(length):], true
+}
+
+func childSection(child []byte) []byte {
+	payload := uvarint(uint64(len(ChildSectionName)))
+	payload = append(payload, ChildSectionName...)
+	payload = append(payload, child...)
+	section := append([]byte{customSectionID}, uvarint(uint64(len(payload)))...)
+	return append(section, payload...)
+}
+
+func uvarint(x uint64) []byte {
+	buf := make([]byte, binary.MaxVarintLen64)
+	return buf[:binary.PutUvarint(buf, x)]
+}
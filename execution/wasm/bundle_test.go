@@ -0,0 +1,37 @@
+package wasm
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/execution/evm"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBundle(t *testing.T) {
+	parent := newTestModule(nil, nil, i32Const(0))
+	child := newTestModule(nil, []byte("child"), i32Const(0))
+	grandchild := newTestModule(nil, []byte("grandchild"), i32Const(0))
+
+	childBundle, err := Bundle(child, grandchild)
+	require.NoError(t, err)
+	bundle, err := Bundle(parent, childBundle, grandchild)
+	require.NoError(t, err)
+
+	// Children are flattened and included once
+	children, err := Children(bundle)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{child, grandchild}, children)
+
+	module, _, err := unbundle(bundle)
+	require.NoError(t, err)
+	assert.Equal(t, parent, module)
+
+	// A bundle is still a module we can run
+	_, cerr := runTestModule(t, newTestState(), evm.NewNoopEventSink(), bundle, 100000)
+	require.NoError(t, cerr)
+
+	_, err = Bundle(parent, []byte("not wasm"))
+	require.Error(t, err)
+}
@@ -4,18 +4,17 @@ import (
 	"encoding/binary"
 	"fmt"
 
+	"github.com/hyperledger/burrow/acm/acmstate"
 	burrow_binary "github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/execution/evm"
-	"github.com/perlin-network/life/exec"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/permission"
+	lifeExec "github.com/perlin-network/life/exec"
 )
 
-type execContext struct {
-	address crypto.Address
-	state   evm.Interface
-}
-
 // In EVM, the code for an account is created by the EVM code itself; the code in the EVM deploy transaction is run,
 // and the EVM code returns (via the RETURN) opcode) the code for the contract. In addition, when a new contract is
 // created using the "new C()" construct is soldity, the EVM itself passes the code for the new contract.
@@ -29,7 +28,8 @@ type execContext struct {
 // - This makes it hard to know ahead-of-time what the code for a contract will be
 
 // Our WASM implementation does not allow for this. The code passed to the deploy transaction, is the contract. Any child contracts must be passed
-// during the initial deployment (not implemented yet)
+// during the initial deployment bundled with the parent (see Bundle) and are created by their code hash at an address
+// derived from the creator, a salt, and the child code as for CREATE2.
 
 // ABIs
 // Our WASM ABI is entirely compatible with Solidity. This means that solidity EVM can call WASM contracts and vice
@@ -48,98 +48,427 @@ type execContext struct {
 // In our WASM model, the function and constructor WASM fuctions have one argument and return value. The argument is
 // where in WASM memory the ABI encoded arguments are and the return value is the offset where the return data can be
 // found. At this offset we first find a 32 bit little endian encoded length (since WASM is little endian and we are
-// using 32 bit memory model) followed by the bytes themselves.
+// using 32 bit memory model) followed by the bytes themselves. A return value of 0 means there is no return data.
 
 // Contract Storage
 // In the EVM model, contract storage is addressed via 256 bit key and the contents is a 256 bit value. For WASM,
 // we've changed the contents to a arbitary byte string. This makes it much easier to store/retrieve smaller values
 // (e.g. int64) and dynamic length fields (e.g. strings/arrays).
 
-// Access to contract storage is via WASM externals.
+// Metering
+// Every instruction executed is charged against the gas passed to the call, as is each host function according to
+// the work it does. Growing memory is charged extra and memory cannot grow beyond MaxMemoryPages. Running out of gas
+// aborts the call with ErrorCodeInsufficientGas and consumes all the gas it was given.
+
+// Host functions
+// Contracts reach the outside world through functions imported from the "env" module. Every pointer passed is
+// checked against the bounds of contract memory; an out of bounds access aborts the call with
+// ErrorCodeMemoryOutOfBounds. Addresses are 20 bytes and log topics are 32 bytes.
 // - set_storage32(uint32 key, uint8* data, uint32 len) // set contract storage
 // - get_storage32(uint32 key, uint8* data, uint32 len) // get contract storage (right pad with zeros)
+// - get_caller(uint8* address) // write the address of the caller
+// - get_address(uint8* address) // write the address of the contract being run
+// - get_call_value() uint64 // the amount of native token sent with the call
+// - get_block_height() uint64 // the height of the block the call is being executed in
+// - get_block_time() int64 // the time of the block the call is being executed in in seconds since the Unix epoch
+// - log(uint8* topics, uint32 topic_count, uint8* data, uint32 len) // emit a LogEvent with up to 4 topics
+// - call(uint8* address, uint64 value, uint8* input, uint32 len, uint64 gas) uint32 // call an EVM, WASM or native
+//   contract, the 63/64 rule applies to gas and a gas of 0 passes as much as is allowed. Native contracts cannot be
+//   sent value so a call to one with a non-zero value fails. Returns 1 on success and 0 on failure
+// - return_data_size() uint32 // the size of the data returned by the last call
+// - return_data_copy(uint8* data) // copy the data returned by the last call
+// - revert(uint8* data, uint32 len) // abort the call, discarding its state changes, and return data
+// - create(uint8* code_hash, uint8* salt, uint8* input, uint32 len, uint64 value, uint8* address) uint32 // create
+//   the bundled child with the 32 byte code hash at the address derived from the 32 byte salt, running its
+//   constructor with input. Returns 1 on success having written the new address and 0 on failure
+
+const (
+	// The maximum number of 64 KiB pages contract memory may grow to
+	MaxMemoryPages = 16
+	// The number of pages contracts that do not declare their own memory start with
+	defaultMemoryPages = 2
+	// The maximum number of topics a single log may have
+	maxLogTopics = 4
+)
+
+// Gas costs
+const (
+	instructionGas  = 1
+	growMemoryGas   = 1000
+	hostCallGas     = 10
+	storageReadGas  = 200
+	storageWriteGas = 5000
+	logGas          = 375
+	logTopicGas     = 375
+	logDataGas      = 8
+	callGas         = 700
+	createGas       = 32000
+	createDataGas   = 200
+)
+
+type VM struct {
+	params     evm.Params
+	origin     crypto.Address
+	nonce      []byte
+	stackDepth uint64
+	logger     *logging.Logger
+	evmOptions []func(*evm.VM)
+}
+
+// Create a new WASM VM. The params, origin and nonce have the same meaning as for evm.NewVM and are passed on along
+// with evmOptions to any EVM contracts called from WASM.
+func NewVM(params evm.Params, origin crypto.Address, nonce []byte, logger *logging.Logger,
+	evmOptions ...func(*evm.VM)) *VM {
+
+	return &VM{
+		params:     params,
+		origin:     origin,
+		nonce:      nonce,
+		logger:     logger.WithScope("NewWASMVM"),
+		evmOptions: evmOptions,
+	}
+}
+
+// Call the constructor (when createContract is set) or function entry point of the WASM contract code at callee
+// transferring value from caller and charging execution against gas
+func (vm *VM) Call(callState evm.Interface, eventSink evm.EventSink, caller, callee crypto.Address, code,
+	input []byte, value uint64, gas *uint64, createContract bool) (output []byte, cerr errors.CodedError) {
+
+	defer func() {
+		if cerr == nil {
+			cerr = callState.Error()
+		}
+		vm.fireCallEvent(eventSink, cerr, &output, caller, callee, input, value, gas)
+	}()
+
+	if vm.params.CallStackMaxDepth > 0 && vm.stackDepth == vm.params.CallStackMaxDepth {
+		return nil, errors.ErrorCodeCallStackOverflow
+	}
+
+	cerr = transfer(callState, caller, callee, value)
+	if cerr != nil {
+		return nil, cerr
+	}
+
+	vm.stackDepth += 1
+	output, cerr = vm.execute(callState, eventSink, caller, callee, code, input, value, gas, createContract)
+	vm.stackDepth -= 1
+	return
+}
+
+func (vm *VM) execute(callState evm.Interface, eventSink evm.EventSink, caller, callee crypto.Address, code,
+	input []byte, value uint64, gas *uint64, createContract bool) (output []byte, cerr errors.CodedError) {
 
-// RunWASM creates a WASM VM, and executes the given WASM contract code
-func RunWASM(state evm.Interface, address crypto.Address, createContract bool, wasm, input []byte) (output []byte, cerr errors.CodedError) {
 	defer func() {
 		if r := recover(); r != nil {
 			cerr = errors.ErrorCodeExecutionAborted
 		}
 	}()
-	// WASM
-	config := exec.VMConfig{
-		DisableFloatingPoint: true,
-		MaxMemoryPages:       2,
-		DefaultMemoryPages:   2,
+
+	// A gas limit of zero means unlimited to life
+	if *gas == 0 {
+		return nil, errors.ErrorCodeInsufficientGas
 	}
 
-	execContext := execContext{address, state}
+	config := lifeExec.VMConfig{
+		DisableFloatingPoint: true,
+		MaxMemoryPages:       MaxMemoryPages,
+		DefaultMemoryPages:   defaultMemoryPages,
+		GasLimit:             *gas,
+	}
 
-	vm, err := exec.NewVirtualMachine(wasm, config, &execContext, nil)
+	ctx := &execContext{
+		vm:        vm,
+		state:     callState,
+		eventSink: eventSink,
+		caller:    caller,
+		address:   callee,
+		code:      code,
+		value:     value,
+	}
 
+	wvm, err := lifeExec.NewVirtualMachine(code, config, ctx, gasPolicy{})
 	if err != nil {
-		return []byte{}, errors.ErrorCodeInvalidContract
+		return nil, errors.ErrorCodeInvalidContract
 	}
 
-	// FIXME: Check length
 	if len(input) > 0 {
-		binary.LittleEndian.PutUint32(vm.Memory[:], uint32(len(input)))
-		copy(vm.Memory[4:], input)
+		if uint64(len(input))+4 > uint64(len(wvm.Memory)) {
+			return nil, errors.ErrorCodef(errors.ErrorCodeInputOutOfBounds,
+				"input of %d bytes does not fit in contract memory of %d bytes", len(input), len(wvm.Memory))
+		}
+		binary.LittleEndian.PutUint32(wvm.Memory, uint32(len(input)))
+		copy(wvm.Memory[4:], input)
 	}
 
 	wasmFunc := "function"
 	if createContract {
 		wasmFunc = "constructor"
 	}
-	entryID, ok := vm.GetFunctionExport(wasmFunc)
+	entryID, ok := wvm.GetFunctionExport(wasmFunc)
 	if !ok {
-		return []byte{}, errors.ErrorCodeUnresolvedSymbols
+		return nil, errors.ErrorCodeUnresolvedSymbols
 	}
 
 	// The 0 argument is the offset where our calldata is stored (if any)
-	offset, err := vm.Run(entryID, 0)
+	offset, err := wvm.Run(entryID, 0)
+	*gas -= wvm.Gas
 	if err != nil {
-		return []byte{}, errors.ErrorCodeExecutionAborted
+		cerr = exitError(err)
+		switch cerr.ErrorCode() {
+		case errors.ErrorCodeInsufficientGas:
+			*gas = 0
+		case errors.ErrorCodeExecutionReverted:
+			output = ctx.revertData
+		}
+		return output, cerr
 	}
 
-	if offset > 0 {
-		// FIXME: Check length
-		length := binary.LittleEndian.Uint32(vm.Memory[offset : offset+4])
-		output = vm.Memory[offset+4 : offset+4+int64(length)]
+	if offset != 0 {
+		lengthBytes, cerr := memorySlice(wvm, uint64(uint32(offset)), 4)
+		if cerr != nil {
+			return nil, cerr
+		}
+		data, cerr := memorySlice(wvm, uint64(uint32(offset))+4, uint64(binary.LittleEndian.Uint32(lengthBytes)))
+		if cerr != nil {
+			return nil, cerr
+		}
+		output = make([]byte, len(data))
+		copy(output, data)
 	}
 
-	return
+	return output, nil
 }
 
-func (e *execContext) ResolveFunc(module, field string) exec.FunctionImport {
+func (vm *VM) fireCallEvent(eventSink evm.EventSink, cerr errors.CodedError, output *[]byte,
+	caller, callee crypto.Address, input []byte, value uint64, gas *uint64) {
+
+	eventErr := eventSink.Call(&exec.CallEvent{
+		CallType: exec.CallTypeCall,
+		CallData: &exec.CallData{
+			Caller: caller,
+			Callee: callee,
+			Data:   input,
+			Value:  value,
+			Gas:    *gas,
+		},
+		Origin:     vm.origin,
+		StackDepth: vm.stackDepth,
+		Return:     *output,
+	}, errors.AsException(cerr))
+	if eventErr != nil {
+		vm.logger.InfoMsg("Could not fire WASM call event", "error", eventErr)
+	}
+}
+
+// Convert the error that stopped the life VM into our own
+func exitError(err error) errors.CodedError {
+	switch e := err.(type) {
+	case errors.CodedError:
+		return e
+	case interface{ ErrorCode() errors.Code }:
+		return errors.NewException(e.ErrorCode(), err.Error())
+	default:
+		// Raised by life when metered instructions exceed the gas limit
+		if err.Error() == "gas limit exceeded" {
+			return errors.ErrorCodeInsufficientGas
+		}
+		return errors.ErrorCodef(errors.ErrorCodeExecutionAborted, "%v", err)
+	}
+}
+
+func transfer(st evm.Interface, from, to crypto.Address, amount uint64) errors.CodedError {
+	if amount == 0 {
+		return nil
+	}
+	if st.GetBalance(from) < amount {
+		return errors.ErrorCodeInsufficientBalance
+	}
+	st.SubtractFromBalance(from, amount)
+	st.AddToBalance(to, amount)
+	return st.Error()
+}
+
+// Returns the part of contract memory [offset, offset+length) or an error if it does not lie within memory
+func memorySlice(wvm *lifeExec.VirtualMachine, offset, length uint64) ([]byte, errors.CodedError) {
+	end := offset + length
+	if end < offset || end > uint64(len(wvm.Memory)) {
+		return nil, errors.ErrorCodef(errors.ErrorCodeMemoryOutOfBounds,
+			"access of %d bytes at offset %d exceeds contract memory of %d bytes", length, offset, len(wvm.Memory))
+	}
+	return wvm.Memory[offset:end], nil
+}
+
+// Charges a flat cost for each instruction with growing memory charged extra
+type gasPolicy struct{}
+
+func (gasPolicy) GetCost(op string) int64 {
+	if op == "grow_memory" {
+		return growMemoryGas
+	}
+	return instructionGas
+}
+
+// The environment a single contract call runs in, serving as the import resolver for its host functions. Host
+// functions report errors by panicking with a CodedError which life returns from Run.
+type execContext struct {
+	vm         *VM
+	state      evm.Interface
+	eventSink  evm.EventSink
+	caller     crypto.Address
+	address    crypto.Address
+	code       []byte
+	value      uint64
+	returnData []byte
+	revertData []byte
+}
+
+func (e *execContext) ResolveFunc(module, field string) lifeExec.FunctionImport {
 	if module != "env" {
 		panic(fmt.Sprintf("unknown module %s", module))
 	}
 
 	switch field {
 	case "set_storage32":
-		return func(vm *exec.VirtualMachine) int64 {
-			key := int(uint32(vm.GetCurrentFrame().Locals[0]))
-			ptr := int(uint32(vm.GetCurrentFrame().Locals[1]))
-			len := int(uint32(vm.GetCurrentFrame().Locals[2]))
-			// FIXME: Check length
-			e.state.SetStorage(e.address, burrow_binary.Int64ToWord256(int64(key)), vm.Memory[ptr:ptr+len])
+		return func(vm *lifeExec.VirtualMachine) int64 {
+			e.useGas(vm, storageWriteGas)
+			key := uint32(local(vm, 0))
+			data := e.memory(vm, local(vm, 1), local(vm, 2))
+			value := make([]byte, len(data))
+			copy(value, data)
+			e.state.SetStorage(e.address, burrow_binary.Int64ToWord256(int64(key)), value)
 			return 0
 		}
 
 	case "get_storage32":
-		return func(vm *exec.VirtualMachine) int64 {
-			key := int(uint32(vm.GetCurrentFrame().Locals[0]))
-			ptr := int(uint32(vm.GetCurrentFrame().Locals[1]))
-			length := int(uint32(vm.GetCurrentFrame().Locals[2]))
+		return func(vm *lifeExec.VirtualMachine) int64 {
+			e.useGas(vm, storageReadGas)
+			key := uint32(local(vm, 0))
+			data := e.memory(vm, local(vm, 1), local(vm, 2))
 			val := e.state.GetStorage(e.address, burrow_binary.Int64ToWord256(int64(key)))
-			if len(val) < length {
-				val = append(val, make([]byte, length-len(val))...)
+			n := copy(data, val)
+			for i := n; i < len(data); i++ {
+				data[i] = 0
 			}
-			// FIXME: Check length
-			copy(vm.Memory[ptr:ptr+length], val)
 			return 0
 		}
+
+	case "get_caller":
+		return func(vm *lifeExec.VirtualMachine) int64 {
+			e.useGas(vm, hostCallGas)
+			copy(e.memory(vm, local(vm, 0), crypto.AddressLength), e.caller[:])
+			return 0
+		}
+
+	case "get_address":
+		return func(vm *lifeExec.VirtualMachine) int64 {
+			e.useGas(vm, hostCallGas)
+			copy(e.memory(vm, local(vm, 0), crypto.AddressLength), e.address[:])
+			return 0
+		}
+
+	case "get_call_value":
+		return func(vm *lifeExec.VirtualMachine) int64 {
+			e.useGas(vm, hostCallGas)
+			return int64(e.value)
+		}
+
+	case "get_block_height":
+		return func(vm *lifeExec.VirtualMachine) int64 {
+			e.useGas(vm, hostCallGas)
+			return int64(e.vm.params.BlockHeight)
+		}
+
+	case "get_block_time":
+		return func(vm *lifeExec.VirtualMachine) int64 {
+			e.useGas(vm, hostCallGas)
+			return e.vm.params.BlockTime
+		}
+
+	case "log":
+		return func(vm *lifeExec.VirtualMachine) int64 {
+			topicCount := uint32(local(vm, 1))
+			if topicCount > maxLogTopics {
+				panic(errors.ErrorCodef(errors.ErrorCodeExecutionAborted,
+					"log has %d topics but at most %d are allowed", topicCount, maxLogTopics))
+			}
+			// Charge for the length asked for before touching memory
+			e.useGas(vm, logGas+uint64(topicCount)*logTopicGas+uint64(uint32(local(vm, 3)))*logDataGas)
+			topicBytes := e.memory(vm, local(vm, 0), int64(topicCount*burrow_binary.Word256Length))
+			data := e.memory(vm, local(vm, 2), local(vm, 3))
+			topics := make([]burrow_binary.Word256, topicCount)
+			for i := range topics {
+				copy(topics[i][:], topicBytes[i*burrow_binary.Word256Length:])
+			}
+			log := &exec.LogEvent{
+				Address: e.address,
+				Topics:  topics,
+				Data:    make([]byte, len(data)),
+			}
+			copy(log.Data, data)
+			err := e.eventSink.Log(log)
+			if err != nil {
+				panic(errors.AsException(err))
+			}
+			return 0
+		}
+
+	case "call":
+		return func(vm *lifeExec.VirtualMachine) int64 {
+			e.useGas(vm, callGas)
+			var address crypto.Address
+			copy(address[:], e.memory(vm, local(vm, 0), crypto.AddressLength))
+			value := uint64(local(vm, 1))
+			args := e.memory(vm, local(vm, 2), local(vm, 3))
+			input := make([]byte, len(args))
+			copy(input, args)
+			if e.call(vm, address, value, input, uint64(local(vm, 4))) != nil {
+				return 0
+			}
+			return 1
+		}
+
+	case "return_data_size":
+		return func(vm *lifeExec.VirtualMachine) int64 {
+			e.useGas(vm, hostCallGas)
+			return int64(len(e.returnData))
+		}
+
+	case "return_data_copy":
+		return func(vm *lifeExec.VirtualMachine) int64 {
+			e.useGas(vm, hostCallGas)
+			copy(e.memory(vm, local(vm, 0), int64(len(e.returnData))), e.returnData)
+			return 0
+		}
+
+	case "revert":
+		return func(vm *lifeExec.VirtualMachine) int64 {
+			e.useGas(vm, hostCallGas)
+			data := e.memory(vm, local(vm, 0), local(vm, 1))
+			e.revertData = make([]byte, len(data))
+			copy(e.revertData, data)
+			panic(errors.ErrorCodeExecutionReverted)
+		}
+
+	case "create":
+		return func(vm *lifeExec.VirtualMachine) int64 {
+			var codeHash acmstate.CodeHash
+			copy(codeHash[:], e.memory(vm, local(vm, 0), burrow_binary.Word256Length))
+			var salt burrow_binary.Word256
+			copy(salt[:], e.memory(vm, local(vm, 1), burrow_binary.Word256Length))
+			args := e.memory(vm, local(vm, 2), local(vm, 3))
+			input := make([]byte, len(args))
+			copy(input, args)
+			value := uint64(local(vm, 4))
+			addressPtr := local(vm, 5)
+			address, err := e.create(vm, codeHash, salt, input, value)
+			if err != nil {
+				return 0
+			}
+			copy(e.memory(vm, addressPtr, crypto.AddressLength), address[:])
+			return 1
+		}
+
 	default:
 		panic(fmt.Sprintf("unknown function %s", field))
 	}
@@ -148,3 +477,141 @@ func (e *execContext) ResolveFunc(module, field string) exec.FunctionImport {
 func (e *execContext) ResolveGlobal(module, field string) int64 {
 	panic(fmt.Sprintf("global %s module %s not found", field, module))
 }
+
+// Make a call from the contract to the account at address in a child cache of our state that is only synced if the
+// call succeeds. The gas used by the call is charged to the contract.
+func (e *execContext) call(vm *lifeExec.VirtualMachine, address crypto.Address, value uint64, input []byte,
+	gasLimit uint64) errors.CodedError {
+
+	gasLimit = limitGas(vm, gasLimit)
+	gas := gasLimit
+
+	childState := e.state.NewCache()
+	var callErr errors.CodedError
+	switch {
+	case evm.IsRegisteredNativeContract(address) && value > 0:
+		e.returnData, callErr = nil, errors.ErrorCodef(errors.ErrorCodeNativeFunction,
+			"cannot send value %d to native contract %v", value, address)
+	case evm.IsRegisteredNativeContract(address):
+		e.returnData, callErr = evm.ExecuteNativeContract(address, childState, e.address, input, &gas,
+			e.vm.params.GasSchedule, e.vm.logger)
+	case !e.state.Exists(address):
+		e.returnData, callErr = nil, errors.ErrorCodef(errors.ErrorCodeUnknownAddress,
+			"call to address %v that does not exist", address)
+	default:
+		if wcode := e.state.GetWASMCode(address); len(wcode) > 0 {
+			e.returnData, callErr = e.vm.Call(childState, e.eventSink, e.address, address, wcode, input, value,
+				&gas, false)
+		} else {
+			options := append(e.vm.evmOptions[:len(e.vm.evmOptions):len(e.vm.evmOptions)],
+				evm.StackDepthOption(e.vm.stackDepth))
+			evmVM := evm.NewVM(e.vm.params, e.vm.origin, e.vm.nonce, e.vm.logger, options...)
+			e.returnData, callErr = evmVM.Call(childState, e.eventSink, e.address, address,
+				e.state.GetEVMCode(address), input, value, &gas)
+		}
+	}
+
+	e.useGas(vm, gasLimit-gas)
+	if callErr != nil {
+		if callErr.ErrorCode() != errors.ErrorCodeExecutionReverted {
+			e.returnData = nil
+		}
+		return callErr
+	}
+	// Sync error is a hard stop
+	syncErr := childState.Sync()
+	if syncErr != nil {
+		panic(syncErr)
+	}
+	return nil
+}
+
+// Create the child bundled with our code that has codeHash in a child cache of our state that is only synced if its
+// constructor succeeds. The gas used by the constructor is charged to the contract.
+func (e *execContext) create(vm *lifeExec.VirtualMachine, codeHash acmstate.CodeHash, salt burrow_binary.Word256,
+	input []byte, value uint64) (crypto.Address, errors.CodedError) {
+
+	e.useGas(vm, createGas)
+	if !evm.HasPermission(e.state, e.address, permission.CreateContract) {
+		panic(errors.PermissionDenied{Address: e.address, Perm: permission.CreateContract})
+	}
+	children, err := Children(e.code)
+	if err != nil {
+		panic(errors.ErrorCodef(errors.ErrorCodeInvalidContractCode, "could not read bundled children: %v", err))
+	}
+	var child []byte
+	for _, c := range children {
+		if ChildHash(c) == codeHash {
+			child = c
+			break
+		}
+	}
+	if child == nil {
+		panic(errors.ErrorCodef(errors.ErrorCodeInvalidContractCode,
+			"no child with code hash %X is bundled with contract %v", codeHash, e.address))
+	}
+	e.useGas(vm, createDataGas*uint64(len(child)))
+
+	e.returnData = nil
+	address := crypto.NewContractAddress2(e.address, salt, child)
+	if e.state.Exists(address) {
+		return address, errors.ErrorCodef(errors.ErrorCodeDuplicateAddress,
+			"cannot create child at %v since an account already exists there", address)
+	}
+	// The child may itself create any of the contracts bundled with us
+	code, err := Bundle(child, children...)
+	if err != nil {
+		panic(errors.ErrorCodef(errors.ErrorCodeInvalidContractCode, "could not bundle child: %v", err))
+	}
+
+	childState := e.state.NewCache()
+	childState.CreateAccount(address)
+	childState.InitWASMCode(address, code)
+	gasLimit := limitGas(vm, 0)
+	gas := gasLimit
+	var callErr errors.CodedError
+	e.returnData, callErr = e.vm.Call(childState, e.eventSink, e.address, address, code, input, value, &gas, true)
+	e.useGas(vm, gasLimit-gas)
+	if callErr != nil {
+		if callErr.ErrorCode() != errors.ErrorCodeExecutionReverted {
+			e.returnData = nil
+		}
+		return address, callErr
+	}
+	syncErr := childState.Sync()
+	if syncErr != nil {
+		panic(syncErr)
+	}
+	return address, nil
+}
+
+// Apply the 63/64 rule of EIP150 to the gas passed on to a call, a gasLimit of 0 passing as much as is allowed
+func limitGas(vm *lifeExec.VirtualMachine, gasLimit uint64) uint64 {
+	available := vm.Config.GasLimit - vm.Gas
+	available -= available / 64
+	if gasLimit == 0 || gasLimit > available {
+		return available
+	}
+	return gasLimit
+}
+
+// Charge gas on top of that charged by life for executing instructions
+func (e *execContext) useGas(vm *lifeExec.VirtualMachine, amount uint64) {
+	if vm.Config.GasLimit-vm.Gas < amount {
+		panic(errors.ErrorCodeInsufficientGas)
+	}
+	vm.Gas += amount
+}
+
+// Bounds-checked view of contract memory where offset and length are 32-bit values passed by the contract
+func (e *execContext) memory(vm *lifeExec.VirtualMachine, offset, length int64) []byte {
+	data, err := memorySlice(vm, uint64(uint32(offset)), uint64(uint32(length)))
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
+
+func local(vm *lifeExec.VirtualMachine, index int) int64 {
+	return vm.GetCurrentFrame().Locals[index]
+}
@@ -5,21 +5,29 @@ import (
 	"testing"
 
 	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/execution/evm"
+	"github.com/hyperledger/burrow/execution/exec"
 
 	"github.com/hyperledger/burrow/acm/acmstate"
 	"github.com/hyperledger/burrow/execution/evm/abi"
 
 	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/permission"
 	"github.com/stretchr/testify/require"
 )
 
 func TestStaticCallWithValue(t *testing.T) {
 	cache := evm.NewState(acmstate.NewMemoryState(), blockHashGetter)
+	vm := NewVM(evm.Params{}, crypto.ZeroAddress, nil, logging.NewNoopLogger())
+	eventSink := evm.NewNoopEventSink()
+	gas := uint64(1000000)
 
 	cache.CreateAccount(crypto.ZeroAddress)
 	// run constructor
-	_, cerr := RunWASM(cache, crypto.ZeroAddress, true, Bytecode_storage_test, []byte{})
+	_, cerr := vm.Call(cache, eventSink, crypto.ZeroAddress, crypto.ZeroAddress, Bytecode_storage_test, []byte{}, 0, &gas,
+		true)
 	require.NoError(t, cerr)
 
 	// run getFooPlus2
@@ -27,7 +35,8 @@ func TestStaticCallWithValue(t *testing.T) {
 	require.NoError(t, err)
 	calldata, _, err := spec.Pack("getFooPlus2")
 
-	returndata, cerr := RunWASM(cache, crypto.ZeroAddress, false, Bytecode_storage_test, calldata)
+	returndata, cerr := vm.Call(cache, eventSink, crypto.ZeroAddress, crypto.ZeroAddress, Bytecode_storage_test, calldata, 0,
+		&gas, false)
 	require.NoError(t, cerr)
 
 	data := abi.GetPackingTypes(spec.Functions["getFooPlus2"].Outputs)
@@ -42,7 +51,8 @@ func TestStaticCallWithValue(t *testing.T) {
 	// call incFoo
 	calldata, _, err = spec.Pack("incFoo")
 
-	returndata, cerr = RunWASM(cache, crypto.ZeroAddress, false, Bytecode_storage_test, calldata)
+	returndata, cerr = vm.Call(cache, eventSink, crypto.ZeroAddress, crypto.ZeroAddress, Bytecode_storage_test, calldata, 0,
+		&gas, false)
 	require.NoError(t, cerr)
 
 	require.Equal(t, returndata, []byte{})
@@ -51,7 +61,8 @@ func TestStaticCallWithValue(t *testing.T) {
 	calldata, _, err = spec.Pack("getFooPlus2")
 	require.NoError(t, err)
 
-	returndata, cerr = RunWASM(cache, crypto.ZeroAddress, false, Bytecode_storage_test, calldata)
+	returndata, cerr = vm.Call(cache, eventSink, crypto.ZeroAddress, crypto.ZeroAddress, Bytecode_storage_test, calldata, 0,
+		&gas, false)
 	require.NoError(t, cerr)
 
 	spec.Unpack(returndata, "getFooPlus2", data...)
@@ -61,6 +72,286 @@ func TestStaticCallWithValue(t *testing.T) {
 	require.Equal(t, expected, returnValue)
 }
 
+func TestRevert(t *testing.T) {
+	code := newTestModule([]hostImport{{"revert", []byte{i32, i32}, nil}}, []byte("bye"),
+		i32Const(0), i32Const(3), call(0), i32Const(0))
+	output, cerr := runTestModule(t, newTestState(), evm.NewNoopEventSink(), code, 100000)
+	require.Equal(t, errors.ErrorCodeExecutionReverted, cerr.ErrorCode())
+	require.Equal(t, []byte("bye"), output)
+}
+
+func TestOutOfGas(t *testing.T) {
+	// An infinite loop
+	code := newTestModule(nil, nil, []byte{0x03, 0x40, 0x0c, 0x00, 0x0b}, i32Const(0))
+	cache := newTestState()
+	gas := uint64(10000)
+	_, cerr := NewVM(evm.Params{}, crypto.ZeroAddress, nil, logging.NewNoopLogger()).Call(cache,
+		evm.NewNoopEventSink(), crypto.ZeroAddress, testAddress, code, nil, 0, &gas, false)
+	require.Equal(t, errors.ErrorCodeInsufficientGas, cerr.ErrorCode())
+	require.Equal(t, uint64(0), gas)
+}
+
+func TestMemoryOutOfBounds(t *testing.T) {
+	code := newTestModule([]hostImport{{"set_storage32", []byte{i32, i32, i32}, nil}}, nil,
+		i32Const(0), i32Const(0xfff0), i32Const(0x100), call(0), i32Const(0))
+	_, cerr := runTestModule(t, newTestState(), evm.NewNoopEventSink(), code, 100000)
+	require.Equal(t, errors.ErrorCodeMemoryOutOfBounds, cerr.ErrorCode())
+
+	// Return data must lie inside memory too
+	code = newTestModule(nil, nil, i32Const(0xfffe))
+	_, cerr = runTestModule(t, newTestState(), evm.NewNoopEventSink(), code, 100000)
+	require.Equal(t, errors.ErrorCodeMemoryOutOfBounds, cerr.ErrorCode())
+}
+
+func TestLogCallerAndBlockHeight(t *testing.T) {
+	code := newTestModule([]hostImport{
+		{"get_caller", []byte{i32}, nil},
+		{"log", []byte{i32, i32, i32, i32}, nil},
+		{"get_block_height", nil, []byte{i64}},
+	}, nil,
+		// Log our caller
+		i32Const(32), call(0),
+		i32Const(0), i32Const(0), i32Const(32), i32Const(crypto.AddressLength), call(1),
+		// Return the block height
+		i32Const(104), call(2), []byte{0x37, 0x03, 0x00},
+		i32Const(100), i32Const(8), []byte{0x36, 0x02, 0x00},
+		i32Const(100))
+
+	eventSink := new(logSink)
+	output, cerr := runTestModule(t, newTestState(), eventSink, code, 100000)
+	require.NoError(t, cerr)
+	require.Equal(t, []byte{34, 0, 0, 0, 0, 0, 0, 0}, output)
+	require.Len(t, eventSink.logs, 1)
+	require.Equal(t, testAddress, eventSink.logs[0].Address)
+	require.Equal(t, testCaller.Bytes(), eventSink.logs[0].Data.Bytes())
+}
+
+func TestCallEVM(t *testing.T) {
+	cache := newTestState()
+	// Returns the word 42
+	evmAddress := crypto.Address{0xEE}
+	cache.CreateAccount(evmAddress)
+	cache.InitCode(evmAddress, []byte{0x60, 0x2a, 0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3})
+
+	code := newTestModule([]hostImport{
+		{"call", []byte{i32, i64, i32, i32, i64}, []byte{i32}},
+		{"return_data_size", nil, []byte{i32}},
+		{"return_data_copy", []byte{i32}, nil},
+	}, evmAddress[:],
+		i32Const(0), i64Const(0), i32Const(0), i32Const(0), i64Const(0), call(0), []byte{0x1a},
+		i32Const(100), call(1), []byte{0x36, 0x02, 0x00},
+		i32Const(104), call(2),
+		i32Const(100))
+
+	output, cerr := runTestModule(t, cache, evm.NewNoopEventSink(), code, 100000)
+	require.NoError(t, cerr)
+	require.Equal(t, binary.Int64ToWord256(42).Bytes(), output)
+}
+
+func TestCallNativeWithValue(t *testing.T) {
+	// Calls the identity native contract with the value given and returns whether the call succeeded
+	callIdentity := func(value int64) []byte {
+		identity := crypto.Address{4}
+		return newTestModule([]hostImport{
+			{"call", []byte{i32, i64, i32, i32, i64}, []byte{i32}},
+		}, identity[:],
+			i32Const(100), i32Const(4), []byte{0x36, 0x02, 0x00},
+			i32Const(104), i32Const(0), i64Const(value), i32Const(0), i32Const(0), i64Const(0), call(0),
+			[]byte{0x36, 0x02, 0x00},
+			i32Const(100))
+	}
+
+	output, cerr := runTestModule(t, newTestState(), evm.NewNoopEventSink(), callIdentity(0), 100000)
+	require.NoError(t, cerr)
+	require.Equal(t, []byte{1, 0, 0, 0}, output)
+
+	cache := newTestState()
+	cache.AddToBalance(testAddress, 10)
+	output, cerr = runTestModule(t, cache, evm.NewNoopEventSink(), callIdentity(5), 100000)
+	require.NoError(t, cerr)
+	require.Equal(t, []byte{0, 0, 0, 0}, output)
+	require.Equal(t, uint64(10), cache.GetBalance(testAddress))
+}
+
+func TestLogChargesGasBeforeMemory(t *testing.T) {
+	// Log far more data than there is memory for with too little gas to pay for it
+	code := newTestModule([]hostImport{{"log", []byte{i32, i32, i32, i32}, nil}}, nil,
+		i32Const(0), i32Const(0), i32Const(0), i32Const(0x7fffffff), call(0), i32Const(0))
+	_, cerr := runTestModule(t, newTestState(), evm.NewNoopEventSink(), code, 100000)
+	require.Equal(t, errors.ErrorCodeInsufficientGas, cerr.ErrorCode())
+}
+
+func TestCreate(t *testing.T) {
+	// Stores "kid" in its constructor
+	child := newTestModule([]hostImport{{"set_storage32", []byte{i32, i32, i32}, nil}}, []byte("kid"),
+		i32Const(1), i32Const(0), i32Const(3), call(0), i32Const(0))
+	childHash := ChildHash(child)
+	parent := newTestModule([]hostImport{
+		{"create", []byte{i32, i32, i32, i32, i64, i32}, []byte{i32}},
+	}, childHash[:],
+		i32Const(0), i32Const(32), i32Const(0), i32Const(0), i64Const(0), i32Const(104), call(0), []byte{0x1a},
+		i32Const(100), i32Const(crypto.AddressLength), []byte{0x36, 0x02, 0x00},
+		i32Const(100))
+	code, err := Bundle(parent, child)
+	require.NoError(t, err)
+
+	cache := newTestState()
+	_, cerr := runTestModule(t, cache, evm.NewNoopEventSink(), code, 1000000)
+	require.Equal(t, errors.ErrorCodePermissionDenied, cerr.ErrorCode())
+
+	cache.SetPermission(testAddress, permission.CreateContract, true)
+	output, cerr := runTestModule(t, cache, evm.NewNoopEventSink(), code, 1000000)
+	require.NoError(t, cerr)
+	address := crypto.NewContractAddress2(testAddress, binary.Zero256, child)
+	require.Equal(t, address.Bytes(), output)
+	require.Equal(t, []byte("kid"), cache.GetStorage(address, binary.Int64ToWord256(1)))
+	children, err := Children(cache.GetWASMCode(address))
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{child}, children)
+
+	// The address is taken now
+	output, cerr = runTestModule(t, cache, evm.NewNoopEventSink(), code, 1000000)
+	require.NoError(t, cerr)
+	require.Equal(t, crypto.ZeroAddress.Bytes(), output)
+}
+
+var (
+	testCaller  = crypto.Address{0x01}
+	testAddress = crypto.Address{0x02}
+)
+
+func newTestState() *evm.State {
+	cache := evm.NewState(acmstate.NewMemoryState(), blockHashGetter)
+	cache.CreateAccount(testCaller)
+	cache.CreateAccount(testAddress)
+	return cache
+}
+
+func runTestModule(t *testing.T, cache *evm.State, eventSink evm.EventSink, code []byte,
+	gas uint64) ([]byte, errors.CodedError) {
+
+	vm := NewVM(evm.Params{BlockHeight: 34}, testCaller, nil, logging.NewNoopLogger())
+	if len(cache.GetWASMCode(testAddress)) == 0 {
+		cache.InitWASMCode(testAddress, code)
+	}
+	output, cerr := vm.Call(cache, eventSink, testCaller, testAddress, code, nil, 0, &gas, false)
+	if cerr == nil {
+		require.NoError(t, cache.Sync())
+	}
+	return output, cerr
+}
+
+type logSink struct {
+	logs []*exec.LogEvent
+}
+
+func (ls *logSink) Call(call *exec.CallEvent, exception *errors.Exception) error {
+	return nil
+}
+
+func (ls *logSink) Log(log *exec.LogEvent) error {
+	ls.logs = append(ls.logs, log)
+	return nil
+}
+
+// A minimal WASM encoder for test contracts with a single page of memory and a single function exported as both
+// "function" and "constructor"
+
+const (
+	i32 = 0x7f
+	i64 = 0x7e
+)
+
+type hostImport struct {
+	name    string
+	params  []byte
+	results []byte
+}
+
+func newTestModule(imports []hostImport, data []byte, instructions ...[]byte) []byte {
+	types := [][]byte{funcType([]byte{i32}, []byte{i32})}
+	var importEntries [][]byte
+	for i, imp := range imports {
+		types = append(types, funcType(imp.params, imp.results))
+		importEntries = append(importEntries, concat(name("env"), name(imp.name), []byte{0x00}, uleb(uint64(i+1))))
+	}
+	entry := uleb(uint64(len(imports)))
+	body := concat([]byte{0x00}, concat(instructions...), []byte{0x0b})
+	module := concat([]byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00},
+		section(1, vec(types...)),
+		section(2, vec(importEntries...)),
+		section(3, vec([]byte{0x00})),
+		section(5, vec([]byte{0x00, 0x01})),
+		section(7, vec(concat(name("function"), []byte{0x00}, entry),
+			concat(name("constructor"), []byte{0x00}, entry))),
+		section(10, vec(concat(uleb(uint64(len(body))), body))))
+	if len(data) > 0 {
+		module = append(module, section(11, vec(concat([]byte{0x00}, i32Const(0), []byte{0x0b},
+			uleb(uint64(len(data))), data)))...)
+	}
+	return module
+}
+
+func i32Const(value int64) []byte {
+	return append([]byte{0x41}, sleb(value)...)
+}
+
+func i64Const(value int64) []byte {
+	return append([]byte{0x42}, sleb(value)...)
+}
+
+func call(index uint64) []byte {
+	return append([]byte{0x10}, uleb(index)...)
+}
+
+func funcType(params, results []byte) []byte {
+	return concat([]byte{0x60}, uleb(uint64(len(params))), params, uleb(uint64(len(results))), results)
+}
+
+func section(id byte, content []byte) []byte {
+	return concat([]byte{id}, uleb(uint64(len(content))), content)
+}
+
+func vec(items ...[]byte) []byte {
+	return concat(uleb(uint64(len(items))), concat(items...))
+}
+
+func name(s string) []byte {
+	return concat(uleb(uint64(len(s))), []byte(s))
+}
+
+func concat(bss ...[]byte) []byte {
+	var out []byte
+	for _, bs := range bss {
+		out = append(out, bs...)
+	}
+	return out
+}
+
+func uleb(value uint64) []byte {
+	var out []byte
+	for {
+		b := byte(value & 0x7f)
+		value >>= 7
+		if value == 0 {
+			return append(out, b)
+		}
+		out = append(out, b|0x80)
+	}
+}
+
+func sleb(value int64) []byte {
+	var out []byte
+	for {
+		b := byte(value & 0x7f)
+		value >>= 7
+		if (value == 0 && b&0x40 == 0) || (value == -1 && b&0x40 != 0) {
+			return append(out, b)
+		}
+		out = append(out, b|0x80)
+	}
+}
+
 func blockHashGetter(height uint64) []byte {
 	return binary.LeftPadWord256([]byte(fmt.Sprintf("block_hash_%d", height))).Bytes()
 }
@@ -23,6 +23,7 @@ import (
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/txs"
 	"github.com/pkg/errors"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/store"
 	"github.com/tendermint/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
@@ -79,8 +80,11 @@ func (re *Replay) LoadAt(height uint64) (err error) {
 	}
 
 	// Get our commit machinery
-	re.committer = execution.NewBatchCommitter(re.State, execution.ParamsFromGenesis(re.genesisDoc), re.blockchain,
-		event.NewEmitter(), re.logger)
+	params, err := execution.ParamsFromGenesis(re.genesisDoc)
+	if err != nil {
+		return err
+	}
+	re.committer = execution.NewBatchCommitter(re.State, params, re.blockchain, event.NewEmitter(), re.logger)
 	return nil
 }
 
@@ -148,6 +152,14 @@ func (re *Replay) Commit(height uint64) (*ReplayCapture, error) {
 	}
 
 	recap.AppHashBefore = binary.HexBytes(block.AppHash)
+	abciHeader := types.TM2PB.Header(&block.Header)
+	err = re.committer.BeginBlock(&abciTypes.RequestBeginBlock{
+		Header:              abciHeader,
+		ByzantineValidators: byzantineValidators(block.Block),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "committer.BeginBlock()")
+	}
 	err = block.Transactions(func(txEnv *txs.Envelope) error {
 		txe, err := re.committer.Execute(txEnv)
 		if err != nil {
@@ -160,7 +172,11 @@ func (re *Replay) Commit(height uint64) (*ReplayCapture, error) {
 		return nil, errors.Wrap(err, "block.Transactions()")
 	}
 
-	abciHeader := types.TM2PB.Header(&block.Header)
+	err = re.committer.EndBlock(&abciTypes.RequestEndBlock{Height: block.Height})
+	if err != nil {
+		return nil, errors.Wrap(err, "committer.EndBlock()")
+	}
+
 	recap.AppHashAfter, err = re.committer.Commit(&abciHeader)
 	if err != nil {
 		return nil, errors.Wrap(err, "committer.Commit()")
@@ -169,6 +185,25 @@ func (re *Replay) Commit(height uint64) (*ReplayCapture, error) {
 	return recap, err
 }
 
+// Tendermint passes evidence to BeginBlock along with the power of the validator at the time, we only need to know who
+// and when
+func byzantineValidators(block *types.Block) []abciTypes.Evidence {
+	evidence := make([]abciTypes.Evidence, len(block.Evidence.Evidence))
+	for i, ev := range block.Evidence.Evidence {
+		evType := types.ABCIEvidenceTypeDuplicateVote
+		if _, ok := ev.(types.MockGoodEvidence); ok {
+			evType = types.ABCIEvidenceTypeMockGood
+		}
+		evidence[i] = abciTypes.Evidence{
+			Type:      evType,
+			Validator: abciTypes.Validator{Address: ev.Address()},
+			Height:    ev.Height(),
+			Time:      block.Time,
+		}
+	}
+	return evidence
+}
+
 func iterComp(exp, act *state.ReadState, tree treeprint.Tree, prefix []byte) (uint, error) {
 	reader1, err := exp.Forest.Reader(prefix)
 	if err != nil {
@@ -89,8 +89,10 @@ func makeChain(t *testing.T, max uint64) (*genesis.GenesisDoc, dbm.DB, dbm.DB) {
 
 	burrowDB, burrowState, burrowChain := initBurrow(t, genesisDoc)
 
-	committer := execution.NewBatchCommitter(burrowState, execution.ParamsFromGenesis(genesisDoc),
-		burrowChain, event.NewEmitter(), logging.NewNoopLogger())
+	params, err := execution.ParamsFromGenesis(genesisDoc)
+	require.NoError(t, err)
+	committer := execution.NewBatchCommitter(burrowState, params, burrowChain, event.NewEmitter(),
+		logging.NewNoopLogger())
 
 	var stateHash []byte
 	for i := uint64(1); i < max; i++ {
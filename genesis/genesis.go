@@ -62,6 +62,34 @@ var DefaultPermissionsAccount = PermissionsAccount(permission.DefaultAccountPerm
 
 type params struct {
 	ProposalThreshold uint64
+	// Name of the EVM gas schedule - one of "legacy" or "ethereum" (empty for legacy)
+	GasSchedule string `json:",omitempty" toml:",omitempty"`
+	// Name of an Ethereum fork (e.g. "istanbul") whose opcode set and semantics the EVM follows, empty for Burrow default
+	Hardfork string `json:",omitempty" toml:",omitempty"`
+	// Fraction of its power a validator loses when Tendermint reports evidence of misbehaviour against it, written as a
+	// decimal or a ratio (e.g. "0.05" or "1/20"), empty to not slash validators
+	SlashFraction string `json:",omitempty" toml:",omitempty"`
+	// Number of blocks for which a slashed validator is jailed with no power, zero to not jail validators
+	JailBlocks uint64 `json:",omitempty" toml:",omitempty"`
+	// Number of blocks for which a validator's unbonded stake is held, during which it can still be slashed, before it is
+	// credited to its account, zero to credit it immediately
+	UnbondingBlocks uint64 `json:",omitempty" toml:",omitempty"`
+	// Whether the fees charged by transactions are paid to the validators in proportion to their power at the end of
+	// each block (and NameTx is charged its fee). This changes the state computed for existing chains so is off unless
+	// set at genesis.
+	PayoutFees bool `json:",omitempty" toml:",omitempty"`
+	// Native token minted at the end of each block and paid, along with the block's fees, to the validators in
+	// proportion to their power when PayoutFees is set, zero to pay out fees only
+	BlockReward uint64 `json:",omitempty" toml:",omitempty"`
+	// Maximum number of validators with non-zero power, zero for no limit
+	MaxValidators uint64 `json:",omitempty" toml:",omitempty"`
+	// Minimum power with which a validator may be bonded, zero for no minimum
+	MinBond uint64 `json:",omitempty" toml:",omitempty"`
+	// Maximum fraction of the total power to which any one validator's power may be raised, written as a decimal or a
+	// ratio (e.g. "0.25" or "1/4"), empty for no cap
+	MaxPowerFraction string `json:",omitempty" toml:",omitempty"`
+	// Maximum number of validators whose power may change in a single block, zero for no limit
+	MaxValidatorChanges uint64 `json:",omitempty" toml:",omitempty"`
 }
 
 type GenesisDoc struct {
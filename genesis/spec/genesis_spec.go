@@ -33,6 +33,8 @@ type GenesisSpec struct {
 
 type params struct {
 	ProposalThreshold uint64 `json:",omitempty" toml:",omitempty"`
+	GasSchedule       string `json:",omitempty" toml:",omitempty"`
+	Hardfork          string `json:",omitempty" toml:",omitempty"`
 }
 
 func (gs *GenesisSpec) RealiseKeys(keyClient keys.KeyClient) error {
@@ -64,6 +66,9 @@ func (gs *GenesisSpec) GenesisDoc(keyClient keys.KeyClient) (*genesis.GenesisDoc
 		genesisDoc.Params.ProposalThreshold = genesis.DefaultProposalThreshold
 	}
 
+	genesisDoc.Params.GasSchedule = gs.Params.GasSchedule
+	genesisDoc.Params.Hardfork = gs.Params.Hardfork
+
 	if len(gs.GlobalPermissions) == 0 {
 		genesisDoc.GlobalPermissions = permission.DefaultAccountPermissions.Clone()
 	} else {
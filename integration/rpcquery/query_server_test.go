@@ -18,6 +18,7 @@ import (
 	"github.com/hyperledger/burrow/genesis"
 	"github.com/hyperledger/burrow/integration/rpctest"
 	"github.com/hyperledger/burrow/rpc/rpcquery"
+	"github.com/hyperledger/burrow/txs/payload"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -110,6 +111,47 @@ func TestQueryServer(t *testing.T) {
 		}
 	})
 
+	t.Run("QueryAtHeight", func(t *testing.T) {
+		tcli := rpctest.NewTransactClient(t, kern.GRPCListenAddress().String())
+		qcli := rpctest.NewQueryClient(t, kern.GRPCListenAddress().String())
+		name := "Historical"
+		address := rpctest.PrivateAccounts[3].GetAddress()
+		txeA, err := rpctest.UpdateName(tcli, address, name, "before", 200)
+		require.NoError(t, err)
+		txeB, err := rpctest.UpdateName(tcli, address, name, "after", 200)
+		require.NoError(t, err)
+
+		entry, err := qcli.GetName(context.Background(), &rpcquery.GetNameParam{Name: name, Height: txeA.Height})
+		require.NoError(t, err)
+		assert.Equal(t, "before", entry.Data)
+		entry, err = qcli.GetName(context.Background(), &rpcquery.GetNameParam{Name: name})
+		require.NoError(t, err)
+		assert.Equal(t, "after", entry.Data)
+
+		accA, err := qcli.GetAccount(context.Background(), &rpcquery.GetAccountParam{Address: address, Height: txeA.Height})
+		require.NoError(t, err)
+		accB, err := qcli.GetAccount(context.Background(), &rpcquery.GetAccountParam{Address: address, Height: txeB.Height})
+		require.NoError(t, err)
+		assert.Equal(t, accA.Balance-txeB.Envelope.Tx.Payload.(*payload.NameTx).Input.Amount, accB.Balance)
+
+		vs, err := qcli.GetValidatorSet(context.Background(), &rpcquery.GetValidatorSetParam{Height: txeA.Height})
+		require.NoError(t, err)
+		require.Len(t, vs.Set, 1)
+		assert.Equal(t, rpctest.PrivateAccounts[0].GetPublicKey(), vs.Set[0].PublicKey)
+
+		_, err = qcli.GetAccount(context.Background(), &rpcquery.GetAccountParam{Address: address, Height: 1 << 40})
+		require.Error(t, err)
+	})
+
+	t.Run("GetParams", func(t *testing.T) {
+		qcli := rpctest.NewQueryClient(t, kern.GRPCListenAddress().String())
+		params, err := qcli.GetParams(context.Background(), &rpcquery.GetParamsParam{})
+		require.NoError(t, err)
+		assert.Equal(t, rpctest.GenesisDoc.Params.ProposalThreshold, params.ProposalThreshold)
+		assert.Equal(t, rpctest.GenesisDoc.Params.GasSchedule, params.GasSchedule)
+		assert.Equal(t, rpctest.GenesisDoc.Params.UnbondingBlocks, params.UnbondingBlocks)
+	})
+
 	t.Run("GetBlockHeader", func(t *testing.T) {
 		qcli := rpctest.NewQueryClient(t, kern.GRPCListenAddress().String())
 		ecli := rpctest.NewExecutionEventsClient(t, kern.GRPCListenAddress().String())
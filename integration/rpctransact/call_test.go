@@ -6,6 +6,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"math/big"
 	"sync"
 	"testing"
@@ -232,6 +233,123 @@ func testCallTx(t *testing.T, kern *core.Kernel, cli rpctransact.TransactClient)
 			return
 		})
 
+		t.Run("EstimateGas", func(t *testing.T) {
+			t.Parallel()
+			initCode, _, expectedReturn := simpleContract(43, 1)
+			createTx := &payload.CallTx{
+				Input: &payload.TxInput{
+					Address: inputAddress,
+					Amount:  uint64(6969),
+				},
+				Data: initCode,
+			}
+			estimate, err := cli.EstimateGas(context.Background(), createTx)
+			require.NoError(t, err)
+			assert.NotZero(t, estimate.GasLimit)
+
+			createTx.GasLimit = estimate.GasLimit
+			txe, err := cli.CallTxSync(context.Background(), createTx)
+			require.NoError(t, err)
+			require.Nil(t, txe.Exception)
+			contractAddress := txe.Receipt.ContractAddress
+
+			callTx := &payload.CallTx{
+				Input: &payload.TxInput{
+					Address: inputAddress,
+					Amount:  uint64(6969),
+				},
+				Address: &contractAddress,
+			}
+			estimate, err = cli.EstimateGas(context.Background(), callTx)
+			require.NoError(t, err)
+			callTx.GasLimit = estimate.GasLimit
+			txe, err = cli.CallTxSync(context.Background(), callTx)
+			require.NoError(t, err)
+			assert.Equal(t, expectedReturn, txe.Result.Return)
+			assert.Equal(t, estimate.GasUsed, txe.Result.GasUsed)
+		})
+
+		t.Run("CallTxSimOverride", func(t *testing.T) {
+			t.Parallel()
+			// Returns storage slot 5 of an account that does not exist
+			address := crypto.Address{0xAB, 0xCD}
+			code := bc.MustSplice(asm.PUSH1, 0x05, asm.SLOAD, asm.PUSH1, 0x00, asm.MSTORE, asm.PUSH1, 0x20,
+				asm.PUSH1, 0x00, asm.RETURN)
+			param := &rpctransact.CallTxSimParam{
+				CallTx: &payload.CallTx{
+					Input:   &payload.TxInput{Address: inputAddress},
+					Address: &address,
+				},
+				Overrides: []*rpctransact.AccountOverride{{
+					Address: address,
+					EVMCode: code,
+					Storage: []*rpctransact.StorageOverride{{
+						Key:   binary.Int64ToWord256(5),
+						Value: binary.Int64ToWord256(42).Bytes(),
+					}},
+				}},
+			}
+			txe, err := cli.CallTxSimOverride(context.Background(), param)
+			require.NoError(t, err)
+			require.Nil(t, txe.Exception)
+			assert.Equal(t, binary.Int64ToWord256(42).Bytes(), []byte(txe.Result.Return))
+
+			// Against the first block, making sure it has been committed
+			_, err = rpctest.CreateContract(cli, inputAddress, code, nil)
+			require.NoError(t, err)
+			param.Height = 1
+			txe, err = cli.CallTxSimOverride(context.Background(), param)
+			require.NoError(t, err)
+			require.Nil(t, txe.Exception)
+			assert.Equal(t, binary.Int64ToWord256(42).Bytes(), []byte(txe.Result.Return))
+		})
+
+		t.Run("TraceTx", func(t *testing.T) {
+			t.Parallel()
+			initCode, _, expectedReturn := simpleContract(43, 1)
+			txe, err := cli.CallTxSync(context.Background(), &payload.CallTx{
+				Input: &payload.TxInput{
+					Address: inputAddress,
+					Amount:  uint64(6969),
+				},
+				Data:     initCode,
+				Fee:      uint64(1000),
+				GasLimit: uint64(1000),
+			})
+			require.NoError(t, err)
+			contractAddress := txe.Receipt.ContractAddress
+			callTx := &payload.CallTx{
+				Input: &payload.TxInput{
+					Address: inputAddress,
+					Amount:  uint64(6969),
+				},
+				Address:  &contractAddress,
+				Fee:      uint64(1000),
+				GasLimit: uint64(1000),
+			}
+			txe, err = cli.CallTxSync(context.Background(), callTx)
+			require.NoError(t, err)
+			assert.Equal(t, expectedReturn, txe.Result.Return)
+
+			for _, param := range []*rpctransact.TraceParam{{TxHash: txe.TxHash}, {CallTx: callTx}} {
+				stream, err := cli.TraceTx(context.Background(), param)
+				require.NoError(t, err)
+				var structLogs []*rpctransact.StructLog
+				for {
+					structLog, err := stream.Recv()
+					if err == io.EOF {
+						break
+					}
+					require.NoError(t, err)
+					structLogs = append(structLogs, structLog)
+				}
+				require.NotEmpty(t, structLogs)
+				assert.Equal(t, contractAddress, structLogs[0].Address)
+				assert.Equal(t, uint64(0), structLogs[0].PC)
+				assert.Equal(t, asm.RETURN.String(), structLogs[len(structLogs)-1].Op)
+			}
+		})
+
 		t.Run("NestedCall", func(t *testing.T) {
 			t.Parallel()
 			// create two contracts, one of which calls the other
@@ -0,0 +1,136 @@
+// +build integration
+
+package rpctransact
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/deploy/jobs"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/solidity"
+	"github.com/hyperledger/burrow/integration"
+	"github.com/hyperledger/burrow/integration/rpctest"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/rpc/rpcquery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hex "github.com/tmthrgd/go-hex"
+)
+
+func TestDryRun(t *testing.T) {
+	t.Parallel()
+	kern, shutdown := integration.RunNode(t, rpctest.GenesisDoc, rpctest.PrivateAccounts)
+	defer shutdown()
+
+	logger := logging.NewNoopLogger()
+	qcli := rpctest.NewQueryClient(t, kern.GRPCListenAddress().String())
+	client := def.NewClient(kern.GRPCListenAddress().String(), "", true, 10*time.Second)
+	client.DryRun = true
+
+	input := inputAddress.String()
+	chainAcc, err := qcli.GetAccount(context.Background(), &rpcquery.GetAccountParam{Address: inputAddress})
+	require.NoError(t, err)
+
+	// Deploy
+	tx, err := client.Call(&def.CallArg{
+		Input: input,
+		Gas:   "1000000",
+		Data:  hex.EncodeToString(solidity.Bytecode_ZeroReset),
+	}, logger)
+	require.NoError(t, err)
+	assert.Equal(t, chainAcc.Sequence+1, tx.Input.Sequence)
+	txe, err := client.SignAndBroadcast(tx, logger)
+	require.NoError(t, err)
+	require.True(t, txe.Receipt.CreatesContract)
+	contractAddress := txe.Receipt.ContractAddress
+
+	// Call
+	data, _, err := abi.EncodeFunctionCall(string(solidity.Abi_ZeroReset), "setUint", logger, 42)
+	require.NoError(t, err)
+	tx, err = client.Call(&def.CallArg{
+		Input:   input,
+		Address: contractAddress.String(),
+		Gas:     "1000000",
+		Data:    hex.EncodeToString(data),
+	}, logger)
+	require.NoError(t, err)
+	assert.Equal(t, chainAcc.Sequence+2, tx.Input.Sequence)
+	_, err = client.SignAndBroadcast(tx, logger)
+	require.NoError(t, err)
+
+	// Query sees the earlier calls
+	data, _, err = abi.EncodeFunctionCall(string(solidity.Abi_ZeroReset), "getUint", logger)
+	require.NoError(t, err)
+	txe, err = client.QueryContract(&def.QueryArg{
+		Input:   input,
+		Address: contractAddress.String(),
+		Data:    hex.EncodeToString(data),
+	}, logger)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(42), binary.Uint64FromWord256(binary.LeftPadWord256(txe.Result.Return)))
+
+	// Send
+	recipient := crypto.Address{1, 2, 3}
+	sendTx, err := client.Send(&def.SendArg{
+		Input:  input,
+		Output: recipient.String(),
+		Amount: "100",
+	}, logger)
+	require.NoError(t, err)
+	_, err = client.SignAndBroadcast(sendTx, logger)
+	require.NoError(t, err)
+	acc, err := client.GetAccount(recipient)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(100), acc.Balance)
+
+	// None of which reached the chain
+	for _, address := range []crypto.Address{contractAddress, recipient} {
+		acc, err = qcli.GetAccount(context.Background(), &rpcquery.GetAccountParam{Address: address})
+		require.NoError(t, err)
+		assert.Equal(t, crypto.ZeroAddress, acc.Address)
+	}
+	acc, err = qcli.GetAccount(context.Background(), &rpcquery.GetAccountParam{Address: inputAddress})
+	require.NoError(t, err)
+	assert.Equal(t, chainAcc.Sequence, acc.Sequence)
+
+	// The chain's parameters were read so only the VM options are unknown
+	assert.Len(t, client.SimulationWarnings(), 1)
+}
+
+func TestDryRunParallelJobs(t *testing.T) {
+	t.Parallel()
+	kern, shutdown := integration.RunNode(t, rpctest.GenesisDoc, rpctest.PrivateAccounts)
+	defer shutdown()
+
+	logger := logging.NewNoopLogger()
+	client := def.NewClient(kern.GRPCListenAddress().String(), "", true, 10*time.Second)
+	client.DryRun = true
+
+	// Each send depends only on the first job so they all run in parallel from the same account
+	recipient := crypto.Address{4, 5, 6}
+	playbook := &def.Playbook{
+		Account: inputAddress.String(),
+		Jobs:    []*def.Job{{Name: "start", Set: &def.Set{Value: "1"}}},
+	}
+	const sends = 8
+	for i := 0; i < sends; i++ {
+		playbook.Jobs = append(playbook.Jobs, &def.Job{
+			Name:      fmt.Sprintf("send%d", i),
+			DependsOn: []string{"start"},
+			Send:      &def.Send{Destination: recipient.String(), Amount: "$start"},
+		})
+	}
+	err := jobs.ExecutePlaybook(&def.DeployArgs{DryRun: true, DefaultAmount: "0", DefaultFee: "0",
+		DefaultGas: "0"}, playbook, client, logger)
+	require.NoError(t, err)
+
+	acc, err := client.GetAccount(recipient)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(sends), acc.Balance)
+}
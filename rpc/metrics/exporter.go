@@ -21,6 +21,7 @@ import (
 	"github.com/tendermint/tendermint/types"
 
 	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/execution/state"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/logging/structure"
 	"github.com/hyperledger/burrow/rpc"
@@ -56,6 +57,11 @@ type InfoService interface {
 	Stats() acmstate.AccountStatsGetter
 }
 
+// Implemented by state that may be pruned
+type PruningStatsGetter interface {
+	GetPruningStats() state.PruningStats
+}
+
 // Datum is used to store data from all the relevant endpoints
 type Datum struct {
 	LatestBlockHeight   float64
@@ -70,6 +76,9 @@ type Datum struct {
 	TimePerBlockBuckets map[float64]uint64
 	AccountsWithCode    float64
 	AccountsWithoutCode float64
+	PrunedVersions      float64
+	PrunedToHeight      float64
+	PruningDuration     float64
 }
 
 // Exporter uses the InfoService to provide pre-aggregated metrics of various types that are then passed to prometheus
@@ -173,6 +182,27 @@ func (e *Exporter) Collect(ch chan<- prometheus.Metric) {
 		e.chainID,
 		e.validatorMoniker,
 	)
+	ch <- prometheus.MustNewConstMetric(
+		PrunedVersions,
+		prometheus.CounterValue,
+		e.datum.PrunedVersions,
+		e.chainID,
+		e.validatorMoniker,
+	)
+	ch <- prometheus.MustNewConstMetric(
+		PrunedToHeight,
+		prometheus.GaugeValue,
+		e.datum.PrunedToHeight,
+		e.chainID,
+		e.validatorMoniker,
+	)
+	ch <- prometheus.MustNewConstMetric(
+		PruningDuration,
+		prometheus.GaugeValue,
+		e.datum.PruningDuration,
+		e.chainID,
+		e.validatorMoniker,
+	)
 
 	e.logger.InfoMsg("All Metrics successfully collected")
 }
@@ -206,6 +236,7 @@ func (e *Exporter) gatherData() error {
 		return err
 	}
 	e.getAccountStats()
+	e.getPruningStats()
 
 	return nil
 }
@@ -317,6 +348,17 @@ func (e *Exporter) getAccountStats() {
 	e.datum.AccountsWithoutCode = float64(stats.AccountsWithoutCode)
 }
 
+func (e *Exporter) getPruningStats() {
+	getter, ok := e.service.Stats().(PruningStatsGetter)
+	if !ok {
+		return
+	}
+	stats := getter.GetPruningStats()
+	e.datum.PrunedVersions = float64(stats.VersionsPruned)
+	e.datum.PrunedToHeight = float64(stats.PrunedToHeight)
+	e.datum.PruningDuration = stats.LastDuration.Seconds()
+}
+
 // Returns a function that builds a histogram.
 //
 // The builder takes a slice of values one for each entity in a sample, sorts it, and computes histogram buckets as
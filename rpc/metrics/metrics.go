@@ -49,6 +49,21 @@ var (
 		prometheus.BuildFQName("burrow", "accounts", "users"),
 		"Current users on the chain",
 		[]string{"chain_id", "moniker"})
+
+	PrunedVersions = newDesc(
+		prometheus.BuildFQName("burrow", "state", "pruned_versions"),
+		"Versions of state pruned since starting",
+		[]string{"chain_id", "moniker"})
+
+	PrunedToHeight = newDesc(
+		prometheus.BuildFQName("burrow", "state", "pruned_to_height"),
+		"Height below which versions of state have been pruned",
+		[]string{"chain_id", "moniker"})
+
+	PruningDuration = newDesc(
+		prometheus.BuildFQName("burrow", "state", "pruning_seconds"),
+		"Duration of the last pruning run",
+		[]string{"chain_id", "moniker"})
 )
 
 func newDesc(fqName, help string, variableLabels []string) *prometheus.Desc {
@@ -98,6 +98,13 @@ func (ees *executionEventsServer) Events(request *BlocksRequest, stream Executio
 		case sev.EndBlock != nil && len(response.Events) > 0:
 			return stream.Send(response)
 
+		case sev.Event != nil && len(stack) == 0:
+			// Events outside of any transaction belong to the block, those with an exception (e.g. a slash that could
+			// not be applied) are excluded in the same way as the events of an exceptional transaction
+			if sev.Event.Header.Exception == nil && qry.Matches(sev.Event) {
+				response.Events = append(response.Events, sev.Event)
+			}
+
 		default:
 			// We need to consume transaction to exclude events belong to an exceptional transaction
 			txe, err := stack.Consume(sev)
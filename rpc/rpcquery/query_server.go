@@ -12,8 +12,10 @@ import (
 	"github.com/hyperledger/burrow/consensus/tendermint"
 	"github.com/hyperledger/burrow/deploy/compile"
 	"github.com/hyperledger/burrow/event/query"
+	"github.com/hyperledger/burrow/execution/delegation"
 	"github.com/hyperledger/burrow/execution/names"
 	"github.com/hyperledger/burrow/execution/proposal"
+	"github.com/hyperledger/burrow/execution/slashing"
 	"github.com/hyperledger/burrow/execution/state"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/rpc"
@@ -26,22 +28,30 @@ type queryServer struct {
 	accounts    acmstate.IterableStatsReader
 	nameReg     names.IterableReader
 	proposalReg proposal.IterableReader
+	slashing    slashing.IterableReader
+	delegations delegation.IterableReader
 	blockchain  bcm.BlockchainInfo
 	validators  validator.History
-	nodeView    *tendermint.NodeView
-	logger      *logging.Logger
+	// Loads the committed state at a previous height for queries that specify one
+	atHeight func(height uint64) (*state.ReadState, error)
+	nodeView *tendermint.NodeView
+	logger   *logging.Logger
 }
 
 var _ QueryServer = &queryServer{}
 
 func NewQueryServer(state acmstate.IterableStatsReader, nameReg names.IterableReader, proposalReg proposal.IterableReader,
-	blockchain bcm.BlockchainInfo, validators validator.History, nodeView *tendermint.NodeView, logger *logging.Logger) *queryServer {
+	slashes slashing.IterableReader, delegations delegation.IterableReader, blockchain bcm.BlockchainInfo,
+	validators validator.History, atHeight func(height uint64) (*state.ReadState, error), nodeView *tendermint.NodeView, logger *logging.Logger) *queryServer {
 	return &queryServer{
 		accounts:    state,
 		nameReg:     nameReg,
 		proposalReg: proposalReg,
+		slashing:    slashes,
+		delegations: delegations,
 		blockchain:  blockchain,
 		validators:  validators,
+		atHeight:    atHeight,
 		nodeView:    nodeView,
 		logger:      logger,
 	}
@@ -54,7 +64,11 @@ func (qs *queryServer) Status(ctx context.Context, param *StatusParam) (*rpc.Res
 // Account state
 
 func (qs *queryServer) GetAccount(ctx context.Context, param *GetAccountParam) (*acm.Account, error) {
-	acc, err := qs.accounts.GetAccount(param.Address)
+	accounts, err := qs.accountsAt(param.Height)
+	if err != nil {
+		return nil, err
+	}
+	acc, err := accounts.GetAccount(param.Address)
 	if acc == nil {
 		acc = &acm.Account{}
 	}
@@ -108,7 +122,11 @@ func (qs *queryServer) GetMetadata(ctx context.Context, param *GetMetadataParam)
 }
 
 func (qs *queryServer) GetStorage(ctx context.Context, param *GetStorageParam) (*StorageValue, error) {
-	val, err := qs.accounts.GetStorage(param.Address, param.Key)
+	accounts, err := qs.accountsAt(param.Height)
+	if err != nil {
+		return nil, err
+	}
+	val, err := accounts.GetStorage(param.Address, param.Key)
 	return &StorageValue{Value: val}, err
 }
 
@@ -117,8 +135,12 @@ func (qs *queryServer) ListAccounts(param *ListAccountsParam, stream Query_ListA
 	if err != nil {
 		return err
 	}
+	accounts, err := qs.accountsAt(param.Height)
+	if err != nil {
+		return err
+	}
 	var streamErr error
-	err = qs.accounts.IterateAccounts(func(acc *acm.Account) error {
+	err = accounts.IterateAccounts(func(acc *acm.Account) error {
 		if qry.Matches(acc) {
 			return stream.Send(acc)
 		} else {
@@ -134,7 +156,11 @@ func (qs *queryServer) ListAccounts(param *ListAccountsParam, stream Query_ListA
 // Names
 
 func (qs *queryServer) GetName(ctx context.Context, param *GetNameParam) (entry *names.Entry, err error) {
-	entry, err = qs.nameReg.GetName(param.Name)
+	nameReg, err := qs.nameRegAt(param.Height)
+	if err != nil {
+		return nil, err
+	}
+	entry, err = nameReg.GetName(param.Name)
 	if entry == nil && err == nil {
 		err = fmt.Errorf("name %s not found", param.Name)
 	}
@@ -163,9 +189,24 @@ func (qs *queryServer) ListNames(param *ListNamesParam, stream Query_ListNamesSe
 // Validators
 
 func (qs *queryServer) GetValidatorSet(ctx context.Context, param *GetValidatorSetParam) (*ValidatorSet, error) {
-	set := validator.Copy(qs.validators.Validators(0))
+	if param.Height == 0 {
+		set := validator.Copy(qs.validators.Validators(0))
+		return &ValidatorSet{
+			Set: set.Validators(),
+		}, nil
+	}
+	st, err := qs.atHeight(param.Height)
+	if err != nil {
+		return nil, err
+	}
+	set := validator.NewSet()
+	err = validator.Write(set, st)
+	if err != nil {
+		return nil, err
+	}
 	return &ValidatorSet{
-		Set: set.Validators(),
+		Height: param.Height,
+		Set:    set.Validators(),
 	}, nil
 }
 
@@ -218,6 +259,57 @@ func (qs *queryServer) ListProposals(param *ListProposalsParam, stream Query_Lis
 	return streamErr
 }
 
+// Slashing
+
+func (qs *queryServer) ListSlashes(param *ListSlashesParam, stream Query_ListSlashesServer) error {
+	qry, err := query.NewOrEmpty(param.Query)
+	if err != nil {
+		return err
+	}
+	return qs.slashing.IterateSlashes(func(slash *slashing.Slash) error {
+		if qry.Matches(slash) {
+			return stream.Send(slash)
+		}
+		return nil
+	})
+}
+
+func (qs *queryServer) ListUnbondings(param *ListUnbondingsParam, stream Query_ListUnbondingsServer) error {
+	qry, err := query.NewOrEmpty(param.Query)
+	if err != nil {
+		return err
+	}
+	return qs.slashing.IterateUnbondings(func(unbonding *slashing.Unbonding) error {
+		if qry.Matches(unbonding) {
+			return stream.Send(unbonding)
+		}
+		return nil
+	})
+}
+
+// Delegations
+
+func (qs *queryServer) GetDelegation(ctx context.Context, param *GetDelegationParam) (*delegation.Delegation, error) {
+	d, err := qs.delegations.GetDelegation(param.Validator, param.Delegator)
+	if d == nil && err == nil {
+		err = fmt.Errorf("%v has not delegated to %v", param.Delegator, param.Validator)
+	}
+	return d, err
+}
+
+func (qs *queryServer) ListDelegations(param *ListDelegationsParam, stream Query_ListDelegationsServer) error {
+	qry, err := query.NewOrEmpty(param.Query)
+	if err != nil {
+		return err
+	}
+	return qs.delegations.IterateDelegations(func(d *delegation.Delegation) error {
+		if qry.Matches(d) {
+			return stream.Send(d)
+		}
+		return nil
+	})
+}
+
 func (qs *queryServer) GetStats(ctx context.Context, param *GetStatsParam) (*Stats, error) {
 	stats := qs.accounts.GetAccountStats()
 
@@ -227,6 +319,26 @@ func (qs *queryServer) GetStats(ctx context.Context, param *GetStatsParam) (*Sta
 	}, nil
 }
 
+// Chain parameters
+
+func (qs *queryServer) GetParams(ctx context.Context, param *GetParamsParam) (*Params, error) {
+	params := qs.blockchain.GenesisDoc().Params
+	return &Params{
+		ProposalThreshold:   params.ProposalThreshold,
+		GasSchedule:         params.GasSchedule,
+		Hardfork:            params.Hardfork,
+		SlashFraction:       params.SlashFraction,
+		JailBlocks:          params.JailBlocks,
+		UnbondingBlocks:     params.UnbondingBlocks,
+		PayoutFees:          params.PayoutFees,
+		BlockReward:         params.BlockReward,
+		MaxValidators:       params.MaxValidators,
+		MinBond:             params.MinBond,
+		MaxPowerFraction:    params.MaxPowerFraction,
+		MaxValidatorChanges: params.MaxValidatorChanges,
+	}, nil
+}
+
 // Tendermint and blocks
 
 func (qs *queryServer) GetBlockHeader(ctx context.Context, param *GetBlockParam) (*types.Header, error) {
@@ -237,3 +349,19 @@ func (qs *queryServer) GetBlockHeader(ctx context.Context, param *GetBlockParam)
 	abciHeader := tmtypes.TM2PB.Header(header)
 	return &abciHeader, nil
 }
+
+// Get the account state at height, zero for the latest state
+func (qs *queryServer) accountsAt(height uint64) (acmstate.IterableReader, error) {
+	if height == 0 {
+		return qs.accounts, nil
+	}
+	return qs.atHeight(height)
+}
+
+// Get the name registry at height, zero for the latest state
+func (qs *queryServer) nameRegAt(height uint64) (names.IterableReader, error) {
+	if height == 0 {
+		return qs.nameReg, nil
+	}
+	return qs.atHeight(height)
+}
@@ -15,7 +15,9 @@ import (
 	validator "github.com/hyperledger/burrow/acm/validator"
 	github_com_hyperledger_burrow_binary "github.com/hyperledger/burrow/binary"
 	github_com_hyperledger_burrow_crypto "github.com/hyperledger/burrow/crypto"
+	delegation "github.com/hyperledger/burrow/execution/delegation"
 	names "github.com/hyperledger/burrow/execution/names"
+	slashing "github.com/hyperledger/burrow/execution/slashing"
 	rpc "github.com/hyperledger/burrow/rpc"
 	payload "github.com/hyperledger/burrow/txs/payload"
 	types "github.com/tendermint/tendermint/abci/types"
@@ -85,10 +87,12 @@ func (*StatusParam) XXX_MessageName() string {
 }
 
 type GetAccountParam struct {
-	Address              github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
-	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
-	XXX_unrecognized     []byte                                       `json:"-"`
-	XXX_sizecache        int32                                        `json:"-"`
+	Address github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	// Height at which to read state, zero for the latest height
+	Height               uint64   `protobuf:"varint,2,opt,name=Height,proto3" json:"Height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *GetAccountParam) Reset()         { *m = GetAccountParam{} }
@@ -115,6 +119,13 @@ func (m *GetAccountParam) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GetAccountParam proto.InternalMessageInfo
 
+func (m *GetAccountParam) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func (*GetAccountParam) XXX_MessageName() string {
 	return "rpcquery.GetAccountParam"
 }
@@ -198,11 +209,13 @@ func (*MetadataResult) XXX_MessageName() string {
 }
 
 type GetStorageParam struct {
-	Address              github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
-	Key                  github_com_hyperledger_burrow_binary.Word256 `protobuf:"bytes,2,opt,name=Key,proto3,customtype=github.com/hyperledger/burrow/binary.Word256" json:"Key"`
-	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
-	XXX_unrecognized     []byte                                       `json:"-"`
-	XXX_sizecache        int32                                        `json:"-"`
+	Address github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	Key     github_com_hyperledger_burrow_binary.Word256 `protobuf:"bytes,2,opt,name=Key,proto3,customtype=github.com/hyperledger/burrow/binary.Word256" json:"Key"`
+	// Height at which to read state, zero for the latest height
+	Height               uint64   `protobuf:"varint,3,opt,name=Height,proto3" json:"Height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *GetStorageParam) Reset()         { *m = GetStorageParam{} }
@@ -229,6 +242,13 @@ func (m *GetStorageParam) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GetStorageParam proto.InternalMessageInfo
 
+func (m *GetStorageParam) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func (*GetStorageParam) XXX_MessageName() string {
 	return "rpcquery.GetStorageParam"
 }
@@ -269,7 +289,9 @@ func (*StorageValue) XXX_MessageName() string {
 }
 
 type ListAccountsParam struct {
-	Query                string   `protobuf:"bytes,1,opt,name=Query,proto3" json:"Query,omitempty"`
+	Query string `protobuf:"bytes,1,opt,name=Query,proto3" json:"Query,omitempty"`
+	// Height at which to read state, zero for the latest height
+	Height               uint64   `protobuf:"varint,2,opt,name=Height,proto3" json:"Height,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -306,12 +328,21 @@ func (m *ListAccountsParam) GetQuery() string {
 	return ""
 }
 
+func (m *ListAccountsParam) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func (*ListAccountsParam) XXX_MessageName() string {
 	return "rpcquery.ListAccountsParam"
 }
 
 type GetNameParam struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	// Height at which to read state, zero for the latest height
+	Height               uint64   `protobuf:"varint,2,opt,name=Height,proto3" json:"Height,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -348,6 +379,13 @@ func (m *GetNameParam) GetName() string {
 	return ""
 }
 
+func (m *GetNameParam) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func (*GetNameParam) XXX_MessageName() string {
 	return "rpcquery.GetNameParam"
 }
@@ -395,6 +433,8 @@ func (*ListNamesParam) XXX_MessageName() string {
 }
 
 type GetValidatorSetParam struct {
+	// Height at which to read state, zero for the latest height
+	Height               uint64   `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -424,6 +464,13 @@ func (m *GetValidatorSetParam) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GetValidatorSetParam proto.InternalMessageInfo
 
+func (m *GetValidatorSetParam) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func (*GetValidatorSetParam) XXX_MessageName() string {
 	return "rpcquery.GetValidatorSetParam"
 }
@@ -697,6 +744,168 @@ func (*ProposalResult) XXX_MessageName() string {
 	return "rpcquery.ProposalResult"
 }
 
+type ListSlashesParam struct {
+	Query                string   `protobuf:"bytes,1,opt,name=Query,proto3" json:"Query,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListSlashesParam) Reset()         { *m = ListSlashesParam{} }
+func (m *ListSlashesParam) String() string { return proto.CompactTextString(m) }
+func (*ListSlashesParam) ProtoMessage()    {}
+func (*ListSlashesParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{16}
+}
+func (m *ListSlashesParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSlashesParam.Unmarshal(m, b)
+}
+func (m *ListSlashesParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSlashesParam.Marshal(b, m, deterministic)
+}
+func (m *ListSlashesParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSlashesParam.Merge(m, src)
+}
+func (m *ListSlashesParam) XXX_Size() int {
+	return xxx_messageInfo_ListSlashesParam.Size(m)
+}
+func (m *ListSlashesParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSlashesParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSlashesParam proto.InternalMessageInfo
+
+func (m *ListSlashesParam) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (*ListSlashesParam) XXX_MessageName() string {
+	return "rpcquery.ListSlashesParam"
+}
+
+type ListUnbondingsParam struct {
+	Query                string   `protobuf:"bytes,1,opt,name=Query,proto3" json:"Query,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListUnbondingsParam) Reset()         { *m = ListUnbondingsParam{} }
+func (m *ListUnbondingsParam) String() string { return proto.CompactTextString(m) }
+func (*ListUnbondingsParam) ProtoMessage()    {}
+func (*ListUnbondingsParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{17}
+}
+func (m *ListUnbondingsParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListUnbondingsParam.Unmarshal(m, b)
+}
+func (m *ListUnbondingsParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListUnbondingsParam.Marshal(b, m, deterministic)
+}
+func (m *ListUnbondingsParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListUnbondingsParam.Merge(m, src)
+}
+func (m *ListUnbondingsParam) XXX_Size() int {
+	return xxx_messageInfo_ListUnbondingsParam.Size(m)
+}
+func (m *ListUnbondingsParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListUnbondingsParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListUnbondingsParam proto.InternalMessageInfo
+
+func (m *ListUnbondingsParam) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (*ListUnbondingsParam) XXX_MessageName() string {
+	return "rpcquery.ListUnbondingsParam"
+}
+
+type GetDelegationParam struct {
+	Validator            github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Validator,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Validator"`
+	Delegator            github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,2,opt,name=Delegator,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Delegator"`
+	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
+	XXX_unrecognized     []byte                                       `json:"-"`
+	XXX_sizecache        int32                                        `json:"-"`
+}
+
+func (m *GetDelegationParam) Reset()         { *m = GetDelegationParam{} }
+func (m *GetDelegationParam) String() string { return proto.CompactTextString(m) }
+func (*GetDelegationParam) ProtoMessage()    {}
+func (*GetDelegationParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{18}
+}
+func (m *GetDelegationParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDelegationParam.Unmarshal(m, b)
+}
+func (m *GetDelegationParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDelegationParam.Marshal(b, m, deterministic)
+}
+func (m *GetDelegationParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDelegationParam.Merge(m, src)
+}
+func (m *GetDelegationParam) XXX_Size() int {
+	return xxx_messageInfo_GetDelegationParam.Size(m)
+}
+func (m *GetDelegationParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDelegationParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDelegationParam proto.InternalMessageInfo
+
+func (*GetDelegationParam) XXX_MessageName() string {
+	return "rpcquery.GetDelegationParam"
+}
+
+type ListDelegationsParam struct {
+	Query                string   `protobuf:"bytes,1,opt,name=Query,proto3" json:"Query,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListDelegationsParam) Reset()         { *m = ListDelegationsParam{} }
+func (m *ListDelegationsParam) String() string { return proto.CompactTextString(m) }
+func (*ListDelegationsParam) ProtoMessage()    {}
+func (*ListDelegationsParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{19}
+}
+func (m *ListDelegationsParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDelegationsParam.Unmarshal(m, b)
+}
+func (m *ListDelegationsParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDelegationsParam.Marshal(b, m, deterministic)
+}
+func (m *ListDelegationsParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDelegationsParam.Merge(m, src)
+}
+func (m *ListDelegationsParam) XXX_Size() int {
+	return xxx_messageInfo_ListDelegationsParam.Size(m)
+}
+func (m *ListDelegationsParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDelegationsParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDelegationsParam proto.InternalMessageInfo
+
+func (m *ListDelegationsParam) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (*ListDelegationsParam) XXX_MessageName() string {
+	return "rpcquery.ListDelegationsParam"
+}
+
 type GetStatsParam struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -707,7 +916,7 @@ func (m *GetStatsParam) Reset()         { *m = GetStatsParam{} }
 func (m *GetStatsParam) String() string { return proto.CompactTextString(m) }
 func (*GetStatsParam) ProtoMessage()    {}
 func (*GetStatsParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88e25d9b99e39f02, []int{16}
+	return fileDescriptor_88e25d9b99e39f02, []int{20}
 }
 func (m *GetStatsParam) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetStatsParam.Unmarshal(m, b)
@@ -743,7 +952,7 @@ func (m *Stats) Reset()         { *m = Stats{} }
 func (m *Stats) String() string { return proto.CompactTextString(m) }
 func (*Stats) ProtoMessage()    {}
 func (*Stats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88e25d9b99e39f02, []int{17}
+	return fileDescriptor_88e25d9b99e39f02, []int{21}
 }
 func (m *Stats) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Stats.Unmarshal(m, b)
@@ -781,6 +990,171 @@ func (*Stats) XXX_MessageName() string {
 	return "rpcquery.Stats"
 }
 
+type GetParamsParam struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetParamsParam) Reset()         { *m = GetParamsParam{} }
+func (m *GetParamsParam) String() string { return proto.CompactTextString(m) }
+func (*GetParamsParam) ProtoMessage()    {}
+func (*GetParamsParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{22}
+}
+func (m *GetParamsParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetParamsParam.Unmarshal(m, b)
+}
+func (m *GetParamsParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetParamsParam.Marshal(b, m, deterministic)
+}
+func (m *GetParamsParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetParamsParam.Merge(m, src)
+}
+func (m *GetParamsParam) XXX_Size() int {
+	return xxx_messageInfo_GetParamsParam.Size(m)
+}
+func (m *GetParamsParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetParamsParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetParamsParam proto.InternalMessageInfo
+
+func (*GetParamsParam) XXX_MessageName() string {
+	return "rpcquery.GetParamsParam"
+}
+
+// The chain parameters set in genesis, see genesis.GenesisDoc
+type Params struct {
+	ProposalThreshold    uint64   `protobuf:"varint,1,opt,name=ProposalThreshold,proto3" json:"ProposalThreshold,omitempty"`
+	GasSchedule          string   `protobuf:"bytes,2,opt,name=GasSchedule,proto3" json:"GasSchedule,omitempty"`
+	Hardfork             string   `protobuf:"bytes,3,opt,name=Hardfork,proto3" json:"Hardfork,omitempty"`
+	SlashFraction        string   `protobuf:"bytes,4,opt,name=SlashFraction,proto3" json:"SlashFraction,omitempty"`
+	JailBlocks           uint64   `protobuf:"varint,5,opt,name=JailBlocks,proto3" json:"JailBlocks,omitempty"`
+	UnbondingBlocks      uint64   `protobuf:"varint,6,opt,name=UnbondingBlocks,proto3" json:"UnbondingBlocks,omitempty"`
+	BlockReward          uint64   `protobuf:"varint,7,opt,name=BlockReward,proto3" json:"BlockReward,omitempty"`
+	MaxValidators        uint64   `protobuf:"varint,8,opt,name=MaxValidators,proto3" json:"MaxValidators,omitempty"`
+	MinBond              uint64   `protobuf:"varint,9,opt,name=MinBond,proto3" json:"MinBond,omitempty"`
+	MaxPowerFraction     string   `protobuf:"bytes,10,opt,name=MaxPowerFraction,proto3" json:"MaxPowerFraction,omitempty"`
+	MaxValidatorChanges  uint64   `protobuf:"varint,11,opt,name=MaxValidatorChanges,proto3" json:"MaxValidatorChanges,omitempty"`
+	PayoutFees           bool     `protobuf:"varint,12,opt,name=PayoutFees,proto3" json:"PayoutFees,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88e25d9b99e39f02, []int{23}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Params.Unmarshal(m, b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return xxx_messageInfo_Params.Size(m)
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetProposalThreshold() uint64 {
+	if m != nil {
+		return m.ProposalThreshold
+	}
+	return 0
+}
+
+func (m *Params) GetGasSchedule() string {
+	if m != nil {
+		return m.GasSchedule
+	}
+	return ""
+}
+
+func (m *Params) GetHardfork() string {
+	if m != nil {
+		return m.Hardfork
+	}
+	return ""
+}
+
+func (m *Params) GetSlashFraction() string {
+	if m != nil {
+		return m.SlashFraction
+	}
+	return ""
+}
+
+func (m *Params) GetJailBlocks() uint64 {
+	if m != nil {
+		return m.JailBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetUnbondingBlocks() uint64 {
+	if m != nil {
+		return m.UnbondingBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetBlockReward() uint64 {
+	if m != nil {
+		return m.BlockReward
+	}
+	return 0
+}
+
+func (m *Params) GetMaxValidators() uint64 {
+	if m != nil {
+		return m.MaxValidators
+	}
+	return 0
+}
+
+func (m *Params) GetMinBond() uint64 {
+	if m != nil {
+		return m.MinBond
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPowerFraction() string {
+	if m != nil {
+		return m.MaxPowerFraction
+	}
+	return ""
+}
+
+func (m *Params) GetMaxValidatorChanges() uint64 {
+	if m != nil {
+		return m.MaxValidatorChanges
+	}
+	return 0
+}
+
+func (m *Params) GetPayoutFees() bool {
+	if m != nil {
+		return m.PayoutFees
+	}
+	return false
+}
+
+func (*Params) XXX_MessageName() string {
+	return "rpcquery.Params"
+}
+
 type GetBlockParam struct {
 	Height               uint64   `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -792,7 +1166,7 @@ func (m *GetBlockParam) Reset()         { *m = GetBlockParam{} }
 func (m *GetBlockParam) String() string { return proto.CompactTextString(m) }
 func (*GetBlockParam) ProtoMessage()    {}
 func (*GetBlockParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_88e25d9b99e39f02, []int{18}
+	return fileDescriptor_88e25d9b99e39f02, []int{24}
 }
 func (m *GetBlockParam) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBlockParam.Unmarshal(m, b)
@@ -855,10 +1229,22 @@ func init() {
 	golang_proto.RegisterType((*ListProposalsParam)(nil), "rpcquery.ListProposalsParam")
 	proto.RegisterType((*ProposalResult)(nil), "rpcquery.ProposalResult")
 	golang_proto.RegisterType((*ProposalResult)(nil), "rpcquery.ProposalResult")
+	proto.RegisterType((*ListSlashesParam)(nil), "rpcquery.ListSlashesParam")
+	golang_proto.RegisterType((*ListSlashesParam)(nil), "rpcquery.ListSlashesParam")
+	proto.RegisterType((*ListUnbondingsParam)(nil), "rpcquery.ListUnbondingsParam")
+	golang_proto.RegisterType((*ListUnbondingsParam)(nil), "rpcquery.ListUnbondingsParam")
+	proto.RegisterType((*GetDelegationParam)(nil), "rpcquery.GetDelegationParam")
+	golang_proto.RegisterType((*GetDelegationParam)(nil), "rpcquery.GetDelegationParam")
+	proto.RegisterType((*ListDelegationsParam)(nil), "rpcquery.ListDelegationsParam")
+	golang_proto.RegisterType((*ListDelegationsParam)(nil), "rpcquery.ListDelegationsParam")
 	proto.RegisterType((*GetStatsParam)(nil), "rpcquery.GetStatsParam")
 	golang_proto.RegisterType((*GetStatsParam)(nil), "rpcquery.GetStatsParam")
 	proto.RegisterType((*Stats)(nil), "rpcquery.Stats")
 	golang_proto.RegisterType((*Stats)(nil), "rpcquery.Stats")
+	proto.RegisterType((*GetParamsParam)(nil), "rpcquery.GetParamsParam")
+	golang_proto.RegisterType((*GetParamsParam)(nil), "rpcquery.GetParamsParam")
+	proto.RegisterType((*Params)(nil), "rpcquery.Params")
+	golang_proto.RegisterType((*Params)(nil), "rpcquery.Params")
 	proto.RegisterType((*GetBlockParam)(nil), "rpcquery.GetBlockParam")
 	golang_proto.RegisterType((*GetBlockParam)(nil), "rpcquery.GetBlockParam")
 }
@@ -867,67 +1253,91 @@ func init() { proto.RegisterFile("rpcquery.proto", fileDescriptor_88e25d9b99e39f
 func init() { golang_proto.RegisterFile("rpcquery.proto", fileDescriptor_88e25d9b99e39f02) }
 
 var fileDescriptor_88e25d9b99e39f02 = []byte{
-	// 955 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xdd, 0x6e, 0x1b, 0x45,
-	0x14, 0x66, 0x93, 0xc6, 0x71, 0x8e, 0x1d, 0xbb, 0x9d, 0x06, 0x63, 0xb6, 0xe0, 0x54, 0x23, 0x91,
-	0x86, 0xaa, 0x5d, 0x1b, 0xd3, 0x00, 0x02, 0x24, 0x54, 0x57, 0x60, 0x87, 0xd2, 0x28, 0xac, 0xa1,
-	0x95, 0x40, 0x42, 0x1a, 0xef, 0x0e, 0xf6, 0x8a, 0xb5, 0xc7, 0xcc, 0xce, 0x16, 0xf6, 0x91, 0x78,
-	0x00, 0xae, 0xe1, 0xb2, 0x8f, 0x80, 0x7a, 0x11, 0xa1, 0xf6, 0x45, 0xd0, 0xce, 0xcf, 0x7a, 0x77,
-	0xe3, 0x44, 0x80, 0xd4, 0x9b, 0x68, 0xce, 0x99, 0x6f, 0xbe, 0x93, 0x39, 0x73, 0xbe, 0x6f, 0x0d,
-	0x0d, 0xbe, 0xf4, 0x7e, 0x8e, 0x29, 0x4f, 0x9c, 0x25, 0x67, 0x82, 0xa1, 0xaa, 0x89, 0xed, 0xbb,
-	0xd3, 0x40, 0xcc, 0xe2, 0x89, 0xe3, 0xb1, 0x79, 0x77, 0xca, 0xa6, 0xac, 0x2b, 0x01, 0x93, 0xf8,
-	0x47, 0x19, 0xc9, 0x40, 0xae, 0xd4, 0x41, 0xfb, 0xc3, 0x1c, 0x5c, 0xd0, 0x85, 0x4f, 0xf9, 0x3c,
-	0x58, 0x88, 0xfc, 0x92, 0x4c, 0xbc, 0xa0, 0x2b, 0x92, 0x25, 0x8d, 0xd4, 0x5f, 0x7d, 0xb0, 0xb6,
-	0x20, 0xf3, 0x2c, 0xd8, 0x21, 0xde, 0x5c, 0x2f, 0x9b, 0x4f, 0x49, 0x18, 0xf8, 0x44, 0x30, 0x6e,
-	0xf6, 0xf8, 0xd2, 0xd3, 0xcb, 0xdd, 0x25, 0x49, 0x42, 0x46, 0x7c, 0x15, 0xe2, 0x00, 0x6a, 0x63,
-	0x41, 0x44, 0x1c, 0x9d, 0x12, 0x4e, 0xe6, 0xe8, 0x10, 0x9a, 0x83, 0x90, 0x79, 0x3f, 0x7d, 0x13,
-	0xcc, 0xe9, 0x93, 0x40, 0xcc, 0x82, 0x45, 0xdb, 0xba, 0x69, 0x1d, 0xee, 0xb8, 0xe5, 0x34, 0xea,
-	0xc1, 0x75, 0x99, 0x1a, 0x53, 0xba, 0xc8, 0xa1, 0x37, 0x24, 0x7a, 0xdd, 0x16, 0x26, 0xd0, 0x1c,
-	0x52, 0x71, 0xdf, 0xf3, 0x58, 0xbc, 0x10, 0xaa, 0xdc, 0x09, 0x6c, 0xdf, 0xf7, 0x7d, 0x4e, 0xa3,
-	0x48, 0x96, 0xa9, 0x0f, 0xee, 0x3d, 0x3b, 0xdb, 0x7f, 0xed, 0xf9, 0xd9, 0xfe, 0x9d, 0x5c, 0x4b,
-	0x66, 0xc9, 0x92, 0xf2, 0x90, 0xfa, 0x53, 0xca, 0xbb, 0x93, 0x98, 0x73, 0xf6, 0x4b, 0xd7, 0xe3,
-	0xc9, 0x52, 0x30, 0x47, 0x9f, 0x75, 0x0d, 0x09, 0xfe, 0xdd, 0x82, 0xab, 0x43, 0x2a, 0x1e, 0x51,
-	0x41, 0x7c, 0x22, 0x88, 0x2a, 0xf2, 0x65, 0xb9, 0x48, 0xef, 0x7f, 0x17, 0x40, 0xdf, 0x42, 0xdd,
-	0x90, 0x8f, 0x48, 0x34, 0x93, 0xd7, 0xad, 0x0f, 0xde, 0x7b, 0x7e, 0xb6, 0x7f, 0xf7, 0x72, 0xc2,
-	0x49, 0xb0, 0x20, 0x3c, 0x71, 0x46, 0xf4, 0xd7, 0x41, 0x22, 0x68, 0xe4, 0x16, 0x68, 0xf0, 0x1d,
-	0x68, 0x98, 0xd8, 0xa5, 0x51, 0x1c, 0x0a, 0x64, 0x43, 0xd5, 0x64, 0xf4, 0x0b, 0x64, 0x31, 0xfe,
-	0xcd, 0x92, 0x9d, 0x1c, 0x0b, 0xc6, 0xc9, 0x94, 0xbe, 0x92, 0x4e, 0xa2, 0x2f, 0x60, 0xf3, 0x21,
-	0x4d, 0xf4, 0xfd, 0xfe, 0x25, 0x97, 0xbe, 0xe3, 0x13, 0xc6, 0xfd, 0xfe, 0xd1, 0x07, 0x6e, 0x4a,
-	0x80, 0xbf, 0x87, 0xba, 0xfe, 0x3f, 0x1f, 0x93, 0x30, 0xa6, 0xe8, 0x21, 0x6c, 0xc9, 0x85, 0xfe,
-	0x2f, 0x8f, 0x34, 0xf3, 0x7f, 0xec, 0x9e, 0xe2, 0xc0, 0xef, 0xc2, 0xb5, 0xaf, 0x82, 0xc8, 0x8c,
-	0x94, 0x1e, 0xe1, 0x3d, 0xd8, 0xfa, 0x3a, 0x55, 0xa1, 0x6e, 0x9b, 0x0a, 0x30, 0x86, 0xfa, 0x90,
-	0x8a, 0x13, 0x32, 0xd7, 0xfd, 0x42, 0x70, 0x25, 0x0d, 0x34, 0x48, 0xae, 0xf1, 0x01, 0x34, 0x52,
-	0xba, 0x74, 0x7d, 0x29, 0x57, 0x0b, 0xf6, 0x86, 0x54, 0x3c, 0x36, 0x1a, 0x1b, 0x53, 0x35, 0xcd,
-	0x78, 0x08, 0x37, 0x4a, 0xf9, 0x51, 0x10, 0x09, 0xc6, 0x93, 0x4c, 0x5b, 0xc7, 0x0b, 0x2f, 0x8c,
-	0x7d, 0x7a, 0xca, 0xe9, 0xd3, 0x80, 0xc5, 0xea, 0xa9, 0x36, 0xdd, 0x72, 0x1a, 0x0f, 0xe1, 0xfa,
-	0x1a, 0x16, 0xd4, 0x83, 0x6d, 0xbd, 0x6c, 0x5b, 0x37, 0x37, 0x0f, 0x6b, 0xfd, 0x96, 0x93, 0x59,
-	0x50, 0x1e, 0xef, 0x1a, 0x18, 0x3e, 0x81, 0x7a, 0x7e, 0x03, 0xb5, 0xa0, 0x32, 0xa3, 0xc1, 0x74,
-	0x26, 0x64, 0xe5, 0x2b, 0xae, 0x8e, 0xd0, 0x01, 0x6c, 0x8e, 0xa9, 0x68, 0x6f, 0x48, 0xd6, 0x3d,
-	0x67, 0x65, 0x1f, 0xd9, 0x69, 0x37, 0x05, 0xe0, 0x03, 0x29, 0xaf, 0x53, 0xce, 0x96, 0x2c, 0x22,
-	0x61, 0xd6, 0x49, 0x29, 0x05, 0xf9, 0xa0, 0xae, 0x5c, 0xe3, 0x1e, 0xa0, 0xb4, 0x93, 0x06, 0xa8,
-	0xbb, 0x69, 0x43, 0x55, 0x65, 0xa8, 0x2f, 0xd1, 0x55, 0x37, 0x8b, 0xf1, 0x23, 0x68, 0x18, 0xb4,
-	0x56, 0xc0, 0x1a, 0x5e, 0x74, 0x0b, 0x2a, 0x03, 0x12, 0x86, 0x4c, 0xc8, 0xc1, 0xac, 0xf5, 0x9b,
-	0x8e, 0x71, 0x33, 0x95, 0x76, 0xf5, 0x36, 0x6e, 0xc2, 0xae, 0x54, 0x08, 0xd1, 0x53, 0x81, 0x29,
-	0x6c, 0xc9, 0x08, 0xdd, 0x86, 0xab, 0x66, 0x5e, 0x52, 0x5f, 0x7a, 0xc0, 0x7c, 0xaa, 0x9b, 0x71,
-	0x2e, 0x9f, 0x7a, 0x5c, 0x3e, 0xc7, 0x62, 0x21, 0xe1, 0x1b, 0x12, 0xbe, 0x6e, 0x0b, 0xdf, 0x92,
-	0x75, 0xa5, 0xfb, 0xa9, 0x3b, 0xb7, 0xa0, 0x32, 0x2a, 0x74, 0x5c, 0x45, 0xfd, 0x3f, 0x2a, 0x7a,
-	0xb4, 0x50, 0x1f, 0x2a, 0xca, 0x81, 0xd1, 0xeb, 0xab, 0xe7, 0xcc, 0x79, 0xb2, 0x7d, 0x2d, 0x4d,
-	0x3b, 0xaa, 0x2b, 0x1a, 0x79, 0x04, 0xb0, 0xb2, 0x52, 0xf4, 0xe6, 0xea, 0x5c, 0xc9, 0x60, 0xed,
-	0xba, 0x93, 0x7e, 0x15, 0x0c, 0xf0, 0x01, 0xd4, 0x72, 0xee, 0x88, 0xec, 0xc2, 0xb9, 0x82, 0x69,
-	0xda, 0xed, 0xd5, 0x5e, 0xc9, 0x99, 0x3e, 0x93, 0xb5, 0xb5, 0xa8, 0x4b, 0xb5, 0xf3, 0x96, 0x64,
-	0xb7, 0xf2, 0xd7, 0xc9, 0x59, 0xc0, 0x27, 0x50, 0xcf, 0xab, 0x16, 0xdd, 0x58, 0xe1, 0xce, 0xa9,
-	0xb9, 0x78, 0x81, 0x9e, 0x85, 0xba, 0xb0, 0xad, 0x75, 0x8c, 0x5a, 0x85, 0xd2, 0x99, 0xb4, 0xed,
-	0xba, 0xa3, 0x3e, 0x8b, 0x9f, 0x2f, 0x04, 0x4f, 0xd0, 0x11, 0xec, 0x64, 0xa2, 0x46, 0xed, 0x62,
-	0xa9, 0x95, 0xd2, 0x8b, 0x87, 0x7a, 0x16, 0x3a, 0x96, 0x16, 0x5b, 0x10, 0x4f, 0xa7, 0x50, 0xef,
-	0x9c, 0xfc, 0xed, 0x0b, 0xd4, 0x88, 0x7e, 0x80, 0xd6, 0x7a, 0x5b, 0x40, 0xef, 0x5c, 0xc8, 0x98,
-	0x37, 0x0e, 0xfb, 0xed, 0xf5, 0xc4, 0x86, 0xe5, 0x63, 0xf9, 0xaa, 0x46, 0x3d, 0xa5, 0x57, 0x2d,
-	0x68, 0xd5, 0x2e, 0xeb, 0x05, 0x1d, 0xc3, 0x6e, 0x41, 0xa8, 0xe8, 0xad, 0x62, 0x87, 0x8a, 0x0a,
-	0xce, 0x4f, 0x45, 0x51, 0xad, 0x3d, 0x0b, 0xdd, 0x83, 0xaa, 0x91, 0x1c, 0x7a, 0xa3, 0x34, 0x15,
-	0x46, 0x86, 0x76, 0xb3, 0x38, 0xe2, 0x11, 0xfa, 0x08, 0x1a, 0x46, 0x30, 0x23, 0x4a, 0x7c, 0xca,
-	0x4b, 0x67, 0x57, 0x52, 0xb2, 0x77, 0x1d, 0xf5, 0xdb, 0x47, 0xe1, 0x06, 0x9f, 0xfe, 0xf5, 0xa2,
-	0x63, 0xfd, 0xfd, 0xa2, 0x63, 0xfd, 0xf9, 0xb2, 0x63, 0x3d, 0x7b, 0xd9, 0xb1, 0xbe, 0xbb, 0x7d,
-	0xf9, 0x47, 0x84, 0x2f, 0xbd, 0xae, 0xa1, 0x9e, 0x54, 0xe4, 0xcf, 0x9f, 0xf7, 0xff, 0x09, 0x00,
-	0x00, 0xff, 0xff, 0xac, 0x34, 0xcd, 0x8f, 0xc5, 0x09, 0x00, 0x00,
+	// 1331 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x5f, 0x6f, 0xd4, 0x46,
+	0x10, 0xaf, 0x09, 0xf9, 0x73, 0x73, 0x97, 0xbb, 0xb0, 0x49, 0x53, 0xd7, 0x94, 0x80, 0xac, 0x16,
+	0x22, 0x1a, 0x7c, 0xd7, 0x94, 0x94, 0x8a, 0x22, 0x55, 0x1c, 0x25, 0x17, 0xa0, 0x41, 0xa9, 0x8f,
+	0x3f, 0x52, 0x2b, 0x55, 0xda, 0xb3, 0x97, 0x3b, 0x0b, 0x9f, 0xf7, 0xba, 0x5e, 0x03, 0xf7, 0x35,
+	0xfa, 0x5d, 0xfa, 0xd6, 0x87, 0x56, 0xea, 0x03, 0x1f, 0xa1, 0xe2, 0x01, 0x55, 0xf0, 0x45, 0xaa,
+	0x5d, 0xaf, 0xed, 0xb5, 0x73, 0x39, 0x89, 0xa2, 0xbe, 0x58, 0x3b, 0xb3, 0xbf, 0x9d, 0xd9, 0x9d,
+	0x9d, 0x99, 0xdf, 0x1a, 0x9a, 0x6c, 0xe2, 0xfd, 0x92, 0x10, 0x36, 0x75, 0x26, 0x8c, 0x72, 0x8a,
+	0x56, 0x32, 0xd9, 0xba, 0x32, 0x0c, 0xf8, 0x28, 0x19, 0x38, 0x1e, 0x1d, 0xb7, 0x87, 0x74, 0x48,
+	0xdb, 0x12, 0x30, 0x48, 0x9e, 0x48, 0x49, 0x0a, 0x72, 0x94, 0x2e, 0xb4, 0xae, 0x69, 0x70, 0x4e,
+	0x22, 0x9f, 0xb0, 0x71, 0x10, 0x71, 0x7d, 0x88, 0x07, 0x5e, 0xd0, 0xe6, 0xd3, 0x09, 0x89, 0xd3,
+	0xaf, 0x5a, 0x58, 0x8f, 0xf0, 0x38, 0x17, 0x6a, 0xd8, 0x1b, 0xab, 0x61, 0xeb, 0x19, 0x0e, 0x03,
+	0x1f, 0x73, 0xca, 0xb2, 0x39, 0x36, 0xf1, 0xd4, 0x70, 0x75, 0x82, 0xa7, 0x21, 0xc5, 0xbe, 0x12,
+	0x9b, 0x71, 0x88, 0xe3, 0x51, 0x10, 0x0d, 0x95, 0xbc, 0xe6, 0x93, 0x90, 0x0c, 0x31, 0x0f, 0x68,
+	0x94, 0x6a, 0xec, 0x00, 0xea, 0x7d, 0x8e, 0x79, 0x12, 0x1f, 0x61, 0x86, 0xc7, 0x68, 0x1b, 0x5a,
+	0xdd, 0x90, 0x7a, 0x4f, 0x1f, 0x04, 0x63, 0xf2, 0x38, 0xe0, 0xa3, 0x20, 0x32, 0x8d, 0x0b, 0xc6,
+	0x76, 0xcd, 0xad, 0xaa, 0x51, 0x07, 0xd6, 0xa5, 0xaa, 0x4f, 0x48, 0xa4, 0xa1, 0x4f, 0x49, 0xf4,
+	0xac, 0x29, 0x7b, 0x0a, 0xad, 0x1e, 0xe1, 0x37, 0x3d, 0x8f, 0x26, 0x11, 0x4f, 0xdd, 0xdd, 0x87,
+	0xe5, 0x9b, 0xbe, 0xcf, 0x48, 0x1c, 0x4b, 0x37, 0x8d, 0xee, 0xd5, 0x97, 0xaf, 0xcf, 0x7f, 0xf0,
+	0xea, 0xf5, 0xf9, 0x1d, 0x2d, 0x68, 0xa3, 0xe9, 0x84, 0xb0, 0x90, 0xf8, 0x43, 0xc2, 0xda, 0x83,
+	0x84, 0x31, 0xfa, 0xbc, 0xed, 0xb1, 0xe9, 0x84, 0x53, 0x47, 0xad, 0x75, 0x33, 0x23, 0x68, 0x13,
+	0x96, 0x0e, 0x48, 0x30, 0x1c, 0x71, 0xb9, 0x8f, 0xd3, 0xae, 0x92, 0xec, 0xdf, 0x0c, 0x58, 0xeb,
+	0x11, 0x7e, 0x48, 0x38, 0xf6, 0x31, 0xc7, 0xa9, 0xf3, 0xbb, 0x55, 0xe7, 0x9d, 0xff, 0xee, 0xf8,
+	0x21, 0x34, 0x32, 0xe3, 0x07, 0x38, 0x1e, 0x49, 0xf7, 0x8d, 0xee, 0x17, 0xaf, 0x5e, 0x9f, 0xbf,
+	0x32, 0xdf, 0xe0, 0x20, 0x88, 0x30, 0x9b, 0x3a, 0x07, 0xe4, 0x45, 0x77, 0xca, 0x49, 0xec, 0x96,
+	0xcc, 0xd8, 0x3b, 0xd0, 0xcc, 0x64, 0x97, 0xc4, 0x49, 0xc8, 0x91, 0x05, 0x2b, 0x99, 0x46, 0xdd,
+	0x4c, 0x2e, 0xdb, 0x7f, 0x1a, 0x32, 0xc2, 0x7d, 0x4e, 0x19, 0x1e, 0x92, 0xff, 0x27, 0xc2, 0xfb,
+	0xb0, 0x70, 0x8f, 0x4c, 0xcd, 0x53, 0xef, 0x62, 0x4b, 0x9d, 0xf1, 0x31, 0x65, 0xfe, 0xee, 0xde,
+	0x57, 0xae, 0x30, 0xa0, 0xdd, 0xd4, 0x42, 0xe9, 0xa6, 0x7e, 0x82, 0x86, 0xda, 0xff, 0x23, 0x1c,
+	0x26, 0x04, 0xdd, 0x83, 0x45, 0x39, 0x50, 0xbb, 0xdf, 0x53, 0x1e, 0xdf, 0x31, 0xaa, 0xa9, 0x0d,
+	0xfb, 0x26, 0x9c, 0xf9, 0x3e, 0x88, 0xb3, 0x14, 0x54, 0x29, 0xbf, 0x01, 0x8b, 0x3f, 0x88, 0xba,
+	0x56, 0xe1, 0x4c, 0x85, 0x13, 0x33, 0xe9, 0x3a, 0x34, 0x7a, 0x84, 0xdf, 0xc7, 0x63, 0x15, 0x5f,
+	0x04, 0xa7, 0x85, 0xa0, 0x16, 0xcb, 0xf1, 0x89, 0x6b, 0x2f, 0x42, 0x53, 0xb8, 0x17, 0x98, 0x79,
+	0xbe, 0x6d, 0x07, 0x36, 0x7a, 0x84, 0x3f, 0xca, 0xaa, 0xbc, 0x4f, 0x54, 0xb5, 0x14, 0x76, 0x8d,
+	0x92, 0xdd, 0x1e, 0x9c, 0xad, 0xe0, 0x0f, 0x82, 0x98, 0x53, 0x36, 0xcd, 0x6b, 0xfa, 0x4e, 0xe4,
+	0x85, 0x89, 0x4f, 0x8e, 0x18, 0x79, 0x16, 0xd0, 0x24, 0x4d, 0x85, 0x05, 0xb7, 0xaa, 0xb6, 0x7b,
+	0xb0, 0x3e, 0xc3, 0x0a, 0xea, 0xc0, 0xb2, 0x1a, 0x9a, 0xc6, 0x85, 0x85, 0xed, 0xfa, 0xee, 0xa6,
+	0x93, 0x37, 0x47, 0x1d, 0xef, 0x66, 0x30, 0xfb, 0x3e, 0x34, 0xf4, 0x09, 0xb1, 0xf3, 0x51, 0x69,
+	0xe7, 0xa9, 0x84, 0x2e, 0xc2, 0x42, 0x9f, 0x88, 0x30, 0x09, 0xab, 0x1b, 0x4e, 0xd1, 0xd8, 0xf2,
+	0xd5, 0xae, 0x00, 0xd8, 0x17, 0x65, 0xf9, 0x1e, 0x31, 0x3a, 0xa1, 0x31, 0x0e, 0xf3, 0xc8, 0xcb,
+	0x52, 0x93, 0x89, 0xe1, 0xca, 0xb1, 0xdd, 0x01, 0x24, 0x22, 0x9c, 0x01, 0x55, 0x94, 0x2d, 0x58,
+	0x49, 0x35, 0xc4, 0x97, 0xe8, 0x15, 0x37, 0x97, 0xed, 0x43, 0x68, 0x66, 0x68, 0x55, 0x61, 0x33,
+	0xec, 0xa2, 0x4b, 0xb0, 0xd4, 0xc5, 0x61, 0x48, 0xd3, 0x1b, 0xad, 0xef, 0xb6, 0x9c, 0xac, 0xcf,
+	0xa6, 0x6a, 0x57, 0x4d, 0xdb, 0xdb, 0xb0, 0x26, 0x36, 0xd0, 0x17, 0x6d, 0x77, 0xfe, 0x25, 0x7f,
+	0x0e, 0xeb, 0x02, 0xf9, 0x30, 0x1a, 0xd0, 0xc8, 0x0f, 0xa2, 0xe1, 0x5c, 0xf0, 0xef, 0x06, 0xa0,
+	0x1e, 0xe1, 0xdf, 0xe5, 0xdd, 0x3b, 0x05, 0xbb, 0x50, 0xcb, 0x03, 0xf5, 0x5e, 0xe5, 0x5d, 0x98,
+	0x11, 0x36, 0x95, 0x1b, 0xca, 0xcc, 0x53, 0xef, 0x63, 0x33, 0x37, 0x63, 0xef, 0xc0, 0x86, 0x38,
+	0x6b, 0xb1, 0xfd, 0xb9, 0x87, 0x6d, 0xc1, 0xaa, 0xec, 0x62, 0x58, 0x55, 0xa8, 0x4d, 0x60, 0x51,
+	0x4a, 0xe8, 0x32, 0xac, 0x65, 0xb5, 0x2b, 0x38, 0xe5, 0x16, 0xf5, 0x89, 0x4a, 0xa8, 0x63, 0x7a,
+	0xc1, 0x4f, 0xba, 0x8e, 0x26, 0x5c, 0xc2, 0xd3, 0x8a, 0x9c, 0x35, 0x65, 0xaf, 0x41, 0xb3, 0xa7,
+	0x4a, 0x4d, 0x39, 0xfe, 0x6b, 0x01, 0x96, 0x52, 0x19, 0xed, 0xc0, 0x99, 0x2c, 0x4f, 0x1e, 0x8c,
+	0x18, 0x89, 0x47, 0x34, 0xf4, 0x95, 0xef, 0xe3, 0x13, 0xe8, 0x02, 0xd4, 0x7b, 0x38, 0xee, 0x7b,
+	0x23, 0xe2, 0x27, 0x21, 0x51, 0xa4, 0xa8, 0xab, 0x44, 0x4e, 0x1e, 0x60, 0xe6, 0x3f, 0xa1, 0xec,
+	0xa9, 0xec, 0x80, 0x35, 0x37, 0x97, 0xd1, 0xa7, 0xb0, 0x2a, 0x13, 0x68, 0x9f, 0x61, 0x4f, 0x44,
+	0xcb, 0x3c, 0x2d, 0x01, 0x65, 0x25, 0xda, 0x02, 0xb8, 0x8b, 0x83, 0x50, 0x32, 0x6d, 0x6c, 0x2e,
+	0xca, 0xad, 0x68, 0x1a, 0x51, 0xf6, 0x79, 0x72, 0x29, 0xd0, 0x92, 0x04, 0x55, 0xd5, 0x62, 0xb7,
+	0x72, 0xe4, 0x92, 0xe7, 0x98, 0xf9, 0xe6, 0xb2, 0x44, 0xe9, 0x2a, 0xb1, 0xa3, 0x43, 0xfc, 0x22,
+	0x4f, 0x92, 0xd8, 0x5c, 0x91, 0x98, 0xb2, 0x12, 0x99, 0xb0, 0x7c, 0x18, 0x44, 0x5d, 0x1a, 0xf9,
+	0x66, 0x4d, 0xce, 0x67, 0xa2, 0xb8, 0xb8, 0x43, 0xfc, 0xe2, 0x88, 0x3e, 0x27, 0x2c, 0x3f, 0x14,
+	0xc8, 0x43, 0x1d, 0xd3, 0x8b, 0x8b, 0xd3, 0xcd, 0xde, 0x1a, 0xe1, 0x68, 0x48, 0x62, 0xb3, 0x9e,
+	0x5e, 0xdc, 0x8c, 0x29, 0x11, 0x89, 0x23, 0x3c, 0xa5, 0x09, 0xdf, 0x27, 0x24, 0x36, 0x1b, 0xb2,
+	0xc2, 0x35, 0x8d, 0x7d, 0x49, 0x26, 0x94, 0x3c, 0xcf, 0xdc, 0x46, 0xba, 0xfb, 0x6b, 0x4d, 0x25,
+	0x24, 0xda, 0x85, 0xa5, 0xf4, 0x59, 0x84, 0x3e, 0x2c, 0x7a, 0x9d, 0xf6, 0x50, 0xb2, 0xce, 0x08,
+	0xb5, 0x93, 0xb6, 0x0c, 0x85, 0xdc, 0x03, 0x28, 0xde, 0x37, 0xe8, 0xe3, 0x62, 0x5d, 0xe5, 0xd5,
+	0x63, 0x35, 0x1c, 0xf1, 0x98, 0xcb, 0x80, 0xb7, 0xa0, 0xae, 0x3d, 0x4d, 0x90, 0x55, 0x5a, 0x57,
+	0x7a, 0xb1, 0x58, 0x66, 0x31, 0x57, 0x79, 0x16, 0x7c, 0x2b, 0x7d, 0x2b, 0xe6, 0xac, 0xf8, 0xd6,
+	0xdf, 0x03, 0xd6, 0xa6, 0x7e, 0x1c, 0x8d, 0x67, 0xbf, 0x81, 0x86, 0x4e, 0x8d, 0xe8, 0x6c, 0x81,
+	0x3b, 0x46, 0x99, 0xe5, 0x03, 0x74, 0x0c, 0xd4, 0x86, 0x65, 0x45, 0x8a, 0x68, 0xb3, 0xe4, 0x3a,
+	0xe7, 0x49, 0xab, 0xe1, 0xa4, 0xaf, 0xd9, 0xdb, 0x11, 0x67, 0x53, 0xb4, 0x07, 0xb5, 0x9c, 0x09,
+	0x91, 0x59, 0x76, 0x55, 0xd0, 0x63, 0x79, 0x51, 0xc7, 0x40, 0x77, 0xe4, 0xfb, 0xa6, 0xc4, 0x2c,
+	0x5b, 0x25, 0x7f, 0xc7, 0x38, 0xd3, 0x3a, 0x81, 0xaa, 0xd0, 0xcf, 0xb0, 0x39, 0x9b, 0x33, 0xd1,
+	0x67, 0x27, 0x5a, 0xd4, 0x59, 0xd5, 0x3a, 0x37, 0xdb, 0x70, 0x66, 0xe5, 0xba, 0xbc, 0xd5, 0xac,
+	0x33, 0x54, 0x6e, 0xb5, 0x44, 0x64, 0x56, 0x95, 0x4c, 0xd0, 0x1d, 0x58, 0x2d, 0xb1, 0x18, 0xfa,
+	0xa4, 0x1c, 0xa1, 0x32, 0xbd, 0xe9, 0x59, 0x51, 0xa6, 0xb2, 0x8e, 0x81, 0x6e, 0x40, 0x5d, 0xe3,
+	0x23, 0x7d, 0x1b, 0x55, 0x9a, 0xb2, 0x5a, 0x4e, 0xfe, 0xb3, 0x20, 0xf5, 0x1d, 0x03, 0xed, 0xa7,
+	0x0f, 0x96, 0x82, 0xa3, 0xd0, 0xb9, 0xb2, 0x81, 0x0a, 0x7b, 0x59, 0xeb, 0x85, 0x8d, 0x7c, 0xaa,
+	0x63, 0xa0, 0xdb, 0xb2, 0x00, 0x8b, 0xf6, 0xaf, 0x1f, 0xe8, 0x38, 0xad, 0x59, 0x9b, 0x8e, 0xf6,
+	0x9b, 0xa2, 0xad, 0xba, 0x07, 0xad, 0x0a, 0x8d, 0xe8, 0xd7, 0x3f, 0x8b, 0x61, 0x4e, 0x32, 0xd5,
+	0x31, 0xd0, 0x55, 0x58, 0xc9, 0x58, 0x06, 0x7d, 0x54, 0xa9, 0x97, 0x8c, 0x79, 0xac, 0x56, 0xb9,
+	0xf8, 0x63, 0x74, 0x0d, 0x6a, 0x39, 0x47, 0xe8, 0x89, 0x5b, 0x26, 0x0e, 0x6b, 0x4d, 0xbb, 0x92,
+	0x14, 0xfb, 0x35, 0x34, 0xb3, 0x1e, 0x74, 0x40, 0xb0, 0x4f, 0x58, 0xc5, 0x69, 0xd1, 0x9d, 0xac,
+	0x55, 0x27, 0xfd, 0x0b, 0x4c, 0x71, 0xdd, 0x1b, 0x7f, 0xbf, 0xd9, 0x32, 0xfe, 0x79, 0xb3, 0x65,
+	0xfc, 0xf1, 0x76, 0xcb, 0x78, 0xf9, 0x76, 0xcb, 0xf8, 0xf1, 0xf2, 0x7c, 0x1e, 0x66, 0x13, 0xaf,
+	0x9d, 0x99, 0x1e, 0x2c, 0xc9, 0xdf, 0xbc, 0x2f, 0xff, 0x1d, 0x00, 0x7f, 0x03, 0x10, 0xc2, 0xcf,
+	0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -953,7 +1363,12 @@ type QueryClient interface {
 	GetValidatorSetHistory(ctx context.Context, in *GetValidatorSetHistoryParam, opts ...grpc.CallOption) (*ValidatorSetHistory, error)
 	GetProposal(ctx context.Context, in *GetProposalParam, opts ...grpc.CallOption) (*payload.Ballot, error)
 	ListProposals(ctx context.Context, in *ListProposalsParam, opts ...grpc.CallOption) (Query_ListProposalsClient, error)
+	ListSlashes(ctx context.Context, in *ListSlashesParam, opts ...grpc.CallOption) (Query_ListSlashesClient, error)
+	ListUnbondings(ctx context.Context, in *ListUnbondingsParam, opts ...grpc.CallOption) (Query_ListUnbondingsClient, error)
+	GetDelegation(ctx context.Context, in *GetDelegationParam, opts ...grpc.CallOption) (*delegation.Delegation, error)
+	ListDelegations(ctx context.Context, in *ListDelegationsParam, opts ...grpc.CallOption) (Query_ListDelegationsClient, error)
 	GetStats(ctx context.Context, in *GetStatsParam, opts ...grpc.CallOption) (*Stats, error)
+	GetParams(ctx context.Context, in *GetParamsParam, opts ...grpc.CallOption) (*Params, error)
 	GetBlockHeader(ctx context.Context, in *GetBlockParam, opts ...grpc.CallOption) (*types.Header, error)
 }
 
@@ -1133,6 +1548,111 @@ func (x *queryListProposalsClient) Recv() (*ProposalResult, error) {
 	return m, nil
 }
 
+func (c *queryClient) ListSlashes(ctx context.Context, in *ListSlashesParam, opts ...grpc.CallOption) (Query_ListSlashesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Query_serviceDesc.Streams[3], "/rpcquery.Query/ListSlashes", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryListSlashesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_ListSlashesClient interface {
+	Recv() (*slashing.Slash, error)
+	grpc.ClientStream
+}
+
+type queryListSlashesClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryListSlashesClient) Recv() (*slashing.Slash, error) {
+	m := new(slashing.Slash)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *queryClient) ListUnbondings(ctx context.Context, in *ListUnbondingsParam, opts ...grpc.CallOption) (Query_ListUnbondingsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Query_serviceDesc.Streams[4], "/rpcquery.Query/ListUnbondings", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryListUnbondingsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_ListUnbondingsClient interface {
+	Recv() (*slashing.Unbonding, error)
+	grpc.ClientStream
+}
+
+type queryListUnbondingsClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryListUnbondingsClient) Recv() (*slashing.Unbonding, error) {
+	m := new(slashing.Unbonding)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *queryClient) GetDelegation(ctx context.Context, in *GetDelegationParam, opts ...grpc.CallOption) (*delegation.Delegation, error) {
+	out := new(delegation.Delegation)
+	err := c.cc.Invoke(ctx, "/rpcquery.Query/GetDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListDelegations(ctx context.Context, in *ListDelegationsParam, opts ...grpc.CallOption) (Query_ListDelegationsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Query_serviceDesc.Streams[5], "/rpcquery.Query/ListDelegations", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryListDelegationsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_ListDelegationsClient interface {
+	Recv() (*delegation.Delegation, error)
+	grpc.ClientStream
+}
+
+type queryListDelegationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryListDelegationsClient) Recv() (*delegation.Delegation, error) {
+	m := new(delegation.Delegation)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *queryClient) GetStats(ctx context.Context, in *GetStatsParam, opts ...grpc.CallOption) (*Stats, error) {
 	out := new(Stats)
 	err := c.cc.Invoke(ctx, "/rpcquery.Query/GetStats", in, out, opts...)
@@ -1142,6 +1662,15 @@ func (c *queryClient) GetStats(ctx context.Context, in *GetStatsParam, opts ...g
 	return out, nil
 }
 
+func (c *queryClient) GetParams(ctx context.Context, in *GetParamsParam, opts ...grpc.CallOption) (*Params, error) {
+	out := new(Params)
+	err := c.cc.Invoke(ctx, "/rpcquery.Query/GetParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) GetBlockHeader(ctx context.Context, in *GetBlockParam, opts ...grpc.CallOption) (*types.Header, error) {
 	out := new(types.Header)
 	err := c.cc.Invoke(ctx, "/rpcquery.Query/GetBlockHeader", in, out, opts...)
@@ -1164,7 +1693,12 @@ type QueryServer interface {
 	GetValidatorSetHistory(context.Context, *GetValidatorSetHistoryParam) (*ValidatorSetHistory, error)
 	GetProposal(context.Context, *GetProposalParam) (*payload.Ballot, error)
 	ListProposals(*ListProposalsParam, Query_ListProposalsServer) error
+	ListSlashes(*ListSlashesParam, Query_ListSlashesServer) error
+	ListUnbondings(*ListUnbondingsParam, Query_ListUnbondingsServer) error
+	GetDelegation(context.Context, *GetDelegationParam) (*delegation.Delegation, error)
+	ListDelegations(*ListDelegationsParam, Query_ListDelegationsServer) error
 	GetStats(context.Context, *GetStatsParam) (*Stats, error)
+	GetParams(context.Context, *GetParamsParam) (*Params, error)
 	GetBlockHeader(context.Context, *GetBlockParam) (*types.Header, error)
 }
 
@@ -1379,6 +1913,87 @@ func (x *queryListProposalsServer) Send(m *ProposalResult) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Query_ListSlashes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListSlashesParam)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).ListSlashes(m, &queryListSlashesServer{stream})
+}
+
+type Query_ListSlashesServer interface {
+	Send(*slashing.Slash) error
+	grpc.ServerStream
+}
+
+type queryListSlashesServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryListSlashesServer) Send(m *slashing.Slash) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Query_ListUnbondings_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListUnbondingsParam)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).ListUnbondings(m, &queryListUnbondingsServer{stream})
+}
+
+type Query_ListUnbondingsServer interface {
+	Send(*slashing.Unbonding) error
+	grpc.ServerStream
+}
+
+type queryListUnbondingsServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryListUnbondingsServer) Send(m *slashing.Unbonding) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Query_GetDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDelegationParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcquery.Query/GetDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetDelegation(ctx, req.(*GetDelegationParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListDelegations_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListDelegationsParam)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).ListDelegations(m, &queryListDelegationsServer{stream})
+}
+
+type Query_ListDelegationsServer interface {
+	Send(*delegation.Delegation) error
+	grpc.ServerStream
+}
+
+type queryListDelegationsServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryListDelegationsServer) Send(m *delegation.Delegation) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Query_GetStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetStatsParam)
 	if err := dec(in); err != nil {
@@ -1397,6 +2012,24 @@ func _Query_GetStats_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetParamsParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcquery.Query/GetParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetParams(ctx, req.(*GetParamsParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_GetBlockHeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetBlockParam)
 	if err := dec(in); err != nil {
@@ -1451,10 +2084,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetProposal",
 			Handler:    _Query_GetProposal_Handler,
 		},
+		{
+			MethodName: "GetDelegation",
+			Handler:    _Query_GetDelegation_Handler,
+		},
 		{
 			MethodName: "GetStats",
 			Handler:    _Query_GetStats_Handler,
 		},
+		{
+			MethodName: "GetParams",
+			Handler:    _Query_GetParams_Handler,
+		},
 		{
 			MethodName: "GetBlockHeader",
 			Handler:    _Query_GetBlockHeader_Handler,
@@ -1476,6 +2117,21 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Query_ListProposals_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ListSlashes",
+			Handler:       _Query_ListSlashes_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListUnbondings",
+			Handler:       _Query_ListUnbondings_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListDelegations",
+			Handler:       _Query_ListDelegations_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "rpcquery.proto",
 }
@@ -1508,6 +2164,9 @@ func (m *GetAccountParam) Size() (n int) {
 	_ = l
 	l = m.Address.Size()
 	n += 1 + l + sovRpcquery(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovRpcquery(uint64(m.Height))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1560,6 +2219,9 @@ func (m *GetStorageParam) Size() (n int) {
 	n += 1 + l + sovRpcquery(uint64(l))
 	l = m.Key.Size()
 	n += 1 + l + sovRpcquery(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovRpcquery(uint64(m.Height))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1590,6 +2252,9 @@ func (m *ListAccountsParam) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRpcquery(uint64(l))
 	}
+	if m.Height != 0 {
+		n += 1 + sovRpcquery(uint64(m.Height))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1606,6 +2271,9 @@ func (m *GetNameParam) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRpcquery(uint64(l))
 	}
+	if m.Height != 0 {
+		n += 1 + sovRpcquery(uint64(m.Height))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1634,6 +2302,9 @@ func (m *GetValidatorSetParam) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.Height != 0 {
+		n += 1 + sovRpcquery(uint64(m.Height))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1745,6 +2416,70 @@ func (m *ProposalResult) Size() (n int) {
 	return n
 }
 
+func (m *ListSlashesParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListUnbondingsParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetDelegationParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Validator.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	l = m.Delegator.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListDelegationsParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *GetStatsParam) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1775,6 +2510,70 @@ func (m *Stats) Size() (n int) {
 	return n
 }
 
+func (m *GetParamsParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalThreshold != 0 {
+		n += 1 + sovRpcquery(uint64(m.ProposalThreshold))
+	}
+	l = len(m.GasSchedule)
+	if l > 0 {
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	l = len(m.Hardfork)
+	if l > 0 {
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	l = len(m.SlashFraction)
+	if l > 0 {
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if m.JailBlocks != 0 {
+		n += 1 + sovRpcquery(uint64(m.JailBlocks))
+	}
+	if m.UnbondingBlocks != 0 {
+		n += 1 + sovRpcquery(uint64(m.UnbondingBlocks))
+	}
+	if m.BlockReward != 0 {
+		n += 1 + sovRpcquery(uint64(m.BlockReward))
+	}
+	if m.MaxValidators != 0 {
+		n += 1 + sovRpcquery(uint64(m.MaxValidators))
+	}
+	if m.MinBond != 0 {
+		n += 1 + sovRpcquery(uint64(m.MinBond))
+	}
+	l = len(m.MaxPowerFraction)
+	if l > 0 {
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if m.MaxValidatorChanges != 0 {
+		n += 1 + sovRpcquery(uint64(m.MaxValidatorChanges))
+	}
+	if m.PayoutFees {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *GetBlockParam) Size() (n int) {
 	if m == nil {
 		return 0
@@ -15,8 +15,11 @@ import (
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	golang_proto "github.com/golang/protobuf/proto"
 	_ "github.com/golang/protobuf/ptypes/duration"
+	github_com_hyperledger_burrow_acm "github.com/hyperledger/burrow/acm"
+	github_com_hyperledger_burrow_binary "github.com/hyperledger/burrow/binary"
 	github_com_hyperledger_burrow_crypto "github.com/hyperledger/burrow/crypto"
 	exec "github.com/hyperledger/burrow/execution/exec"
+	permission "github.com/hyperledger/burrow/permission"
 	github_com_hyperledger_burrow_txs "github.com/hyperledger/burrow/txs"
 	txs "github.com/hyperledger/burrow/txs"
 	payload "github.com/hyperledger/burrow/txs/payload"
@@ -96,6 +99,202 @@ func (*CallCodeParam) XXX_MessageName() string {
 	return "rpctransact.CallCodeParam"
 }
 
+type CallTxSimParam struct {
+	CallTx *payload.CallTx `protobuf:"bytes,1,opt,name=CallTx,proto3" json:"CallTx,omitempty"`
+	// Run against the state as of the end of the block at this height, or against the current state when zero
+	Height uint64 `protobuf:"varint,2,opt,name=Height,proto3" json:"Height,omitempty"`
+	// Changes to make to accounts (creating any that do not exist) before running the call
+	Overrides            []*AccountOverride `protobuf:"bytes,3,rep,name=Overrides,proto3" json:"Overrides,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *CallTxSimParam) Reset()         { *m = CallTxSimParam{} }
+func (m *CallTxSimParam) String() string { return proto.CompactTextString(m) }
+func (*CallTxSimParam) ProtoMessage()    {}
+func (*CallTxSimParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_039da6ebb58a8dc9, []int{1}
+}
+func (m *CallTxSimParam) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CallTxSimParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CallTxSimParam.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CallTxSimParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallTxSimParam.Merge(m, src)
+}
+func (m *CallTxSimParam) XXX_Size() int {
+	return m.Size()
+}
+func (m *CallTxSimParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallTxSimParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallTxSimParam proto.InternalMessageInfo
+
+func (m *CallTxSimParam) GetCallTx() *payload.CallTx {
+	if m != nil {
+		return m.CallTx
+	}
+	return nil
+}
+
+func (m *CallTxSimParam) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *CallTxSimParam) GetOverrides() []*AccountOverride {
+	if m != nil {
+		return m.Overrides
+	}
+	return nil
+}
+
+func (*CallTxSimParam) XXX_MessageName() string {
+	return "rpctransact.CallTxSimParam"
+}
+
+type AccountOverride struct {
+	Address github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	// Replace the balance with Balance when set
+	SetBalance bool   `protobuf:"varint,2,opt,name=SetBalance,proto3" json:"SetBalance,omitempty"`
+	Balance    uint64 `protobuf:"varint,3,opt,name=Balance,proto3" json:"Balance,omitempty"`
+	// Replace the code when non-empty
+	EVMCode  github_com_hyperledger_burrow_acm.Bytecode `protobuf:"bytes,4,opt,name=EVMCode,proto3,customtype=github.com/hyperledger/burrow/acm.Bytecode" json:"EVMCode"`
+	WASMCode github_com_hyperledger_burrow_acm.Bytecode `protobuf:"bytes,5,opt,name=WASMCode,proto3,customtype=github.com/hyperledger/burrow/acm.Bytecode" json:"WASMCode"`
+	// Storage slots to set
+	Storage []*StorageOverride `protobuf:"bytes,6,rep,name=Storage,proto3" json:"Storage,omitempty"`
+	// Replace the permissions when provided
+	Permissions          *permission.AccountPermissions `protobuf:"bytes,7,opt,name=Permissions,proto3" json:"Permissions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *AccountOverride) Reset()         { *m = AccountOverride{} }
+func (m *AccountOverride) String() string { return proto.CompactTextString(m) }
+func (*AccountOverride) ProtoMessage()    {}
+func (*AccountOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_039da6ebb58a8dc9, []int{2}
+}
+func (m *AccountOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountOverride.Merge(m, src)
+}
+func (m *AccountOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountOverride proto.InternalMessageInfo
+
+func (m *AccountOverride) GetSetBalance() bool {
+	if m != nil {
+		return m.SetBalance
+	}
+	return false
+}
+
+func (m *AccountOverride) GetBalance() uint64 {
+	if m != nil {
+		return m.Balance
+	}
+	return 0
+}
+
+func (m *AccountOverride) GetStorage() []*StorageOverride {
+	if m != nil {
+		return m.Storage
+	}
+	return nil
+}
+
+func (m *AccountOverride) GetPermissions() *permission.AccountPermissions {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+func (*AccountOverride) XXX_MessageName() string {
+	return "rpctransact.AccountOverride"
+}
+
+type StorageOverride struct {
+	Key                  github_com_hyperledger_burrow_binary.Word256  `protobuf:"bytes,1,opt,name=Key,proto3,customtype=github.com/hyperledger/burrow/binary.Word256" json:"Key"`
+	Value                github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,2,opt,name=Value,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Value"`
+	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
+	XXX_unrecognized     []byte                                        `json:"-"`
+	XXX_sizecache        int32                                         `json:"-"`
+}
+
+func (m *StorageOverride) Reset()         { *m = StorageOverride{} }
+func (m *StorageOverride) String() string { return proto.CompactTextString(m) }
+func (*StorageOverride) ProtoMessage()    {}
+func (*StorageOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_039da6ebb58a8dc9, []int{3}
+}
+func (m *StorageOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StorageOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StorageOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StorageOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageOverride.Merge(m, src)
+}
+func (m *StorageOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *StorageOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageOverride proto.InternalMessageInfo
+
+func (*StorageOverride) XXX_MessageName() string {
+	return "rpctransact.StorageOverride"
+}
+
 type TxEnvelope struct {
 	Envelope             *github_com_hyperledger_burrow_txs.Envelope `protobuf:"bytes,1,opt,name=Envelope,proto3,customtype=github.com/hyperledger/burrow/txs.Envelope" json:"Envelope,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                                    `json:"-"`
@@ -107,7 +306,7 @@ func (m *TxEnvelope) Reset()         { *m = TxEnvelope{} }
 func (m *TxEnvelope) String() string { return proto.CompactTextString(m) }
 func (*TxEnvelope) ProtoMessage()    {}
 func (*TxEnvelope) Descriptor() ([]byte, []int) {
-	return fileDescriptor_039da6ebb58a8dc9, []int{1}
+	return fileDescriptor_039da6ebb58a8dc9, []int{4}
 }
 func (m *TxEnvelope) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -158,7 +357,7 @@ func (m *TxEnvelopeParam) Reset()         { *m = TxEnvelopeParam{} }
 func (m *TxEnvelopeParam) String() string { return proto.CompactTextString(m) }
 func (*TxEnvelopeParam) ProtoMessage()    {}
 func (*TxEnvelopeParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_039da6ebb58a8dc9, []int{2}
+	return fileDescriptor_039da6ebb58a8dc9, []int{5}
 }
 func (m *TxEnvelopeParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -204,55 +403,353 @@ func (m *TxEnvelopeParam) GetTimeout() time.Duration {
 func (*TxEnvelopeParam) XXX_MessageName() string {
 	return "rpctransact.TxEnvelopeParam"
 }
+
+type GasEstimate struct {
+	// Gas used by the CallTx when run with GasLimit
+	GasUsed uint64 `protobuf:"varint,1,opt,name=GasUsed,proto3" json:"GasUsed,omitempty"`
+	// The lowest GasLimit with which the CallTx succeeds
+	GasLimit             uint64   `protobuf:"varint,2,opt,name=GasLimit,proto3" json:"GasLimit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GasEstimate) Reset()         { *m = GasEstimate{} }
+func (m *GasEstimate) String() string { return proto.CompactTextString(m) }
+func (*GasEstimate) ProtoMessage()    {}
+func (*GasEstimate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_039da6ebb58a8dc9, []int{6}
+}
+func (m *GasEstimate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasEstimate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasEstimate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasEstimate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasEstimate.Merge(m, src)
+}
+func (m *GasEstimate) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasEstimate) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasEstimate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasEstimate proto.InternalMessageInfo
+
+func (m *GasEstimate) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *GasEstimate) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (*GasEstimate) XXX_MessageName() string {
+	return "rpctransact.GasEstimate"
+}
+
+type TraceParam struct {
+	// Hash of a committed transaction to re-execute
+	TxHash github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,1,opt,name=TxHash,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"TxHash"`
+	// If no TxHash provided then this CallTx will be simulated against the current committed state
+	CallTx               *payload.CallTx `protobuf:"bytes,2,opt,name=CallTx,proto3" json:"CallTx,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *TraceParam) Reset()         { *m = TraceParam{} }
+func (m *TraceParam) String() string { return proto.CompactTextString(m) }
+func (*TraceParam) ProtoMessage()    {}
+func (*TraceParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_039da6ebb58a8dc9, []int{7}
+}
+func (m *TraceParam) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TraceParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TraceParam.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TraceParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TraceParam.Merge(m, src)
+}
+func (m *TraceParam) XXX_Size() int {
+	return m.Size()
+}
+func (m *TraceParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_TraceParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TraceParam proto.InternalMessageInfo
+
+func (m *TraceParam) GetCallTx() *payload.CallTx {
+	if m != nil {
+		return m.CallTx
+	}
+	return nil
+}
+
+func (*TraceParam) XXX_MessageName() string {
+	return "rpctransact.TraceParam"
+}
+
+// The state of the EVM immediately before an opcode is executed
+type StructLog struct {
+	// Depth of the call stack, the top-level call has depth 1
+	Depth uint64 `protobuf:"varint,1,opt,name=Depth,proto3" json:"Depth,omitempty"`
+	// Account whose code is being executed
+	Address github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,2,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	PC      uint64                                       `protobuf:"varint,3,opt,name=PC,proto3" json:"PC,omitempty"`
+	Op      string                                       `protobuf:"bytes,4,opt,name=Op,proto3" json:"Op,omitempty"`
+	// Gas remaining before the opcode is executed
+	Gas uint64 `protobuf:"varint,5,opt,name=Gas,proto3" json:"Gas,omitempty"`
+	// Data stack from bottom to top
+	Stack []github_com_hyperledger_burrow_binary.Word256 `protobuf:"bytes,6,rep,name=Stack,proto3,customtype=github.com/hyperledger/burrow/binary.Word256" json:"Stack"`
+	// Memory up to the highest offset accessed so far in this call frame
+	Memory github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,7,opt,name=Memory,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Memory"`
+	// The storage write this opcode is about to make, only set for SSTORE
+	StorageDelta         *StorageDelta `protobuf:"bytes,8,opt,name=StorageDelta,proto3" json:"StorageDelta,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *StructLog) Reset()         { *m = StructLog{} }
+func (m *StructLog) String() string { return proto.CompactTextString(m) }
+func (*StructLog) ProtoMessage()    {}
+func (*StructLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_039da6ebb58a8dc9, []int{8}
+}
+func (m *StructLog) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StructLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StructLog.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StructLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StructLog.Merge(m, src)
+}
+func (m *StructLog) XXX_Size() int {
+	return m.Size()
+}
+func (m *StructLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_StructLog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StructLog proto.InternalMessageInfo
+
+func (m *StructLog) GetDepth() uint64 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
+func (m *StructLog) GetPC() uint64 {
+	if m != nil {
+		return m.PC
+	}
+	return 0
+}
+
+func (m *StructLog) GetOp() string {
+	if m != nil {
+		return m.Op
+	}
+	return ""
+}
+
+func (m *StructLog) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+func (m *StructLog) GetStorageDelta() *StorageDelta {
+	if m != nil {
+		return m.StorageDelta
+	}
+	return nil
+}
+
+func (*StructLog) XXX_MessageName() string {
+	return "rpctransact.StructLog"
+}
+
+type StorageDelta struct {
+	Key                  github_com_hyperledger_burrow_binary.Word256 `protobuf:"bytes,1,opt,name=Key,proto3,customtype=github.com/hyperledger/burrow/binary.Word256" json:"Key"`
+	Value                github_com_hyperledger_burrow_binary.Word256 `protobuf:"bytes,2,opt,name=Value,proto3,customtype=github.com/hyperledger/burrow/binary.Word256" json:"Value"`
+	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
+	XXX_unrecognized     []byte                                       `json:"-"`
+	XXX_sizecache        int32                                        `json:"-"`
+}
+
+func (m *StorageDelta) Reset()         { *m = StorageDelta{} }
+func (m *StorageDelta) String() string { return proto.CompactTextString(m) }
+func (*StorageDelta) ProtoMessage()    {}
+func (*StorageDelta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_039da6ebb58a8dc9, []int{9}
+}
+func (m *StorageDelta) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StorageDelta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StorageDelta.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StorageDelta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageDelta.Merge(m, src)
+}
+func (m *StorageDelta) XXX_Size() int {
+	return m.Size()
+}
+func (m *StorageDelta) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageDelta.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageDelta proto.InternalMessageInfo
+
+func (*StorageDelta) XXX_MessageName() string {
+	return "rpctransact.StorageDelta"
+}
 func init() {
 	proto.RegisterType((*CallCodeParam)(nil), "rpctransact.CallCodeParam")
 	golang_proto.RegisterType((*CallCodeParam)(nil), "rpctransact.CallCodeParam")
+	proto.RegisterType((*CallTxSimParam)(nil), "rpctransact.CallTxSimParam")
+	golang_proto.RegisterType((*CallTxSimParam)(nil), "rpctransact.CallTxSimParam")
+	proto.RegisterType((*AccountOverride)(nil), "rpctransact.AccountOverride")
+	golang_proto.RegisterType((*AccountOverride)(nil), "rpctransact.AccountOverride")
+	proto.RegisterType((*StorageOverride)(nil), "rpctransact.StorageOverride")
+	golang_proto.RegisterType((*StorageOverride)(nil), "rpctransact.StorageOverride")
 	proto.RegisterType((*TxEnvelope)(nil), "rpctransact.TxEnvelope")
 	golang_proto.RegisterType((*TxEnvelope)(nil), "rpctransact.TxEnvelope")
 	proto.RegisterType((*TxEnvelopeParam)(nil), "rpctransact.TxEnvelopeParam")
 	golang_proto.RegisterType((*TxEnvelopeParam)(nil), "rpctransact.TxEnvelopeParam")
+	proto.RegisterType((*GasEstimate)(nil), "rpctransact.GasEstimate")
+	golang_proto.RegisterType((*GasEstimate)(nil), "rpctransact.GasEstimate")
+	proto.RegisterType((*TraceParam)(nil), "rpctransact.TraceParam")
+	golang_proto.RegisterType((*TraceParam)(nil), "rpctransact.TraceParam")
+	proto.RegisterType((*StructLog)(nil), "rpctransact.StructLog")
+	golang_proto.RegisterType((*StructLog)(nil), "rpctransact.StructLog")
+	proto.RegisterType((*StorageDelta)(nil), "rpctransact.StorageDelta")
+	golang_proto.RegisterType((*StorageDelta)(nil), "rpctransact.StorageDelta")
 }
 
 func init() { proto.RegisterFile("rpctransact.proto", fileDescriptor_039da6ebb58a8dc9) }
 func init() { golang_proto.RegisterFile("rpctransact.proto", fileDescriptor_039da6ebb58a8dc9) }
 
 var fileDescriptor_039da6ebb58a8dc9 = []byte{
-	// 555 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0xc5, 0x7c, 0xf4, 0x63, 0xdc, 0x2a, 0x74, 0x2f, 0x84, 0x08, 0x39, 0x28, 0x07, 0x84, 0x50,
-	0xbb, 0x8e, 0x42, 0x8f, 0x7c, 0x28, 0x4e, 0xdb, 0x23, 0xaa, 0x1c, 0x0b, 0x09, 0x6e, 0x1b, 0x7b,
-	0x71, 0x2d, 0xd9, 0x5e, 0x6b, 0xbd, 0x06, 0xe7, 0x57, 0x70, 0xe1, 0xc0, 0xcf, 0xe1, 0x98, 0x23,
-	0x12, 0xb7, 0x1e, 0x02, 0x4a, 0xff, 0x08, 0xf2, 0xae, 0xdd, 0xda, 0x49, 0xd3, 0x72, 0xe1, 0x36,
-	0xfb, 0x66, 0xdf, 0xdb, 0x79, 0x33, 0xb3, 0xb0, 0xc7, 0x13, 0x57, 0x70, 0x12, 0xa7, 0xc4, 0x15,
-	0x38, 0xe1, 0x4c, 0x30, 0xa4, 0xd7, 0xa0, 0xce, 0x81, 0x1f, 0x88, 0xb3, 0x6c, 0x82, 0x5d, 0x16,
-	0x99, 0x3e, 0xf3, 0x99, 0x29, 0xef, 0x4c, 0xb2, 0x4f, 0xf2, 0x24, 0x0f, 0x32, 0x52, 0xdc, 0x8e,
-	0xe1, 0x33, 0xe6, 0x87, 0xf4, 0xea, 0x96, 0x97, 0x71, 0x22, 0x02, 0x16, 0x97, 0x79, 0xa0, 0x39,
-	0x75, 0xcb, 0x78, 0x37, 0x21, 0xd3, 0x90, 0x11, 0xaf, 0x3c, 0x6e, 0x8b, 0x3c, 0x55, 0x61, 0xef,
-	0xab, 0x06, 0xbb, 0x23, 0x12, 0x86, 0x23, 0xe6, 0xd1, 0x53, 0xc2, 0x49, 0x84, 0xde, 0x83, 0x7e,
-	0xc2, 0x59, 0x34, 0xf4, 0x3c, 0x4e, 0xd3, 0xb4, 0xad, 0x3d, 0xd5, 0x9e, 0xef, 0x58, 0x87, 0xb3,
-	0x79, 0xf7, 0xce, 0xf9, 0xbc, 0xbb, 0x5f, 0xab, 0xf1, 0x6c, 0x9a, 0x50, 0x1e, 0x52, 0xcf, 0xa7,
-	0xdc, 0x9c, 0x64, 0x9c, 0xb3, 0x2f, 0xa6, 0xcb, 0xa7, 0x89, 0x60, 0xb8, 0xe4, 0xda, 0x75, 0x21,
-	0x84, 0xe0, 0x7e, 0xf1, 0x48, 0xfb, 0x6e, 0x21, 0x68, 0xcb, 0xb8, 0xc0, 0x8e, 0x88, 0x20, 0xed,
-	0x7b, 0x0a, 0x2b, 0xe2, 0x9e, 0x0f, 0xe0, 0xe4, 0xc7, 0xf1, 0x67, 0x1a, 0xb2, 0x84, 0xa2, 0x0f,
-	0xb0, 0x55, 0xc5, 0xb2, 0x14, 0x7d, 0xb0, 0x8b, 0x8b, 0xea, 0x2b, 0xd0, 0xc2, 0xe7, 0xf3, 0xee,
-	0x8b, 0x9b, 0xab, 0xaa, 0xdf, 0xb7, 0x2f, 0xe5, 0x7a, 0xbf, 0x34, 0x68, 0x5d, 0xbd, 0xa4, 0xcc,
-	0xff, 0xbf, 0xe7, 0xd0, 0x33, 0xd8, 0x3c, 0x55, 0x53, 0x90, 0x2d, 0xd0, 0x07, 0x3b, 0xb8, 0x9a,
-	0xca, 0x30, 0x9e, 0xda, 0x55, 0x12, 0xbd, 0x86, 0x4d, 0x27, 0x88, 0x28, 0xcb, 0x84, 0x6c, 0x8b,
-	0x3e, 0x78, 0x8c, 0xd5, 0xa4, 0x71, 0x35, 0x69, 0x7c, 0x54, 0x4e, 0xda, 0xda, 0x2a, 0xc6, 0xf2,
-	0xfd, 0x77, 0x57, 0xb3, 0x2b, 0xce, 0xe0, 0xdb, 0x03, 0xd8, 0x72, 0xca, 0x95, 0x42, 0x16, 0xb4,
-	0x2c, 0xce, 0x88, 0xe7, 0x92, 0x54, 0x38, 0xf9, 0x78, 0x1a, 0xbb, 0xe8, 0x09, 0xae, 0xaf, 0xe1,
-	0x92, 0xff, 0xce, 0x1e, 0x96, 0x5b, 0xe3, 0xe4, 0xc7, 0x39, 0x75, 0xb3, 0xe2, 0x0d, 0xf4, 0x06,
-	0x1e, 0xd6, 0x34, 0x86, 0xe9, 0xed, 0x22, 0x3b, 0xb2, 0x65, 0x36, 0x75, 0x69, 0x90, 0x08, 0xf4,
-	0x16, 0x36, 0xc6, 0x81, 0x1f, 0x3b, 0xf9, 0x2d, 0xac, 0x47, 0x6b, 0xb2, 0xe8, 0x10, 0xf4, 0x13,
-	0xc6, 0xa3, 0x2c, 0x24, 0x82, 0x3a, 0x39, 0x6a, 0xb4, 0x6d, 0x3d, 0xab, 0x0f, 0x50, 0xec, 0x75,
-	0xe9, 0xba, 0x75, 0x49, 0x52, 0xe0, 0x75, 0x46, 0xf7, 0x41, 0x57, 0x49, 0xe5, 0x71, 0x85, 0xd2,
-	0xb4, 0x65, 0xc2, 0x76, 0xa9, 0x1f, 0x44, 0xff, 0x24, 0xff, 0x4a, 0xc9, 0x17, 0x7b, 0x5f, 0x50,
-	0x3a, 0x8d, 0xc2, 0x1b, 0x5f, 0xf0, 0x3a, 0x76, 0x1f, 0x60, 0x4c, 0x63, 0x6f, 0xc5, 0x8e, 0x02,
-	0xd7, 0xd8, 0x51, 0xc9, 0x65, 0x3b, 0x25, 0xa5, 0x69, 0xa7, 0x0f, 0xf0, 0x8e, 0x44, 0x74, 0x45,
-	0x5f, 0x81, 0x6b, 0xf4, 0x55, 0x72, 0x59, 0xbf, 0xa4, 0x34, 0xf4, 0xad, 0xd1, 0x6c, 0x61, 0x68,
-	0x3f, 0x17, 0x86, 0xf6, 0x67, 0x61, 0x68, 0x3f, 0x2e, 0x0c, 0x6d, 0x76, 0x61, 0x68, 0x1f, 0x0f,
-	0x6e, 0xfe, 0x49, 0x3c, 0x71, 0xcd, 0x5a, 0x97, 0x26, 0x1b, 0xf2, 0x07, 0xbc, 0xfc, 0x1b, 0x00,
-	0x00, 0xff, 0xff, 0x70, 0x12, 0x34, 0xbe, 0x4a, 0x05, 0x00, 0x00,
+	// 1051 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4d, 0x6f, 0xdc, 0x44,
+	0x18, 0xc6, 0xbb, 0xc9, 0xee, 0xe6, 0x75, 0xda, 0x24, 0x23, 0x54, 0xcc, 0x82, 0x36, 0xd5, 0x1e,
+	0xa0, 0x42, 0xad, 0x37, 0x5a, 0xda, 0x0a, 0x21, 0x0a, 0xec, 0x6e, 0xbe, 0xd4, 0xe6, 0x4b, 0x5e,
+	0x93, 0x0a, 0x6e, 0x13, 0x7b, 0x70, 0x2c, 0xd6, 0x3b, 0xd6, 0x78, 0x5c, 0xbc, 0x77, 0xae, 0x88,
+	0x2b, 0x67, 0x0e, 0xfc, 0x01, 0xfe, 0x00, 0xc7, 0x1c, 0x41, 0xdc, 0x38, 0x14, 0x94, 0xfe, 0x0e,
+	0x24, 0xe4, 0x99, 0xb1, 0x63, 0x6f, 0x36, 0x4d, 0x49, 0xc3, 0x6d, 0xde, 0xaf, 0xc7, 0xef, 0xc7,
+	0xbc, 0x8f, 0x07, 0x56, 0x58, 0xe8, 0x70, 0x86, 0xc7, 0x11, 0x76, 0xb8, 0x19, 0x32, 0xca, 0x29,
+	0xd2, 0x0b, 0xaa, 0xe6, 0x3d, 0xcf, 0xe7, 0xc7, 0xf1, 0x91, 0xe9, 0xd0, 0xa0, 0xe3, 0x51, 0x8f,
+	0x76, 0x84, 0xcf, 0x51, 0xfc, 0xb5, 0x90, 0x84, 0x20, 0x4e, 0x32, 0xb6, 0xd9, 0xf2, 0x28, 0xf5,
+	0x46, 0xe4, 0xcc, 0xcb, 0x8d, 0x19, 0xe6, 0x3e, 0x1d, 0x2b, 0x3b, 0x90, 0x84, 0x38, 0xea, 0x7c,
+	0x23, 0xc4, 0x93, 0x11, 0xc5, 0xae, 0x12, 0x97, 0x43, 0xc2, 0x02, 0x3f, 0x8a, 0xce, 0x9c, 0x17,
+	0x78, 0x12, 0xc9, 0x63, 0xfb, 0x07, 0x0d, 0x6e, 0x0c, 0xf0, 0x68, 0x34, 0xa0, 0x2e, 0x39, 0xc0,
+	0x0c, 0x07, 0xe8, 0x10, 0xf4, 0x4d, 0x46, 0x83, 0x9e, 0xeb, 0x32, 0x12, 0x45, 0x86, 0x76, 0x5b,
+	0xbb, 0xb3, 0xd8, 0xbf, 0x7f, 0xf2, 0x7c, 0xf5, 0x8d, 0x3f, 0x9f, 0xaf, 0xde, 0x2d, 0x64, 0x7d,
+	0x3c, 0x09, 0x09, 0x1b, 0x11, 0xd7, 0x23, 0xac, 0x73, 0x14, 0x33, 0x46, 0xbf, 0xed, 0x38, 0x6c,
+	0x12, 0x72, 0x6a, 0xaa, 0x58, 0xab, 0x08, 0x84, 0x10, 0xcc, 0xa5, 0x1f, 0x31, 0x2a, 0x29, 0xa0,
+	0x25, 0xce, 0xa9, 0x6e, 0x1d, 0x73, 0x6c, 0x54, 0xa5, 0x2e, 0x3d, 0xb7, 0xbf, 0xd7, 0xe0, 0x66,
+	0x9a, 0x91, 0x9d, 0x0c, 0xfd, 0x40, 0xa6, 0xf4, 0x3e, 0xd4, 0xa4, 0x46, 0x64, 0xa3, 0x77, 0x97,
+	0xcc, 0xac, 0x42, 0xa9, 0xb6, 0x94, 0x19, 0xdd, 0x82, 0xda, 0x36, 0xf1, 0xbd, 0x63, 0x2e, 0xbe,
+	0x32, 0x67, 0x29, 0x09, 0x7d, 0x0c, 0x0b, 0xfb, 0xcf, 0x08, 0x63, 0xbe, 0x4b, 0x22, 0xa3, 0x7a,
+	0xbb, 0x7a, 0x47, 0xef, 0xbe, 0x6b, 0x16, 0x07, 0xd4, 0x73, 0x1c, 0x1a, 0x8f, 0x79, 0xe6, 0x64,
+	0x9d, 0xb9, 0xb7, 0x7f, 0xa9, 0xc2, 0xd2, 0x94, 0x19, 0xed, 0x41, 0xfd, 0x3a, 0xfa, 0x93, 0x81,
+	0xa0, 0x16, 0xc0, 0x90, 0xf0, 0x3e, 0x1e, 0xe1, 0xb1, 0x23, 0x3b, 0xd4, 0xb0, 0x0a, 0x1a, 0x64,
+	0x40, 0x3d, 0x33, 0x56, 0x45, 0x61, 0x99, 0x88, 0x76, 0xa0, 0xbe, 0x71, 0xb8, 0x2b, 0x1a, 0x3b,
+	0x27, 0x32, 0xe9, 0xaa, 0x4c, 0x3e, 0x78, 0x79, 0x26, 0xd8, 0x09, 0xcc, 0xfe, 0x84, 0x13, 0x87,
+	0xba, 0xc4, 0xca, 0x20, 0xd0, 0x1e, 0x34, 0x9e, 0xf6, 0x86, 0x12, 0x6e, 0xfe, 0xca, 0x70, 0x39,
+	0x06, 0x7a, 0x08, 0xf5, 0x21, 0xa7, 0x0c, 0x7b, 0xc4, 0xa8, 0xcd, 0xe8, 0xba, 0xb2, 0xe5, 0x5d,
+	0xcf, 0x9c, 0xd1, 0xe7, 0xa0, 0x1f, 0xe4, 0x97, 0x36, 0x32, 0xea, 0x62, 0xea, 0x2d, 0xb3, 0x70,
+	0x91, 0xd5, 0x44, 0x0a, 0x5e, 0x56, 0x31, 0xa4, 0xfd, 0xb3, 0x06, 0x4b, 0x53, 0xf0, 0x68, 0x13,
+	0xaa, 0x4f, 0xc8, 0xe4, 0xbf, 0x4d, 0xec, 0xc8, 0x1f, 0x63, 0x36, 0x31, 0x9f, 0x52, 0xe6, 0x76,
+	0x1f, 0x3c, 0xb4, 0x52, 0x00, 0xf4, 0x04, 0xe6, 0x0f, 0xf1, 0x28, 0x56, 0x57, 0xb9, 0xff, 0x40,
+	0x21, 0xdd, 0x7b, 0x25, 0xa4, 0x6d, 0x92, 0xa4, 0x8d, 0x8a, 0x2c, 0x89, 0xd1, 0xf6, 0x00, 0xec,
+	0x64, 0x63, 0xfc, 0x8c, 0x8c, 0x68, 0x48, 0xd0, 0x97, 0xd0, 0xc8, 0xce, 0xea, 0xae, 0xdf, 0x30,
+	0xd3, 0x65, 0xcd, 0x94, 0x7d, 0xf3, 0xf2, 0x59, 0x14, 0xfd, 0xad, 0x1c, 0xae, 0xfd, 0x87, 0x06,
+	0x4b, 0x67, 0x5f, 0x92, 0x8b, 0xf5, 0xff, 0x7d, 0x0e, 0xbd, 0x07, 0xf5, 0x03, 0xb9, 0xa4, 0xa2,
+	0x4d, 0x7a, 0x77, 0x31, 0x5f, 0xda, 0xde, 0x78, 0x62, 0x65, 0x46, 0xf4, 0x08, 0xea, 0xb6, 0x1f,
+	0x10, 0x1a, 0x73, 0x71, 0xb5, 0xf5, 0xee, 0xdb, 0xa6, 0xa4, 0x3a, 0x33, 0xa3, 0x3a, 0x73, 0x5d,
+	0x51, 0x5d, 0xbf, 0x91, 0x76, 0xfa, 0xc7, 0xbf, 0x56, 0x35, 0x2b, 0x8b, 0x69, 0x0f, 0x40, 0xdf,
+	0xc2, 0xd1, 0x46, 0xc4, 0xfd, 0x00, 0x73, 0xb1, 0x28, 0x5b, 0x38, 0xfa, 0x22, 0x22, 0xae, 0xa8,
+	0x67, 0xce, 0xca, 0x44, 0xd4, 0x84, 0xc6, 0x16, 0x8e, 0x76, 0xfc, 0xc0, 0xcf, 0xc8, 0x21, 0x97,
+	0xdb, 0xdf, 0x69, 0x00, 0x36, 0xc3, 0x8e, 0xea, 0xca, 0x2e, 0xd4, 0xec, 0x64, 0x1b, 0x47, 0xc7,
+	0x86, 0xf6, 0x3a, 0x03, 0x56, 0x20, 0x05, 0xf6, 0xaa, 0xbc, 0x94, 0xbd, 0xda, 0xff, 0x54, 0x60,
+	0x61, 0xc8, 0x59, 0xec, 0xf0, 0x1d, 0xea, 0xa1, 0x37, 0x61, 0x7e, 0x9d, 0x84, 0xfc, 0x58, 0x15,
+	0x22, 0x85, 0x22, 0xf3, 0x54, 0xae, 0x83, 0x79, 0x6e, 0x42, 0xe5, 0x60, 0xa0, 0x48, 0xa5, 0x72,
+	0x30, 0x48, 0xe5, 0xfd, 0x50, 0x50, 0xc9, 0x82, 0x55, 0xd9, 0x0f, 0xd1, 0x32, 0x54, 0xb7, 0x70,
+	0x24, 0xc8, 0x60, 0xce, 0x4a, 0x8f, 0xe8, 0x31, 0xcc, 0x0f, 0x39, 0x76, 0xbe, 0x11, 0x1b, 0x7d,
+	0xd5, 0x3d, 0x92, 0x10, 0x69, 0xa7, 0x77, 0x49, 0x40, 0xd9, 0xc4, 0xa8, 0xbf, 0x56, 0xa7, 0x25,
+	0x08, 0x7a, 0x04, 0x8b, 0x6a, 0xe7, 0xd7, 0xc9, 0x88, 0x63, 0xa3, 0xa1, 0x2e, 0xd4, 0x0c, 0xce,
+	0x11, 0x0e, 0x56, 0xc9, 0xbd, 0xfd, 0x93, 0x56, 0x8e, 0xbf, 0x36, 0xc2, 0x78, 0x5c, 0x26, 0x8c,
+	0x2b, 0xb6, 0x4c, 0x40, 0x74, 0x7f, 0xaf, 0x41, 0xc3, 0x56, 0xc5, 0xa0, 0x3e, 0x2c, 0xf5, 0x19,
+	0xc5, 0xae, 0x83, 0x23, 0x6e, 0x27, 0xc3, 0xc9, 0xd8, 0x41, 0x65, 0x86, 0x9d, 0x5a, 0xf8, 0xe6,
+	0x8a, 0x29, 0xde, 0x09, 0x76, 0xb2, 0x91, 0x10, 0x27, 0x4e, 0x97, 0x0a, 0x7d, 0x0a, 0xcb, 0x05,
+	0x8c, 0x5e, 0x74, 0x39, 0xc8, 0xa2, 0xe0, 0x08, 0x8b, 0x38, 0xc4, 0x0f, 0x39, 0xfa, 0x0c, 0x6a,
+	0x43, 0xdf, 0x1b, 0xdb, 0xc9, 0x25, 0x51, 0x6f, 0x5d, 0x60, 0x45, 0xf7, 0x41, 0xdf, 0xa4, 0x2c,
+	0x88, 0x47, 0x98, 0x13, 0x3b, 0x41, 0x25, 0x9e, 0xb8, 0x38, 0x6a, 0x0d, 0x40, 0xbd, 0x12, 0xd2,
+	0x84, 0xa7, 0x77, 0x6a, 0x56, 0xa1, 0x77, 0x41, 0x97, 0xc6, 0x5e, 0x34, 0x33, 0xa4, 0x5c, 0x56,
+	0x07, 0x16, 0xf2, 0x57, 0xc8, 0x2b, 0xc1, 0x7f, 0x22, 0xe1, 0xd3, 0xff, 0x5e, 0x1a, 0xd2, 0x2c,
+	0x25, 0x5e, 0x7a, 0x62, 0xcd, 0x8a, 0x1e, 0xc0, 0x4a, 0xfe, 0xb9, 0xfc, 0x87, 0xf5, 0xce, 0x39,
+	0x8c, 0xb3, 0x47, 0xd1, 0xec, 0x14, 0xea, 0x82, 0xc6, 0xec, 0x04, 0x4d, 0xf5, 0x2d, 0x27, 0xb7,
+	0xe6, 0xad, 0xa9, 0x6d, 0x50, 0x74, 0xb3, 0xa6, 0xa1, 0x8f, 0x40, 0xcf, 0x78, 0x34, 0xdd, 0xf3,
+	0x73, 0x35, 0x1b, 0xa5, 0xc8, 0x22, 0xeb, 0xae, 0xa5, 0xcf, 0x97, 0xb1, 0x7b, 0x6e, 0x16, 0x52,
+	0x79, 0xc1, 0x2c, 0xa4, 0x71, 0x7a, 0x16, 0x2a, 0xa4, 0x3c, 0x8b, 0x35, 0x80, 0x3d, 0x1c, 0x90,
+	0x73, 0xf8, 0x52, 0x79, 0x01, 0xbe, 0x34, 0x4e, 0xe3, 0xab, 0x90, 0x12, 0x7e, 0x7f, 0x70, 0x72,
+	0xda, 0xd2, 0x7e, 0x3b, 0x6d, 0x69, 0x7f, 0x9f, 0xb6, 0xb4, 0x5f, 0x5f, 0xb4, 0xb4, 0x93, 0x17,
+	0x2d, 0xed, 0xab, 0x4b, 0x48, 0x88, 0x85, 0x4e, 0xa7, 0xd0, 0x90, 0xa3, 0x9a, 0xf8, 0x5f, 0x7d,
+	0xf8, 0xef, 0x00, 0x21, 0x6d, 0x86, 0xe8, 0xf9, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -285,6 +782,15 @@ type TransactClient interface {
 	CallTxSim(ctx context.Context, in *payload.CallTx, opts ...grpc.CallOption) (*exec.TxExecution, error)
 	// Perform a 'simulated' execution of provided code against the current committed EVM state without any changes been saved
 	CallCodeSim(ctx context.Context, in *CallCodeParam, opts ...grpc.CallOption) (*exec.TxExecution, error)
+	// Perform a 'simulated' call of a contract against the committed EVM state at a given height (or the current state)
+	// with the given changes made to accounts first, without any changes been saved
+	CallTxSimOverride(ctx context.Context, in *CallTxSimParam, opts ...grpc.CallOption) (*exec.TxExecution, error)
+	// Re-execute a committed transaction (or simulate a CallTx against committed state) with EVM tracing enabled and
+	// stream a StructLog for each opcode executed without any changes been saved
+	TraceTx(ctx context.Context, in *TraceParam, opts ...grpc.CallOption) (Transact_TraceTxClient, error)
+	// Find the lowest GasLimit with which a CallTx succeeds against the current committed EVM state by binary search
+	// without any changes been saved. If the CallTx sets a GasLimit it is used as the upper bound of the search.
+	EstimateGas(ctx context.Context, in *payload.CallTx, opts ...grpc.CallOption) (*GasEstimate, error)
 	// Formulate a SendTx transaction signed server-side and wait for it to be included in a block, retrieving response
 	SendTxSync(ctx context.Context, in *payload.SendTx, opts ...grpc.CallOption) (*exec.TxExecution, error)
 	// Formulate and  SendTx transaction signed server-side
@@ -375,32 +881,82 @@ func (c *transactClient) CallCodeSim(ctx context.Context, in *CallCodeParam, opt
 	return out, nil
 }
 
-func (c *transactClient) SendTxSync(ctx context.Context, in *payload.SendTx, opts ...grpc.CallOption) (*exec.TxExecution, error) {
+func (c *transactClient) CallTxSimOverride(ctx context.Context, in *CallTxSimParam, opts ...grpc.CallOption) (*exec.TxExecution, error) {
 	out := new(exec.TxExecution)
-	err := c.cc.Invoke(ctx, "/rpctransact.Transact/SendTxSync", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/rpctransact.Transact/CallTxSimOverride", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *transactClient) SendTxAsync(ctx context.Context, in *payload.SendTx, opts ...grpc.CallOption) (*txs.Receipt, error) {
-	out := new(txs.Receipt)
-	err := c.cc.Invoke(ctx, "/rpctransact.Transact/SendTxAsync", in, out, opts...)
+func (c *transactClient) TraceTx(ctx context.Context, in *TraceParam, opts ...grpc.CallOption) (Transact_TraceTxClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Transact_serviceDesc.Streams[0], "/rpctransact.Transact/TraceTx", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-func (c *transactClient) NameTxSync(ctx context.Context, in *payload.NameTx, opts ...grpc.CallOption) (*exec.TxExecution, error) {
-	out := new(exec.TxExecution)
-	err := c.cc.Invoke(ctx, "/rpctransact.Transact/NameTxSync", in, out, opts...)
-	if err != nil {
+	x := &transactTraceTxClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Transact_TraceTxClient interface {
+	Recv() (*StructLog, error)
+	grpc.ClientStream
+}
+
+type transactTraceTxClient struct {
+	grpc.ClientStream
+}
+
+func (x *transactTraceTxClient) Recv() (*StructLog, error) {
+	m := new(StructLog)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *transactClient) EstimateGas(ctx context.Context, in *payload.CallTx, opts ...grpc.CallOption) (*GasEstimate, error) {
+	out := new(GasEstimate)
+	err := c.cc.Invoke(ctx, "/rpctransact.Transact/EstimateGas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactClient) SendTxSync(ctx context.Context, in *payload.SendTx, opts ...grpc.CallOption) (*exec.TxExecution, error) {
+	out := new(exec.TxExecution)
+	err := c.cc.Invoke(ctx, "/rpctransact.Transact/SendTxSync", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactClient) SendTxAsync(ctx context.Context, in *payload.SendTx, opts ...grpc.CallOption) (*txs.Receipt, error) {
+	out := new(txs.Receipt)
+	err := c.cc.Invoke(ctx, "/rpctransact.Transact/SendTxAsync", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactClient) NameTxSync(ctx context.Context, in *payload.NameTx, opts ...grpc.CallOption) (*exec.TxExecution, error) {
+	out := new(exec.TxExecution)
+	err := c.cc.Invoke(ctx, "/rpctransact.Transact/NameTxSync", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
 func (c *transactClient) NameTxAsync(ctx context.Context, in *payload.NameTx, opts ...grpc.CallOption) (*txs.Receipt, error) {
 	out := new(txs.Receipt)
@@ -431,6 +987,15 @@ type TransactServer interface {
 	CallTxSim(context.Context, *payload.CallTx) (*exec.TxExecution, error)
 	// Perform a 'simulated' execution of provided code against the current committed EVM state without any changes been saved
 	CallCodeSim(context.Context, *CallCodeParam) (*exec.TxExecution, error)
+	// Perform a 'simulated' call of a contract against the committed EVM state at a given height (or the current state)
+	// with the given changes made to accounts first, without any changes been saved
+	CallTxSimOverride(context.Context, *CallTxSimParam) (*exec.TxExecution, error)
+	// Re-execute a committed transaction (or simulate a CallTx against committed state) with EVM tracing enabled and
+	// stream a StructLog for each opcode executed without any changes been saved
+	TraceTx(*TraceParam, Transact_TraceTxServer) error
+	// Find the lowest GasLimit with which a CallTx succeeds against the current committed EVM state by binary search
+	// without any changes been saved. If the CallTx sets a GasLimit it is used as the upper bound of the search.
+	EstimateGas(context.Context, *payload.CallTx) (*GasEstimate, error)
 	// Formulate a SendTx transaction signed server-side and wait for it to be included in a block, retrieving response
 	SendTxSync(context.Context, *payload.SendTx) (*exec.TxExecution, error)
 	// Formulate and  SendTx transaction signed server-side
@@ -589,6 +1154,63 @@ func _Transact_CallCodeSim_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Transact_CallTxSimOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CallTxSimParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactServer).CallTxSimOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpctransact.Transact/CallTxSimOverride",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactServer).CallTxSimOverride(ctx, req.(*CallTxSimParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transact_TraceTx_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TraceParam)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TransactServer).TraceTx(m, &transactTraceTxServer{stream})
+}
+
+type Transact_TraceTxServer interface {
+	Send(*StructLog) error
+	grpc.ServerStream
+}
+
+type transactTraceTxServer struct {
+	grpc.ServerStream
+}
+
+func (x *transactTraceTxServer) Send(m *StructLog) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Transact_EstimateGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(payload.CallTx)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactServer).EstimateGas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpctransact.Transact/EstimateGas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactServer).EstimateGas(ctx, req.(*payload.CallTx))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Transact_SendTxSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(payload.SendTx)
 	if err := dec(in); err != nil {
@@ -697,6 +1319,14 @@ var _Transact_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CallCodeSim",
 			Handler:    _Transact_CallCodeSim_Handler,
 		},
+		{
+			MethodName: "CallTxSimOverride",
+			Handler:    _Transact_CallTxSimOverride_Handler,
+		},
+		{
+			MethodName: "EstimateGas",
+			Handler:    _Transact_EstimateGas_Handler,
+		},
 		{
 			MethodName: "SendTxSync",
 			Handler:    _Transact_SendTxSync_Handler,
@@ -714,7 +1344,13 @@ var _Transact_serviceDesc = grpc.ServiceDesc{
 			Handler:    _Transact_NameTxAsync_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "TraceTx",
+			Handler:       _Transact_TraceTx_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "rpctransact.proto",
 }
 
@@ -759,6 +1395,173 @@ func (m *CallCodeParam) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *CallTxSimParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CallTxSimParam) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.CallTx != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRpctransact(dAtA, i, uint64(m.CallTx.Size()))
+		n2, err := m.CallTx.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if m.Height != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintRpctransact(dAtA, i, uint64(m.Height))
+	}
+	if len(m.Overrides) > 0 {
+		for _, msg := range m.Overrides {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintRpctransact(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *AccountOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountOverride) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintRpctransact(dAtA, i, uint64(m.Address.Size()))
+	n3, err := m.Address.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n3
+	if m.SetBalance {
+		dAtA[i] = 0x10
+		i++
+		if m.SetBalance {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Balance != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintRpctransact(dAtA, i, uint64(m.Balance))
+	}
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintRpctransact(dAtA, i, uint64(m.EVMCode.Size()))
+	n4, err := m.EVMCode.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n4
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintRpctransact(dAtA, i, uint64(m.WASMCode.Size()))
+	n5, err := m.WASMCode.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n5
+	if len(m.Storage) > 0 {
+		for _, msg := range m.Storage {
+			dAtA[i] = 0x32
+			i++
+			i = encodeVarintRpctransact(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Permissions != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintRpctransact(dAtA, i, uint64(m.Permissions.Size()))
+		n6, err := m.Permissions.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *StorageOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StorageOverride) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintRpctransact(dAtA, i, uint64(m.Key.Size()))
+	n7, err := m.Key.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n7
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintRpctransact(dAtA, i, uint64(m.Value.Size()))
+	n8, err := m.Value.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n8
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func (m *TxEnvelope) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -778,11 +1581,11 @@ func (m *TxEnvelope) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRpctransact(dAtA, i, uint64(m.Envelope.Size()))
-		n2, err := m.Envelope.MarshalTo(dAtA[i:])
+		n9, err := m.Envelope.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n2
+		i += n9
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -809,90 +1612,352 @@ func (m *TxEnvelopeParam) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRpctransact(dAtA, i, uint64(m.Envelope.Size()))
-		n3, err := m.Envelope.MarshalTo(dAtA[i:])
+		n10, err := m.Envelope.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n3
+		i += n10
 	}
 	if m.Payload != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintRpctransact(dAtA, i, uint64(m.Payload.Size()))
-		n4, err := m.Payload.MarshalTo(dAtA[i:])
+		n11, err := m.Payload.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n4
+		i += n11
 	}
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintRpctransact(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdDuration(m.Timeout)))
-	n5, err := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Timeout, dAtA[i:])
+	n12, err := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Timeout, dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n5
+	i += n12
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func encodeVarintRpctransact(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *GasEstimate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func (m *CallCodeParam) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *GasEstimate) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = m.FromAddress.Size()
-	n += 1 + l + sovRpctransact(uint64(l))
-	l = len(m.Code)
-	if l > 0 {
-		n += 1 + l + sovRpctransact(uint64(l))
+	if m.GasUsed != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRpctransact(dAtA, i, uint64(m.GasUsed))
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovRpctransact(uint64(l))
+	if m.GasLimit != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintRpctransact(dAtA, i, uint64(m.GasLimit))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *TxEnvelope) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Envelope != nil {
-		l = m.Envelope.Size()
-		n += 1 + l + sovRpctransact(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *TraceParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *TxEnvelopeParam) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TraceParam) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Envelope != nil {
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintRpctransact(dAtA, i, uint64(m.TxHash.Size()))
+	n13, err := m.TxHash.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n13
+	if m.CallTx != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRpctransact(dAtA, i, uint64(m.CallTx.Size()))
+		n14, err := m.CallTx.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *StructLog) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StructLog) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Depth != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRpctransact(dAtA, i, uint64(m.Depth))
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintRpctransact(dAtA, i, uint64(m.Address.Size()))
+	n15, err := m.Address.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n15
+	if m.PC != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintRpctransact(dAtA, i, uint64(m.PC))
+	}
+	if len(m.Op) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintRpctransact(dAtA, i, uint64(len(m.Op)))
+		i += copy(dAtA[i:], m.Op)
+	}
+	if m.Gas != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintRpctransact(dAtA, i, uint64(m.Gas))
+	}
+	if len(m.Stack) > 0 {
+		for _, msg := range m.Stack {
+			dAtA[i] = 0x32
+			i++
+			i = encodeVarintRpctransact(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	dAtA[i] = 0x3a
+	i++
+	i = encodeVarintRpctransact(dAtA, i, uint64(m.Memory.Size()))
+	n16, err := m.Memory.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n16
+	if m.StorageDelta != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintRpctransact(dAtA, i, uint64(m.StorageDelta.Size()))
+		n17, err := m.StorageDelta.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n17
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *StorageDelta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StorageDelta) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintRpctransact(dAtA, i, uint64(m.Key.Size()))
+	n18, err := m.Key.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n18
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintRpctransact(dAtA, i, uint64(m.Value.Size()))
+	n19, err := m.Value.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n19
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func encodeVarintRpctransact(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *CallCodeParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FromAddress.Size()
+	n += 1 + l + sovRpctransact(uint64(l))
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovRpctransact(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovRpctransact(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CallTxSimParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CallTx != nil {
+		l = m.CallTx.Size()
+		n += 1 + l + sovRpctransact(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovRpctransact(uint64(m.Height))
+	}
+	if len(m.Overrides) > 0 {
+		for _, e := range m.Overrides {
+			l = e.Size()
+			n += 1 + l + sovRpctransact(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AccountOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Address.Size()
+	n += 1 + l + sovRpctransact(uint64(l))
+	if m.SetBalance {
+		n += 2
+	}
+	if m.Balance != 0 {
+		n += 1 + sovRpctransact(uint64(m.Balance))
+	}
+	l = m.EVMCode.Size()
+	n += 1 + l + sovRpctransact(uint64(l))
+	l = m.WASMCode.Size()
+	n += 1 + l + sovRpctransact(uint64(l))
+	if len(m.Storage) > 0 {
+		for _, e := range m.Storage {
+			l = e.Size()
+			n += 1 + l + sovRpctransact(uint64(l))
+		}
+	}
+	if m.Permissions != nil {
+		l = m.Permissions.Size()
+		n += 1 + l + sovRpctransact(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StorageOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Key.Size()
+	n += 1 + l + sovRpctransact(uint64(l))
+	l = m.Value.Size()
+	n += 1 + l + sovRpctransact(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TxEnvelope) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Envelope != nil {
+		l = m.Envelope.Size()
+		n += 1 + l + sovRpctransact(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TxEnvelopeParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Envelope != nil {
 		l = m.Envelope.Size()
 		n += 1 + l + sovRpctransact(uint64(l))
 	}
@@ -905,23 +1970,1258 @@ func (m *TxEnvelopeParam) Size() (n int) {
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
-	return n
-}
+	return n
+}
+
+func (m *GasEstimate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		n += 1 + sovRpctransact(uint64(m.GasUsed))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovRpctransact(uint64(m.GasLimit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TraceParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TxHash.Size()
+	n += 1 + l + sovRpctransact(uint64(l))
+	if m.CallTx != nil {
+		l = m.CallTx.Size()
+		n += 1 + l + sovRpctransact(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StructLog) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Depth != 0 {
+		n += 1 + sovRpctransact(uint64(m.Depth))
+	}
+	l = m.Address.Size()
+	n += 1 + l + sovRpctransact(uint64(l))
+	if m.PC != 0 {
+		n += 1 + sovRpctransact(uint64(m.PC))
+	}
+	l = len(m.Op)
+	if l > 0 {
+		n += 1 + l + sovRpctransact(uint64(l))
+	}
+	if m.Gas != 0 {
+		n += 1 + sovRpctransact(uint64(m.Gas))
+	}
+	if len(m.Stack) > 0 {
+		for _, e := range m.Stack {
+			l = e.Size()
+			n += 1 + l + sovRpctransact(uint64(l))
+		}
+	}
+	l = m.Memory.Size()
+	n += 1 + l + sovRpctransact(uint64(l))
+	if m.StorageDelta != nil {
+		l = m.StorageDelta.Size()
+		n += 1 + l + sovRpctransact(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StorageDelta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Key.Size()
+	n += 1 + l + sovRpctransact(uint64(l))
+	l = m.Value.Size()
+	n += 1 + l + sovRpctransact(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovRpctransact(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozRpctransact(x uint64) (n int) {
+	return sovRpctransact(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CallCodeParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpctransact
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CallCodeParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CallCodeParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FromAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
+			if m.Code == nil {
+				m.Code = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpctransact(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CallTxSimParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpctransact
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CallTxSimParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CallTxSimParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CallTx == nil {
+				m.CallTx = &payload.CallTx{}
+			}
+			if err := m.CallTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Overrides = append(m.Overrides, &AccountOverride{})
+			if err := m.Overrides[len(m.Overrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpctransact(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpctransact
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetBalance", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SetBalance = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			m.Balance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Balance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EVMCode", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EVMCode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WASMCode", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WASMCode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Storage = append(m.Storage, &StorageOverride{})
+			if err := m.Storage[len(m.Storage)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Permissions == nil {
+				m.Permissions = &permission.AccountPermissions{}
+			}
+			if err := m.Permissions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpctransact(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StorageOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpctransact
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StorageOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StorageOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Key.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpctransact(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxEnvelope) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpctransact
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxEnvelope: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxEnvelope: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Envelope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Envelope == nil {
+				m.Envelope = &github_com_hyperledger_burrow_txs.Envelope{}
+			}
+			if err := m.Envelope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpctransact(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxEnvelopeParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpctransact
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxEnvelopeParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxEnvelopeParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Envelope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Envelope == nil {
+				m.Envelope = &github_com_hyperledger_burrow_txs.Envelope{}
+			}
+			if err := m.Envelope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Payload == nil {
+				m.Payload = &payload.Any{}
+			}
+			if err := m.Payload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Timeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpctransact(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasEstimate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpctransact
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasEstimate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasEstimate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpctransact(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TraceParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpctransact
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TraceParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TraceParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TxHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CallTx == nil {
+				m.CallTx = &payload.CallTx{}
+			}
+			if err := m.CallTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpctransact(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
 
-func sovRpctransact(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozRpctransact(x uint64) (n int) {
-	return sovRpctransact(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *CallCodeParam) Unmarshal(dAtA []byte) error {
+func (m *StructLog) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -944,15 +3244,34 @@ func (m *CallCodeParam) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CallCodeParam: wiretype end group for non-group")
+			return fmt.Errorf("proto: StructLog: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CallCodeParam: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StructLog: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			}
+			m.Depth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Depth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -979,15 +3298,34 @@ func (m *CallCodeParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FromAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PC", wireType)
+			}
+			m.PC = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PC |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpctransact
@@ -997,29 +3335,46 @@ func (m *CallCodeParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRpctransact
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpctransact
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
-			if m.Code == nil {
-				m.Code = []byte{}
-			}
+			m.Op = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stack", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1046,68 +3401,48 @@ func (m *CallCodeParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			var v github_com_hyperledger_burrow_binary.Word256
+			m.Stack = append(m.Stack, v)
+			if err := m.Stack[len(m.Stack)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRpctransact(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthRpctransact
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRpctransact
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TxEnvelope) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRpctransact
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpctransact
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Memory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TxEnvelope: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxEnvelope: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Envelope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageDelta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1134,10 +3469,10 @@ func (m *TxEnvelope) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Envelope == nil {
-				m.Envelope = &github_com_hyperledger_burrow_txs.Envelope{}
+			if m.StorageDelta == nil {
+				m.StorageDelta = &StorageDelta{}
 			}
-			if err := m.Envelope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StorageDelta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1166,7 +3501,7 @@ func (m *TxEnvelope) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TxEnvelopeParam) Unmarshal(dAtA []byte) error {
+func (m *StorageDelta) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1189,17 +3524,17 @@ func (m *TxEnvelopeParam) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxEnvelopeParam: wiretype end group for non-group")
+			return fmt.Errorf("proto: StorageDelta: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxEnvelopeParam: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StorageDelta: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Envelope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpctransact
@@ -1209,69 +3544,30 @@ func (m *TxEnvelopeParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRpctransact
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpctransact
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Envelope == nil {
-				m.Envelope = &github_com_hyperledger_burrow_txs.Envelope{}
-			}
-			if err := m.Envelope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Key.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpctransact
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRpctransact
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpctransact
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Payload == nil {
-				m.Payload = &payload.Any{}
-			}
-			if err := m.Payload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpctransact
@@ -1281,22 +3577,22 @@ func (m *TxEnvelopeParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRpctransact
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpctransact
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Timeout, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
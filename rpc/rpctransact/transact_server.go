@@ -6,11 +6,14 @@ import (
 
 	"github.com/hyperledger/burrow/logging"
 
-	"github.com/hyperledger/burrow/acm/acmstate"
 	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/binary"
 
 	"github.com/hyperledger/burrow/execution"
+	"github.com/hyperledger/burrow/execution/contexts"
+	"github.com/hyperledger/burrow/execution/evm"
 	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/state"
 	"github.com/hyperledger/burrow/txs"
 	"github.com/hyperledger/burrow/txs/payload"
 	"golang.org/x/net/context"
@@ -20,14 +23,14 @@ import (
 const maxBroadcastSyncTimeout = time.Hour
 
 type transactServer struct {
-	state      acmstate.Reader
+	state      *state.State
 	blockchain bcm.BlockchainInfo
 	transactor *execution.Transactor
 	txCodec    txs.Codec
 	logger     *logging.Logger
 }
 
-func NewTransactServer(state acmstate.Reader, blockchain bcm.BlockchainInfo, transactor *execution.Transactor,
+func NewTransactServer(state *state.State, blockchain bcm.BlockchainInfo, transactor *execution.Transactor,
 	txCodec txs.Codec, logger *logging.Logger) TransactServer {
 	return &transactServer{
 		state:      state,
@@ -108,6 +111,68 @@ func (ts *transactServer) CallCodeSim(ctx context.Context, param *CallCodeParam)
 		ts.logger)
 }
 
+func (ts *transactServer) CallTxSimOverride(ctx context.Context, param *CallTxSimParam) (*exec.TxExecution, error) {
+	tx := param.CallTx
+	if tx == nil || tx.Input == nil || tx.Address == nil {
+		return nil, fmt.Errorf("CallTxSimOverride requires a CallTx with an input and a non-nil address from " +
+			"which to retrieve code")
+	}
+	if tx.GasLimit == 0 {
+		withGas := *tx
+		withGas.GasLimit = contexts.GasLimit
+		tx = &withGas
+	}
+	overrides := make([]*execution.AccountOverride, len(param.Overrides))
+	for i, override := range param.Overrides {
+		overrides[i] = newAccountOverride(override)
+	}
+	return execution.CallTxSim(ts.state, ts.blockchain, tx, param.Height, overrides, ts.logger)
+}
+
+func (ts *transactServer) EstimateGas(ctx context.Context, param *payload.CallTx) (*GasEstimate, error) {
+	gasUsed, gasLimit, err := execution.EstimateGas(ts.state, ts.blockchain, param, ts.logger)
+	if err != nil {
+		return nil, err
+	}
+	return &GasEstimate{
+		GasUsed:  gasUsed,
+		GasLimit: gasLimit,
+	}, nil
+}
+
+func (ts *transactServer) TraceTx(param *TraceParam, stream Transact_TraceTxServer) error {
+	var sendErr error
+	tracer := evm.TracerFunc(func(step *evm.Step) {
+		if sendErr == nil {
+			sendErr = stream.Send(newStructLog(step))
+		}
+	})
+	switch {
+	case len(param.TxHash) > 0:
+		genesisDoc := ts.blockchain.GenesisDoc()
+		params, err := execution.ParamsFromGenesis(&genesisDoc)
+		if err != nil {
+			return err
+		}
+		_, err = execution.TraceTx(ts.state, ts.blockchain, params, param.TxHash, tracer, ts.logger)
+		if err != nil {
+			return err
+		}
+	case param.CallTx != nil:
+		if param.CallTx.Address == nil {
+			return fmt.Errorf("TraceTx requires a CallTx with a non-nil address from which to retrieve code")
+		}
+		_, err := execution.CallSim(ts.state, ts.blockchain, param.CallTx.Input.Address, *param.CallTx.Address,
+			param.CallTx.Data, ts.logger, evm.TracerOption(tracer))
+		if err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("TraceTx requires either a TxHash or a CallTx")
+	}
+	return sendErr
+}
+
 func (ts *transactServer) SendTxSync(ctx context.Context, param *payload.SendTx) (*exec.TxExecution, error) {
 	return ts.BroadcastTxSync(ctx, &TxEnvelopeParam{Payload: param.Any()})
 }
@@ -136,3 +201,42 @@ func (te *TxEnvelopeParam) GetEnvelope(chainID string) *txs.Envelope {
 	}
 	return nil
 }
+
+func newStructLog(step *evm.Step) *StructLog {
+	structLog := &StructLog{
+		Depth:   step.Depth,
+		Address: step.Address,
+		PC:      step.PC,
+		Op:      step.Op.String(),
+		Gas:     step.Gas,
+		Stack:   step.Stack,
+		Memory:  step.Memory,
+	}
+	if step.StorageDelta != nil {
+		structLog.StorageDelta = &StorageDelta{
+			Key:   step.StorageDelta.Key,
+			Value: step.StorageDelta.Value,
+		}
+	}
+	return structLog
+}
+
+func newAccountOverride(override *AccountOverride) *execution.AccountOverride {
+	accountOverride := &execution.AccountOverride{
+		Address:     override.Address,
+		EVMCode:     override.EVMCode,
+		WASMCode:    override.WASMCode,
+		Permissions: override.Permissions,
+	}
+	if override.SetBalance {
+		balance := override.Balance
+		accountOverride.Balance = &balance
+	}
+	if len(override.Storage) > 0 {
+		accountOverride.Storage = make(map[binary.Word256][]byte, len(override.Storage))
+		for _, slot := range override.Storage {
+			accountOverride.Storage[slot.Key] = slot.Value
+		}
+	}
+	return accountOverride
+}
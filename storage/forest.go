@@ -2,6 +2,8 @@ package storage
 
 import (
 	"fmt"
+	"sort"
+	"sync"
 
 	"github.com/golang/protobuf/proto"
 
@@ -71,6 +73,8 @@ type MutableForest struct {
 	dirty map[string]*RWTree
 	// List of dirty prefixes in deterministic order so we may loop over them on Save() and obtain a consistent commitTree hash
 	dirtyPrefixes []string
+	// Guards the saved versions of commitsTree which may be read by GetImmutable concurrently with Save() and Prune()
+	versions sync.RWMutex
 }
 
 // ImmutableForest contains much of the implementation for MutableForest yet it's external API is immutable
@@ -143,11 +147,15 @@ func (muf *MutableForest) Save() (hash []byte, version int64, _ error) {
 	// empty dirty cache
 	muf.dirty = make(map[string]*RWTree, len(muf.dirty))
 	muf.dirtyPrefixes = muf.dirtyPrefixes[:0]
+	muf.versions.Lock()
+	defer muf.versions.Unlock()
 	return muf.commitsTree.Save()
 }
 
 func (muf *MutableForest) GetImmutable(version int64) (*ImmutableForest, error) {
+	muf.versions.RLock()
 	commitsTree, err := muf.commitsTree.GetImmutable(version)
+	muf.versions.RUnlock()
 	if err != nil {
 		return nil, fmt.Errorf("MutableForest.GetImmutable() could not get commits tree for version %d: %v",
 			version, err)
@@ -191,6 +199,88 @@ func (muf *MutableForest) Delete(prefix []byte) (*CommitID, error) {
 	return unmarshalCommitID(bs)
 }
 
+// Prune deletes saved versions of the forest from from up to (but excluding) until for which keep returns false (a nil
+// keep deletes them all). The latest version is never deleted. Each tree version is deleted along with the last forest
+// version that references it so that every remaining forest version can still be loaded in full. Calls should be
+// serialised with writes and saves. Returns the number of forest versions deleted.
+func (muf *MutableForest) Prune(from, until int64, keep func(version int64) bool) (int, error) {
+	const errHeader = "MutableForest.Prune():"
+	if latest := muf.Version(); until > latest {
+		until = latest
+	}
+	versions := muf.commitsTree.AvailableVersions()
+	i := sort.SearchInts(versions, int(from))
+	// The last retained version of the forest before the one under consideration, zero if there is none
+	var previous int64
+	if i > 0 {
+		previous = int64(versions[i-1])
+	}
+	// Only the tree versions of the previous, current, and next versions of the forest are needed at any one time
+	commits := make(map[int64]map[string]int64)
+	load := func(version int64) (map[string]int64, error) {
+		if version == 0 {
+			return nil, nil
+		}
+		if cv, ok := commits[version]; ok {
+			return cv, nil
+		}
+		cv, err := muf.commitVersions(version)
+		if err != nil {
+			return nil, err
+		}
+		commits[version] = cv
+		return cv, nil
+	}
+	pruned := 0
+	for ; i < len(versions) && int64(versions[i]) < until; i++ {
+		version := int64(versions[i])
+		if keep != nil && keep(version) {
+			delete(commits, previous)
+			previous = version
+			continue
+		}
+		current, err := load(version)
+		if err != nil {
+			return pruned, fmt.Errorf("%s %v", errHeader, err)
+		}
+		before, err := load(previous)
+		if err != nil {
+			return pruned, fmt.Errorf("%s %v", errHeader, err)
+		}
+		// Since until is no greater than the latest version there is always a next version
+		after, err := load(int64(versions[i+1]))
+		if err != nil {
+			return pruned, fmt.Errorf("%s %v", errHeader, err)
+		}
+		for prefix, treeVersion := range current {
+			if before[prefix] == treeVersion || after[prefix] == treeVersion {
+				// Still needed by a retained version of the forest
+				continue
+			}
+			tree, err := muf.tree([]byte(prefix))
+			if err != nil {
+				return pruned, fmt.Errorf("%s %v", errHeader, err)
+			}
+			// Trees that have since been deleted from the forest are not loaded so their versions are left alone
+			if tree.VersionExists(treeVersion) {
+				err = tree.DeleteVersion(treeVersion)
+				if err != nil {
+					return pruned, fmt.Errorf("%s could not prune tree %X: %v", errHeader, prefix, err)
+				}
+			}
+		}
+		muf.versions.Lock()
+		err = muf.commitsTree.DeleteVersion(version)
+		muf.versions.Unlock()
+		if err != nil {
+			return pruned, fmt.Errorf("%s %v", errHeader, err)
+		}
+		delete(commits, version)
+		pruned++
+	}
+	return pruned, nil
+}
+
 // Get the current global hash for all trees in this forest
 func (muf *MutableForest) Hash() []byte {
 	return muf.commitsTree.Hash()
@@ -209,6 +299,29 @@ func (muf *MutableForest) saveTree(prefix []byte, tree *RWTree) error {
 	return muf.setCommit(prefix, hash, version)
 }
 
+// Get the version of each tree referenced by a saved version of the forest
+func (muf *MutableForest) commitVersions(version int64) (map[string]int64, error) {
+	muf.versions.RLock()
+	commitsTree, err := muf.commitsTree.GetImmutable(version)
+	muf.versions.RUnlock()
+	if err != nil {
+		return nil, fmt.Errorf("could not get commits tree for version %d: %v", version, err)
+	}
+	commits := make(map[string]int64)
+	err = commitsTree.Iterate(nil, nil, true, func(prefix []byte, value []byte) error {
+		commitID, err := unmarshalCommitID(value)
+		if err != nil {
+			return err
+		}
+		commits[string(prefix)] = commitID.Version
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return commits, nil
+}
+
 func (muf *MutableForest) setCommit(prefix, hash []byte, version int64) error {
 	bs, err := marshalCommitID(hash, version)
 	if err != nil {
@@ -105,6 +105,67 @@ func TestMutableForest_Load(t *testing.T) {
 	require.Equal(t, dump, forest.Dump())
 }
 
+func TestMutableForest_Prune(t *testing.T) {
+	db := dbm.NewMemDB()
+	forest, err := NewMutableForest(db, 100)
+	require.NoError(t, err)
+	prefixA := []byte("a")
+	prefixB := []byte("b")
+	key := []byte("key")
+	// Tree a is written at every version, tree b only at versions 1 and 4
+	for v := 1; v <= 6; v++ {
+		tree, err := forest.Writer(prefixA)
+		require.NoError(t, err)
+		tree.Set(key, []byte{byte(v)})
+		if v == 1 || v == 4 {
+			tree, err = forest.Writer(prefixB)
+			require.NoError(t, err)
+			tree.Set(key, []byte{byte(v)})
+		}
+		_, _, err = forest.Save()
+		require.NoError(t, err)
+	}
+
+	// Prune in two batches to check tree versions needed either side of a batch boundary are kept
+	keep := func(version int64) bool { return version == 2 }
+	pruned, err := forest.Prune(1, 4, keep)
+	require.NoError(t, err)
+	require.Equal(t, 2, pruned)
+	pruned, err = forest.Prune(4, 5, keep)
+	require.NoError(t, err)
+	require.Equal(t, 1, pruned)
+
+	// Reload from DB to check the versions we kept are complete
+	forest, err = NewMutableForest(db, 100)
+	require.NoError(t, err)
+	require.NoError(t, forest.Load(6))
+	for _, version := range []int64{1, 3, 4} {
+		_, err = forest.GetImmutable(version)
+		require.Error(t, err, "version %d should have been pruned", version)
+	}
+	expected := map[int64][2]byte{2: {2, 1}, 5: {5, 4}, 6: {6, 4}}
+	for version, values := range expected {
+		imf, err := forest.GetImmutable(version)
+		require.NoError(t, err)
+		reader, err := imf.Reader(prefixA)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{values[0]}, reader.Get(key), "tree a at version %d", version)
+		reader, err = imf.Reader(prefixB)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{values[1]}, reader.Get(key), "tree b at version %d", version)
+	}
+
+	// Pruning everything leaves only the latest version
+	pruned, err = forest.Prune(1, 100, nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, pruned)
+	imf, err := forest.GetImmutable(6)
+	require.NoError(t, err)
+	reader, err := imf.Reader(prefixB)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{4}, reader.Get(key))
+}
+
 func TestSorted(t *testing.T) {
 	forest, err := NewMutableForest(dbm.NewMemDB(), 100)
 	require.NoError(t, err)
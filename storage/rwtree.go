@@ -84,6 +84,24 @@ func (rwt *RWTree) GetImmutable(version int64) (*ImmutableTree, error) {
 	return rwt.tree.GetImmutable(version)
 }
 
+// Returns the saved versions of the tree in ascending order
+func (rwt *RWTree) AvailableVersions() []int {
+	return rwt.tree.AvailableVersions()
+}
+
+func (rwt *RWTree) VersionExists(version int64) bool {
+	return rwt.tree.VersionExists(version)
+}
+
+// Delete a saved version of the tree from DB, the latest saved version cannot be deleted
+func (rwt *RWTree) DeleteVersion(version int64) error {
+	err := rwt.tree.DeleteVersion(version)
+	if err != nil {
+		return fmt.Errorf("RWTree.DeleteVersion() could not delete version %d: %v", version, err)
+	}
+	return nil
+}
+
 func (rwt *RWTree) IterateWriteTree(start, end []byte, ascending bool, fn func(key []byte, value []byte) error) error {
 	return rwt.tree.IterateWriteTree(start, end, ascending, fn)
 }
@@ -0,0 +1,35 @@
+package payload
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/crypto"
+)
+
+func NewDelegateTx(delegator, validator crypto.Address, amount uint64) *DelegateTx {
+	return &DelegateTx{
+		Input: &TxInput{
+			Address: delegator,
+			Amount:  amount,
+		},
+		Validator: validator,
+	}
+}
+
+func (tx *DelegateTx) Type() Type {
+	return TypeDelegate
+}
+
+func (tx *DelegateTx) GetInputs() []*TxInput {
+	return []*TxInput{tx.Input}
+}
+
+func (tx *DelegateTx) String() string {
+	return fmt.Sprintf("DelegateTx{%v -> %v}", tx.Input, tx.Validator)
+}
+
+func (tx *DelegateTx) Any() *Any {
+	return &Any{
+		DelegateTx: tx,
+	}
+}
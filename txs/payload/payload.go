@@ -16,6 +16,8 @@ Account Txs:
 Validation Txs:
  - BondTx         New validator posts a bond
  - UnbondTx       Validator leaves
+ - DelegateTx     Account delegates stake to a validator
+ - UndelegateTx   Account withdraws stake delegated to a validator
 
 Admin Txs:
  - PermsTx
@@ -33,8 +35,10 @@ const (
 	TypeBatch = Type(0x04)
 
 	// Validation transactions
-	TypeBond   = Type(0x11)
-	TypeUnbond = Type(0x12)
+	TypeBond       = Type(0x11)
+	TypeUnbond     = Type(0x12)
+	TypeDelegate   = Type(0x13)
+	TypeUndelegate = Type(0x14)
 
 	// Admin transactions
 	TypePermissions = Type(0x21)
@@ -62,6 +66,8 @@ var nameFromType = map[Type]string{
 	TypeProposal:    "ProposalTx",
 	TypeBond:        "BondTx",
 	TypeUnbond:      "UnbondTx",
+	TypeDelegate:    "DelegateTx",
+	TypeUndelegate:  "UndelegateTx",
 }
 
 var typeFromName = make(map[string]Type)
@@ -128,6 +134,10 @@ func New(txType Type) (Payload, error) {
 		return &BondTx{}, nil
 	case TypeUnbond:
 		return &UnbondTx{}, nil
+	case TypeDelegate:
+		return &DelegateTx{}, nil
+	case TypeUndelegate:
+		return &UndelegateTx{}, nil
 	case TypeProposal:
 		return &ProposalTx{}, nil
 	}
@@ -55,22 +55,25 @@ func (x Ballot_ProposalState) String() string {
 }
 
 func (Ballot_ProposalState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_678c914f1bee6d56, []int{15, 0}
+	return fileDescriptor_678c914f1bee6d56, []int{17, 0}
 }
 
+// Any encodes a sum type for which only one should be set
 type Any struct {
-	CallTx               *CallTx     `protobuf:"bytes,1,opt,name=CallTx,proto3" json:"CallTx,omitempty"`
-	SendTx               *SendTx     `protobuf:"bytes,2,opt,name=SendTx,proto3" json:"SendTx,omitempty"`
-	NameTx               *NameTx     `protobuf:"bytes,3,opt,name=NameTx,proto3" json:"NameTx,omitempty"`
-	PermsTx              *PermsTx    `protobuf:"bytes,4,opt,name=PermsTx,proto3" json:"PermsTx,omitempty"`
-	GovTx                *GovTx      `protobuf:"bytes,5,opt,name=GovTx,proto3" json:"GovTx,omitempty"`
-	BondTx               *BondTx     `protobuf:"bytes,6,opt,name=BondTx,proto3" json:"BondTx,omitempty"`
-	UnbondTx             *UnbondTx   `protobuf:"bytes,7,opt,name=UnbondTx,proto3" json:"UnbondTx,omitempty"`
-	BatchTx              *BatchTx    `protobuf:"bytes,8,opt,name=BatchTx,proto3" json:"BatchTx,omitempty"`
-	ProposalTx           *ProposalTx `protobuf:"bytes,9,opt,name=ProposalTx,proto3" json:"ProposalTx,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	CallTx               *CallTx       `protobuf:"bytes,1,opt,name=CallTx,proto3" json:"CallTx,omitempty"`
+	SendTx               *SendTx       `protobuf:"bytes,2,opt,name=SendTx,proto3" json:"SendTx,omitempty"`
+	NameTx               *NameTx       `protobuf:"bytes,3,opt,name=NameTx,proto3" json:"NameTx,omitempty"`
+	PermsTx              *PermsTx      `protobuf:"bytes,4,opt,name=PermsTx,proto3" json:"PermsTx,omitempty"`
+	GovTx                *GovTx        `protobuf:"bytes,5,opt,name=GovTx,proto3" json:"GovTx,omitempty"`
+	BondTx               *BondTx       `protobuf:"bytes,6,opt,name=BondTx,proto3" json:"BondTx,omitempty"`
+	UnbondTx             *UnbondTx     `protobuf:"bytes,7,opt,name=UnbondTx,proto3" json:"UnbondTx,omitempty"`
+	BatchTx              *BatchTx      `protobuf:"bytes,8,opt,name=BatchTx,proto3" json:"BatchTx,omitempty"`
+	ProposalTx           *ProposalTx   `protobuf:"bytes,9,opt,name=ProposalTx,proto3" json:"ProposalTx,omitempty"`
+	DelegateTx           *DelegateTx   `protobuf:"bytes,10,opt,name=DelegateTx,proto3" json:"DelegateTx,omitempty"`
+	UndelegateTx         *UndelegateTx `protobuf:"bytes,11,opt,name=UndelegateTx,proto3" json:"UndelegateTx,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *Any) Reset()         { *m = Any{} }
@@ -169,6 +172,20 @@ func (m *Any) GetProposalTx() *ProposalTx {
 	return nil
 }
 
+func (m *Any) GetDelegateTx() *DelegateTx {
+	if m != nil {
+		return m.DelegateTx
+	}
+	return nil
+}
+
+func (m *Any) GetUndelegateTx() *UndelegateTx {
+	if m != nil {
+		return m.UndelegateTx
+	}
+	return nil
+}
+
 func (*Any) XXX_MessageName() string {
 	return "payload.Any"
 }
@@ -704,6 +721,100 @@ func (*UnbondTx) XXX_MessageName() string {
 	return "payload.UnbondTx"
 }
 
+type DelegateTx struct {
+	// Input must be the delegator and carry the stake to delegate as its Amount
+	Input *TxInput `protobuf:"bytes,1,opt,name=Input,proto3" json:"Input,omitempty"`
+	// The validator to delegate stake to
+	Validator            github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,2,opt,name=Validator,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Validator"`
+	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
+	XXX_unrecognized     []byte                                       `json:"-"`
+	XXX_sizecache        int32                                        `json:"-"`
+}
+
+func (m *DelegateTx) Reset()      { *m = DelegateTx{} }
+func (*DelegateTx) ProtoMessage() {}
+func (*DelegateTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c914f1bee6d56, []int{10}
+}
+func (m *DelegateTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegateTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegateTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegateTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegateTx.Merge(m, src)
+}
+func (m *DelegateTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegateTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegateTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegateTx proto.InternalMessageInfo
+
+func (*DelegateTx) XXX_MessageName() string {
+	return "payload.DelegateTx"
+}
+
+type UndelegateTx struct {
+	// Input must be the delegator
+	Input *TxInput `protobuf:"bytes,1,opt,name=Input,proto3" json:"Input,omitempty"`
+	// The validator to withdraw delegated stake from
+	Validator github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,2,opt,name=Validator,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Validator"`
+	// The stake to withdraw
+	Amount               uint64   `protobuf:"varint,3,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UndelegateTx) Reset()      { *m = UndelegateTx{} }
+func (*UndelegateTx) ProtoMessage() {}
+func (*UndelegateTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c914f1bee6d56, []int{11}
+}
+func (m *UndelegateTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UndelegateTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UndelegateTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UndelegateTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UndelegateTx.Merge(m, src)
+}
+func (m *UndelegateTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *UndelegateTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_UndelegateTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UndelegateTx proto.InternalMessageInfo
+
+func (*UndelegateTx) XXX_MessageName() string {
+	return "payload.UndelegateTx"
+}
+
 type GovTx struct {
 	Inputs               []*TxInput              `protobuf:"bytes,1,rep,name=Inputs,proto3" json:"Inputs,omitempty"`
 	AccountUpdates       []*spec.TemplateAccount `protobuf:"bytes,2,rep,name=AccountUpdates,proto3" json:"AccountUpdates,omitempty"`
@@ -715,7 +826,7 @@ type GovTx struct {
 func (m *GovTx) Reset()      { *m = GovTx{} }
 func (*GovTx) ProtoMessage() {}
 func (*GovTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c914f1bee6d56, []int{10}
+	return fileDescriptor_678c914f1bee6d56, []int{12}
 }
 func (m *GovTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -761,7 +872,7 @@ type ProposalTx struct {
 func (m *ProposalTx) Reset()      { *m = ProposalTx{} }
 func (*ProposalTx) ProtoMessage() {}
 func (*ProposalTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c914f1bee6d56, []int{11}
+	return fileDescriptor_678c914f1bee6d56, []int{13}
 }
 func (m *ProposalTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -805,7 +916,7 @@ type BatchTx struct {
 func (m *BatchTx) Reset()      { *m = BatchTx{} }
 func (*BatchTx) ProtoMessage() {}
 func (*BatchTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c914f1bee6d56, []int{12}
+	return fileDescriptor_678c914f1bee6d56, []int{14}
 }
 func (m *BatchTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -849,7 +960,7 @@ type Vote struct {
 func (m *Vote) Reset()      { *m = Vote{} }
 func (*Vote) ProtoMessage() {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c914f1bee6d56, []int{13}
+	return fileDescriptor_678c914f1bee6d56, []int{15}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -894,7 +1005,7 @@ type Proposal struct {
 func (m *Proposal) Reset()      { *m = Proposal{} }
 func (*Proposal) ProtoMessage() {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c914f1bee6d56, []int{14}
+	return fileDescriptor_678c914f1bee6d56, []int{16}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -941,7 +1052,7 @@ func (m *Ballot) Reset()         { *m = Ballot{} }
 func (m *Ballot) String() string { return proto.CompactTextString(m) }
 func (*Ballot) ProtoMessage()    {}
 func (*Ballot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c914f1bee6d56, []int{15}
+	return fileDescriptor_678c914f1bee6d56, []int{17}
 }
 func (m *Ballot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1017,6 +1128,10 @@ func init() {
 	golang_proto.RegisterType((*BondTx)(nil), "payload.BondTx")
 	proto.RegisterType((*UnbondTx)(nil), "payload.UnbondTx")
 	golang_proto.RegisterType((*UnbondTx)(nil), "payload.UnbondTx")
+	proto.RegisterType((*DelegateTx)(nil), "payload.DelegateTx")
+	golang_proto.RegisterType((*DelegateTx)(nil), "payload.DelegateTx")
+	proto.RegisterType((*UndelegateTx)(nil), "payload.UndelegateTx")
+	golang_proto.RegisterType((*UndelegateTx)(nil), "payload.UndelegateTx")
 	proto.RegisterType((*GovTx)(nil), "payload.GovTx")
 	golang_proto.RegisterType((*GovTx)(nil), "payload.GovTx")
 	proto.RegisterType((*ProposalTx)(nil), "payload.ProposalTx")
@@ -1035,72 +1150,76 @@ func init() { proto.RegisterFile("payload.proto", fileDescriptor_678c914f1bee6d5
 func init() { golang_proto.RegisterFile("payload.proto", fileDescriptor_678c914f1bee6d56) }
 
 var fileDescriptor_678c914f1bee6d56 = []byte{
-	// 1033 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0x66, 0xd7, 0x7f, 0xfa, 0xe2, 0x04, 0x77, 0xa0, 0xc8, 0x8a, 0x84, 0x1d, 0x19, 0x04,
-	0x29, 0x34, 0x36, 0xa4, 0xfc, 0x11, 0xb9, 0x20, 0xff, 0x4b, 0x1a, 0xd4, 0x36, 0x61, 0xbc, 0x69,
-	0x11, 0x88, 0xc3, 0xd8, 0x1e, 0xd6, 0x2b, 0xd9, 0x3b, 0xcb, 0xee, 0xb8, 0xac, 0x39, 0x71, 0xe0,
-	0xc0, 0x15, 0xf5, 0xc2, 0x31, 0x5f, 0x81, 0x6f, 0xc0, 0x31, 0x47, 0xce, 0x1c, 0x22, 0x94, 0x5e,
-	0x10, 0x9f, 0x02, 0xcd, 0xec, 0xcc, 0x7a, 0xed, 0x56, 0x8d, 0x13, 0x21, 0x6e, 0x33, 0xef, 0xfd,
-	0xe6, 0xbd, 0x37, 0xef, 0xfd, 0xde, 0x9b, 0x81, 0x75, 0x9f, 0x4c, 0x47, 0x8c, 0x0c, 0x6a, 0x7e,
-	0xc0, 0x38, 0x43, 0x39, 0xb5, 0xdd, 0xdc, 0x71, 0x5c, 0x3e, 0x9c, 0xf4, 0x6a, 0x7d, 0x36, 0xae,
-	0x3b, 0xcc, 0x61, 0x75, 0xa9, 0xef, 0x4d, 0xbe, 0x95, 0x3b, 0xb9, 0x91, 0xab, 0xf8, 0xdc, 0x66,
-	0xd1, 0xa7, 0xc1, 0xd8, 0x0d, 0x43, 0x97, 0x79, 0x4a, 0x02, 0xa1, 0x4f, 0xfb, 0xf1, 0xba, 0xfa,
-	0x8b, 0x09, 0x66, 0xc3, 0x9b, 0xa2, 0x77, 0x20, 0xdb, 0x22, 0xa3, 0x91, 0x1d, 0x95, 0x8c, 0x2d,
-	0x63, 0x7b, 0x6d, 0xf7, 0x95, 0x9a, 0xf6, 0x1e, 0x8b, 0xb1, 0x52, 0x0b, 0x60, 0x97, 0x7a, 0x03,
-	0x3b, 0x2a, 0xad, 0x2e, 0x00, 0x63, 0x31, 0x56, 0x6a, 0x01, 0x7c, 0x48, 0xc6, 0xd4, 0x8e, 0x4a,
-	0xe6, 0x02, 0x30, 0x16, 0x63, 0xa5, 0x46, 0xef, 0x42, 0xee, 0x98, 0x06, 0xe3, 0xd0, 0x8e, 0x4a,
-	0x96, 0x44, 0x16, 0x13, 0xa4, 0x92, 0x63, 0x0d, 0x40, 0x6f, 0x41, 0xe6, 0x80, 0x3d, 0xb1, 0xa3,
-	0x52, 0x46, 0x22, 0x37, 0x12, 0xa4, 0x94, 0xe2, 0x58, 0x29, 0x5c, 0x37, 0x99, 0x8c, 0x31, 0xbb,
-	0xe0, 0x3a, 0x16, 0x63, 0xa5, 0x46, 0x3b, 0x90, 0x3f, 0xf1, 0x7a, 0x31, 0x34, 0x27, 0xa1, 0x37,
-	0x13, 0xa8, 0x56, 0xe0, 0x04, 0x22, 0x22, 0x6d, 0x12, 0xde, 0x1f, 0xda, 0x51, 0x29, 0xbf, 0x10,
-	0xa9, 0x92, 0x63, 0x0d, 0x40, 0x77, 0x01, 0x8e, 0x03, 0xe6, 0xb3, 0x90, 0x88, 0xa4, 0xde, 0x90,
-	0xf0, 0x57, 0x67, 0x17, 0x4b, 0x54, 0x38, 0x05, 0xdb, 0xb3, 0xce, 0x4e, 0x2b, 0x46, 0xf5, 0xa9,
-	0x01, 0x39, 0x3b, 0x3a, 0xf4, 0xfc, 0x09, 0x47, 0x0f, 0x21, 0xd7, 0x18, 0x0c, 0x02, 0x1a, 0x86,
-	0xb2, 0x30, 0x85, 0xe6, 0x87, 0x67, 0xe7, 0x95, 0x95, 0x3f, 0xcf, 0x2b, 0x77, 0x52, 0x2c, 0x18,
-	0x4e, 0x7d, 0x1a, 0x8c, 0xe8, 0xc0, 0xa1, 0x41, 0xbd, 0x37, 0x09, 0x02, 0xf6, 0x7d, 0xbd, 0x1f,
-	0x4c, 0x7d, 0xce, 0x6a, 0xea, 0x2c, 0xd6, 0x46, 0xd0, 0xeb, 0x90, 0x6d, 0x8c, 0xd9, 0xc4, 0xe3,
-	0xb2, 0x7c, 0x16, 0x56, 0x3b, 0xb4, 0x09, 0xf9, 0x2e, 0xfd, 0x6e, 0x42, 0xbd, 0x3e, 0x95, 0xf5,
-	0xb2, 0x70, 0xb2, 0xdf, 0xb3, 0x7e, 0x3d, 0xad, 0xac, 0x54, 0x23, 0xc8, 0xdb, 0xd1, 0xd1, 0x84,
-	0xff, 0x8f, 0x51, 0x29, 0xcf, 0x4f, 0x4d, 0x4d, 0x4e, 0xf4, 0x36, 0x64, 0x64, 0x5e, 0x14, 0x4b,
-	0x67, 0xf9, 0x57, 0xf9, 0xc2, 0xb1, 0x1a, 0x7d, 0x3e, 0x0b, 0x70, 0x55, 0x06, 0xf8, 0xfe, 0xf5,
-	0x83, 0xdb, 0x84, 0xfc, 0x01, 0x09, 0xef, 0xbb, 0x63, 0x97, 0xeb, 0xd4, 0xe8, 0x3d, 0x2a, 0x82,
-	0xb9, 0x4f, 0xa9, 0xe4, 0xad, 0x85, 0xc5, 0x12, 0x1d, 0x82, 0xd5, 0x26, 0x9c, 0x48, 0x82, 0x16,
-	0x9a, 0x1f, 0xa9, 0xbc, 0xec, 0xbc, 0xdc, 0x75, 0xcf, 0xf5, 0x48, 0x30, 0xad, 0xdd, 0xa3, 0x51,
-	0x73, 0xca, 0x69, 0x88, 0xa5, 0x09, 0xf4, 0x35, 0x58, 0x8f, 0x1b, 0xdd, 0x07, 0x92, 0xc4, 0x85,
-	0xe6, 0xc1, 0xb5, 0x4c, 0xfd, 0x73, 0x5e, 0xd9, 0xe0, 0xc4, 0x09, 0xef, 0xb0, 0xb1, 0xcb, 0xe9,
-	0xd8, 0xe7, 0x53, 0x2c, 0x8d, 0xa2, 0x4f, 0xa1, 0xd0, 0x62, 0x1e, 0x0f, 0x48, 0x9f, 0x3f, 0xa0,
-	0x9c, 0x94, 0x72, 0x5b, 0xe6, 0xf6, 0xda, 0xee, 0xad, 0x59, 0xdb, 0xa7, 0x94, 0x78, 0x0e, 0xaa,
-	0xaa, 0x32, 0x99, 0x37, 0x80, 0xbe, 0x80, 0x7c, 0x8b, 0x0d, 0xe8, 0x3d, 0x12, 0x0e, 0x15, 0x29,
-	0xae, 0x79, 0xf9, 0xc4, 0x0c, 0x42, 0x60, 0xc9, 0xd8, 0x44, 0x09, 0x6f, 0x60, 0xb9, 0xae, 0xba,
-	0x7a, 0xfe, 0xa0, 0x6d, 0xc8, 0xca, 0x62, 0x0b, 0x0e, 0x9a, 0x2f, 0x24, 0x83, 0xd2, 0xa3, 0xf7,
-	0x20, 0x17, 0x13, 0x57, 0xb0, 0xc1, 0x9c, 0xeb, 0x72, 0x4d, 0x69, 0xac, 0x11, 0x7b, 0xf9, 0x9f,
-	0x4f, 0x2b, 0x2b, 0xf2, 0x86, 0x2c, 0x19, 0x4c, 0x4b, 0xf3, 0xee, 0x63, 0xc8, 0x8b, 0x23, 0x8d,
-	0xc0, 0x09, 0xd5, 0x7c, 0x7c, 0xad, 0x96, 0x9a, 0xbf, 0x5a, 0xd7, 0xb4, 0x44, 0x6a, 0x70, 0x82,
-	0x55, 0x29, 0xf5, 0xf5, 0xc8, 0x5c, 0xda, 0x1f, 0x02, 0x4b, 0x9c, 0xd0, 0x19, 0x12, 0x6b, 0x21,
-	0x93, 0x0c, 0x34, 0x63, 0x99, 0xa4, 0xd2, 0x73, 0x3c, 0x55, 0x1e, 0xf7, 0xf4, 0xa4, 0x5c, 0xd6,
-	0x63, 0x2a, 0x3d, 0xce, 0x6c, 0x78, 0x2e, 0x1d, 0xef, 0x6d, 0xc8, 0xc6, 0x79, 0x56, 0xd9, 0x79,
-	0x41, 0x21, 0x14, 0x20, 0xe5, 0xe8, 0x47, 0x43, 0x4d, 0xfd, 0x2b, 0x94, 0xbc, 0x05, 0x1b, 0x8d,
-	0x7e, 0x5f, 0x0c, 0x91, 0x13, 0x7f, 0x40, 0x38, 0xd5, 0x95, 0xbf, 0x55, 0x93, 0x8f, 0x9f, 0x4d,
-	0xc7, 0xfe, 0x88, 0x70, 0xaa, 0x30, 0xb2, 0x1e, 0x06, 0x5e, 0x38, 0x92, 0x0a, 0xe1, 0x6f, 0x23,
-	0x3d, 0xce, 0x97, 0xbe, 0x6e, 0x15, 0x0a, 0x8f, 0x18, 0x77, 0x3d, 0xe7, 0x31, 0x75, 0x9d, 0x61,
-	0x7c, 0x69, 0x13, 0xcf, 0xc9, 0xd0, 0x09, 0x14, 0xb4, 0x65, 0xd9, 0x3b, 0xa6, 0xec, 0x9d, 0x0f,
-	0xae, 0xde, 0x37, 0x73, 0x66, 0xc4, 0xd3, 0xa6, 0xf7, 0xea, 0x59, 0xbd, 0xf9, 0xdc, 0xeb, 0x83,
-	0x13, 0x48, 0xea, 0xaa, 0xdf, 0x24, 0x8f, 0xdc, 0x15, 0xd2, 0x5d, 0x06, 0xd3, 0x8e, 0x74, 0x8e,
-	0x0b, 0x09, 0xac, 0xe1, 0x4d, 0xb1, 0x50, 0xa4, 0xcc, 0xff, 0x64, 0x80, 0xf5, 0x88, 0x71, 0xfa,
-	0x9f, 0xbf, 0x21, 0x4b, 0xe4, 0x3a, 0x15, 0xc6, 0x93, 0x59, 0x7a, 0x92, 0x26, 0x32, 0x52, 0x4d,
-	0xb4, 0x05, 0x6b, 0x6d, 0x1a, 0xf6, 0x03, 0xd7, 0xe7, 0x2e, 0xf3, 0x54, 0x7f, 0xa5, 0x45, 0xe9,
-	0xcf, 0x80, 0x79, 0xc9, 0x67, 0x20, 0xe5, 0xf7, 0xb7, 0x55, 0xc8, 0x36, 0xc9, 0x68, 0xc4, 0xf8,
-	0x5c, 0x85, 0x8c, 0x4b, 0x2b, 0x24, 0x78, 0xb2, 0xef, 0x7a, 0x64, 0xe4, 0xfe, 0xe0, 0x7a, 0x8e,
-	0xfa, 0x7e, 0x5d, 0x8f, 0x27, 0x69, 0x33, 0xa8, 0x05, 0xeb, 0xbe, 0x72, 0xd1, 0xe5, 0x84, 0xc7,
-	0x33, 0x62, 0x63, 0xf7, 0x8d, 0xd4, 0x65, 0x44, 0xb4, 0x49, 0x44, 0x12, 0x84, 0xe7, 0xcf, 0xa0,
-	0x37, 0x21, 0x23, 0x6a, 0x1a, 0x96, 0x32, 0x92, 0x00, 0xeb, 0xc9, 0x61, 0x21, 0xc5, 0xb1, 0xae,
-	0xfa, 0x09, 0xac, 0xcf, 0x19, 0x41, 0x05, 0xc8, 0x1f, 0xe3, 0xa3, 0xe3, 0xa3, 0x6e, 0xa7, 0x5d,
-	0x5c, 0x11, 0xbb, 0xce, 0x97, 0x9d, 0xd6, 0x89, 0xdd, 0x69, 0x17, 0x0d, 0x04, 0x90, 0xdd, 0x6f,
-	0x1c, 0xde, 0xef, 0xb4, 0x8b, 0xab, 0xcd, 0xcf, 0xce, 0x2e, 0xca, 0xc6, 0x1f, 0x17, 0x65, 0xe3,
-	0xaf, 0x8b, 0xb2, 0xf1, 0xfb, 0xb3, 0xb2, 0x71, 0xf6, 0xac, 0x6c, 0x7c, 0x75, 0xfb, 0xe5, 0xb7,
-	0xe6, 0x51, 0x58, 0x57, 0x51, 0xf4, 0xb2, 0xf2, 0xaf, 0x7b, 0xf7, 0xdf, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x8c, 0x43, 0x16, 0xe2, 0x52, 0x0b, 0x00, 0x00,
+	// 1100 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x4d, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xcf, 0x66, 0x37, 0xb6, 0xf3, 0xc4, 0xc9, 0xdf, 0xff, 0x81, 0xa2, 0x55, 0x24, 0xec, 0xc8,
+	0x20, 0x68, 0xa1, 0x71, 0xa0, 0xe5, 0x45, 0xe4, 0x82, 0xfc, 0x92, 0xa4, 0x41, 0x6d, 0x13, 0x26,
+	0x9b, 0x14, 0x81, 0x38, 0x8c, 0xed, 0x61, 0xbd, 0x92, 0xbd, 0xb3, 0xec, 0x8e, 0xcb, 0x9a, 0x13,
+	0x48, 0x1c, 0x38, 0xd3, 0x0b, 0xc7, 0x7c, 0x00, 0x2e, 0x7c, 0x03, 0x8e, 0x39, 0x72, 0xe6, 0x10,
+	0xa1, 0xf4, 0x82, 0xf8, 0x14, 0x68, 0x66, 0x67, 0xd7, 0xb3, 0xa6, 0x6a, 0x9d, 0x14, 0xc1, 0x6d,
+	0xe7, 0x79, 0x7e, 0xcf, 0xcb, 0x3c, 0xf3, 0x9b, 0x9f, 0xc7, 0xb0, 0x1a, 0x90, 0xc9, 0x90, 0x91,
+	0x7e, 0x23, 0x08, 0x19, 0x67, 0xa8, 0xa8, 0x96, 0xeb, 0x9b, 0xae, 0xc7, 0x07, 0xe3, 0x6e, 0xa3,
+	0xc7, 0x46, 0x5b, 0x2e, 0x73, 0xd9, 0x96, 0xf4, 0x77, 0xc7, 0x5f, 0xc8, 0x95, 0x5c, 0xc8, 0xaf,
+	0x24, 0x6e, 0xbd, 0x12, 0xd0, 0x70, 0xe4, 0x45, 0x91, 0xc7, 0x7c, 0x65, 0x81, 0x28, 0xa0, 0xbd,
+	0xe4, 0xbb, 0xfe, 0xad, 0x05, 0x66, 0xd3, 0x9f, 0xa0, 0xd7, 0xa1, 0xd0, 0x26, 0xc3, 0xa1, 0x13,
+	0xdb, 0xc6, 0x86, 0x71, 0x7d, 0xe5, 0xd6, 0xff, 0x1a, 0x69, 0xf5, 0xc4, 0x8c, 0x95, 0x5b, 0x00,
+	0x8f, 0xa8, 0xdf, 0x77, 0x62, 0x7b, 0x71, 0x06, 0x98, 0x98, 0xb1, 0x72, 0x0b, 0xe0, 0x7d, 0x32,
+	0xa2, 0x4e, 0x6c, 0x9b, 0x33, 0xc0, 0xc4, 0x8c, 0x95, 0x1b, 0xbd, 0x01, 0xc5, 0x43, 0x1a, 0x8e,
+	0x22, 0x27, 0xb6, 0x2d, 0x89, 0xac, 0x64, 0x48, 0x65, 0xc7, 0x29, 0x00, 0xbd, 0x0a, 0x4b, 0x7b,
+	0xec, 0xa1, 0x13, 0xdb, 0x4b, 0x12, 0xb9, 0x96, 0x21, 0xa5, 0x15, 0x27, 0x4e, 0x51, 0xba, 0xc5,
+	0x64, 0x8f, 0x85, 0x99, 0xd2, 0x89, 0x19, 0x2b, 0x37, 0xda, 0x84, 0xd2, 0xb1, 0xdf, 0x4d, 0xa0,
+	0x45, 0x09, 0xfd, 0x7f, 0x06, 0x4d, 0x1d, 0x38, 0x83, 0x88, 0x4e, 0x5b, 0x84, 0xf7, 0x06, 0x4e,
+	0x6c, 0x97, 0x66, 0x3a, 0x55, 0x76, 0x9c, 0x02, 0xd0, 0x6d, 0x80, 0xc3, 0x90, 0x05, 0x2c, 0x22,
+	0x62, 0xa8, 0xcb, 0x12, 0xfe, 0xc2, 0x74, 0x63, 0x99, 0x0b, 0x6b, 0x30, 0x11, 0xd4, 0xa1, 0x43,
+	0xea, 0x12, 0x2e, 0xe6, 0x06, 0x33, 0x41, 0x53, 0x17, 0xd6, 0x60, 0xe8, 0x03, 0x28, 0x1f, 0xfb,
+	0xfd, 0x69, 0xd8, 0x8a, 0x0c, 0xbb, 0xa6, 0x6d, 0x64, 0xea, 0xc4, 0x39, 0xe8, 0xb6, 0x75, 0x76,
+	0x5a, 0x33, 0xea, 0x8f, 0x0c, 0x28, 0x3a, 0xf1, 0xbe, 0x1f, 0x8c, 0x39, 0xba, 0x0f, 0xc5, 0x66,
+	0xbf, 0x1f, 0xd2, 0x28, 0x92, 0x44, 0x28, 0xb7, 0xde, 0x39, 0x3b, 0xaf, 0x2d, 0xfc, 0x76, 0x5e,
+	0xbb, 0xa9, 0xb1, 0x6e, 0x30, 0x09, 0x68, 0x38, 0xa4, 0x7d, 0x97, 0x86, 0x5b, 0xdd, 0x71, 0x18,
+	0xb2, 0xaf, 0xb6, 0x7a, 0xe1, 0x24, 0xe0, 0xac, 0xa1, 0x62, 0x71, 0x9a, 0x04, 0xbd, 0x04, 0x85,
+	0xe6, 0x88, 0x8d, 0x7d, 0x2e, 0xe9, 0x62, 0x61, 0xb5, 0x42, 0xeb, 0x50, 0x3a, 0xa2, 0x5f, 0x8e,
+	0xa9, 0xdf, 0xa3, 0x92, 0x1f, 0x16, 0xce, 0xd6, 0xdb, 0xd6, 0x8f, 0xa7, 0xb5, 0x85, 0x7a, 0x0c,
+	0x25, 0x27, 0x3e, 0x18, 0xf3, 0x7f, 0xb1, 0x2b, 0x55, 0xf9, 0x91, 0x99, 0x5e, 0x06, 0xf4, 0x1a,
+	0x2c, 0xc9, 0xb9, 0xd8, 0xc6, 0xcc, 0x79, 0xab, 0x79, 0xe1, 0xc4, 0x8d, 0x3e, 0x9a, 0x36, 0xb8,
+	0x28, 0x1b, 0x7c, 0xeb, 0xea, 0xcd, 0xad, 0x43, 0x69, 0x8f, 0x44, 0x77, 0xbd, 0x91, 0xc7, 0xd3,
+	0xd1, 0xa4, 0x6b, 0x54, 0x01, 0x73, 0x97, 0x52, 0x79, 0x4f, 0x2c, 0x2c, 0x3e, 0xd1, 0x3e, 0x58,
+	0x1d, 0xc2, 0x89, 0xbc, 0x10, 0xe5, 0xd6, 0xbb, 0x6a, 0x2e, 0x9b, 0x4f, 0x2f, 0xdd, 0xf5, 0x7c,
+	0x12, 0x4e, 0x1a, 0x77, 0x68, 0xdc, 0x9a, 0x70, 0x1a, 0x61, 0x99, 0x02, 0x7d, 0x06, 0xd6, 0x83,
+	0xe6, 0xd1, 0x3d, 0x79, 0x69, 0xca, 0xad, 0xbd, 0x2b, 0xa5, 0xfa, 0xf3, 0xbc, 0xb6, 0xc6, 0x89,
+	0x1b, 0xdd, 0x64, 0x23, 0x8f, 0xd3, 0x51, 0xc0, 0x27, 0x58, 0x26, 0x15, 0x2c, 0x6d, 0x33, 0x9f,
+	0x87, 0xa4, 0xc7, 0xef, 0x51, 0x4e, 0xec, 0xe2, 0x86, 0x99, 0x63, 0xa9, 0xee, 0xc4, 0x39, 0xa8,
+	0x3a, 0x95, 0x71, 0x3e, 0x01, 0xfa, 0x18, 0x4a, 0x6d, 0xd6, 0xa7, 0x77, 0x48, 0x34, 0xb0, 0x8d,
+	0xe7, 0xd9, 0x7c, 0x96, 0x06, 0x21, 0xb0, 0x64, 0x6f, 0xe2, 0x08, 0x97, 0xb1, 0xfc, 0xae, 0x7b,
+	0xa9, 0xde, 0xa1, 0xeb, 0x50, 0x90, 0x87, 0x2d, 0x38, 0x68, 0x3e, 0x91, 0x0c, 0xca, 0x8f, 0xde,
+	0x84, 0x62, 0x42, 0x5c, 0xc1, 0x06, 0x33, 0xa7, 0x2a, 0x29, 0xa5, 0x71, 0x8a, 0xd8, 0x2e, 0x7d,
+	0x7f, 0x5a, 0x5b, 0x90, 0x3b, 0x64, 0x99, 0x10, 0xce, 0xcd, 0xbb, 0xf7, 0xa0, 0x24, 0x42, 0x9a,
+	0xa1, 0x1b, 0x29, 0x3d, 0x7e, 0xb1, 0xa1, 0xe9, 0x7d, 0xea, 0x6b, 0x59, 0x62, 0x34, 0x38, 0xc3,
+	0xaa, 0x91, 0x06, 0xa9, 0x44, 0xcf, 0x5d, 0x0f, 0x81, 0x25, 0x22, 0xd2, 0x09, 0x89, 0x6f, 0x61,
+	0x93, 0x0c, 0x34, 0x13, 0x9b, 0xf8, 0xfe, 0x3b, 0x4f, 0x55, 0xc5, 0xed, 0x54, 0x99, 0xe7, 0xad,
+	0xa8, 0x8d, 0xc7, 0x9d, 0x8a, 0xf5, 0xdc, 0xfd, 0xde, 0x80, 0x42, 0x32, 0x67, 0x35, 0x9d, 0x27,
+	0x1c, 0x84, 0x02, 0x68, 0x85, 0x7e, 0x30, 0x74, 0x19, 0x9e, 0xbb, 0x16, 0x86, 0xe5, 0x13, 0x32,
+	0xf4, 0xfa, 0x84, 0xb3, 0xd0, 0x5e, 0x7c, 0x0e, 0x99, 0x9a, 0xa6, 0xd1, 0x9a, 0xfa, 0xc9, 0xc8,
+	0xcb, 0xfc, 0x7f, 0xd9, 0x96, 0xa6, 0x9f, 0x66, 0x4e, 0x3f, 0xa7, 0xed, 0x7e, 0x63, 0xa8, 0x5f,
+	0xea, 0x4b, 0x5c, 0x9b, 0x36, 0xac, 0x35, 0x7b, 0x3d, 0x91, 0xe8, 0x38, 0xe8, 0x13, 0x4e, 0xd3,
+	0xdb, 0x73, 0xad, 0x21, 0x1f, 0x2c, 0x0e, 0x1d, 0x05, 0x43, 0xc2, 0xa9, 0xc2, 0x48, 0x4e, 0x1b,
+	0x78, 0x26, 0x44, 0x6b, 0xe1, 0x0f, 0x43, 0xff, 0x09, 0x9e, 0x7b, 0x5e, 0x75, 0x28, 0x9f, 0x30,
+	0xee, 0xf9, 0xee, 0x03, 0xea, 0xb9, 0x83, 0x84, 0x38, 0x26, 0xce, 0xd9, 0xd0, 0x31, 0x94, 0xd3,
+	0xcc, 0x52, 0x7f, 0x4c, 0x39, 0xd6, 0xb7, 0x2f, 0xaf, 0x3d, 0xb9, 0x34, 0xe2, 0x39, 0x92, 0xae,
+	0x6d, 0x6b, 0x86, 0xaf, 0xa9, 0x03, 0x67, 0x10, 0x6d, 0xab, 0x9f, 0x67, 0x0f, 0x93, 0x4b, 0x8c,
+	0xbb, 0x0a, 0xa6, 0x13, 0xa7, 0x33, 0x2e, 0x67, 0xb0, 0xa6, 0x3f, 0xc1, 0xc2, 0xa1, 0xa5, 0xff,
+	0xce, 0x00, 0xeb, 0x84, 0x71, 0xfa, 0x8f, 0xff, 0x0e, 0xcf, 0x31, 0x6b, 0xad, 0x8d, 0x87, 0xd3,
+	0xf1, 0x64, 0x42, 0x64, 0x68, 0x42, 0xb4, 0x01, 0x2b, 0x1d, 0x1a, 0xf5, 0x42, 0x2f, 0xe0, 0x1e,
+	0xf3, 0x95, 0x46, 0xe9, 0x26, 0xfd, 0x01, 0x67, 0x3e, 0xe3, 0x01, 0xa7, 0xd5, 0xfd, 0x79, 0x11,
+	0x0a, 0x2d, 0x32, 0x1c, 0x32, 0x9e, 0x3b, 0x21, 0xe3, 0x99, 0x27, 0x24, 0x78, 0xb2, 0xeb, 0xf9,
+	0x64, 0xe8, 0x7d, 0xed, 0xf9, 0xae, 0x7a, 0x32, 0x5f, 0x8d, 0x27, 0x7a, 0x1a, 0xd4, 0x86, 0xd5,
+	0x40, 0x95, 0x38, 0xe2, 0x84, 0x27, 0x3a, 0xbb, 0x76, 0xeb, 0x65, 0x6d, 0x33, 0xa2, 0xdb, 0xc6,
+	0xa1, 0x0e, 0xc2, 0xf9, 0x18, 0xf4, 0x0a, 0x2c, 0x89, 0x33, 0x8d, 0xec, 0x25, 0x49, 0x80, 0xd5,
+	0x2c, 0x58, 0x58, 0x71, 0xe2, 0xab, 0xbf, 0x0f, 0xab, 0xb9, 0x24, 0xa8, 0x0c, 0xa5, 0x43, 0x7c,
+	0x70, 0x78, 0x70, 0xb4, 0xd3, 0xa9, 0x2c, 0x88, 0xd5, 0xce, 0x27, 0x3b, 0xed, 0x63, 0x67, 0xa7,
+	0x53, 0x31, 0x10, 0x40, 0x61, 0xb7, 0xb9, 0x7f, 0x77, 0xa7, 0x53, 0x59, 0x6c, 0x7d, 0x78, 0x76,
+	0x51, 0x35, 0x7e, 0xbd, 0xa8, 0x1a, 0xbf, 0x5f, 0x54, 0x8d, 0x5f, 0x1e, 0x57, 0x8d, 0xb3, 0xc7,
+	0x55, 0xe3, 0xd3, 0x1b, 0x4f, 0xdf, 0x35, 0x8f, 0xa3, 0x2d, 0xd5, 0x45, 0xb7, 0x20, 0xff, 0x9f,
+	0xdc, 0xfe, 0x6b, 0x00, 0x9b, 0xad, 0x1c, 0xfa, 0x06, 0x0d, 0x00, 0x00,
 }
 
 func (m *Any) Marshal() (dAtA []byte, err error) {
@@ -1208,6 +1327,26 @@ func (m *Any) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n9
 	}
+	if m.DelegateTx != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.DelegateTx.Size()))
+		n10, err := m.DelegateTx.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	if m.UndelegateTx != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.UndelegateTx.Size()))
+		n11, err := m.UndelegateTx.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -1232,11 +1371,11 @@ func (m *TxInput) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.Address.Size()))
-	n10, err := m.Address.MarshalTo(dAtA[i:])
+	n12, err := m.Address.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n10
+	i += n12
 	if m.Amount != 0 {
 		dAtA[i] = 0x10
 		i++
@@ -1271,11 +1410,11 @@ func (m *TxOutput) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.Address.Size()))
-	n11, err := m.Address.MarshalTo(dAtA[i:])
+	n13, err := m.Address.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n11
+	i += n13
 	if m.Amount != 0 {
 		dAtA[i] = 0x10
 		i++
@@ -1306,21 +1445,21 @@ func (m *CallTx) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPayload(dAtA, i, uint64(m.Input.Size()))
-		n12, err := m.Input.MarshalTo(dAtA[i:])
+		n14, err := m.Input.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n12
+		i += n14
 	}
 	if m.Address != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintPayload(dAtA, i, uint64(m.Address.Size()))
-		n13, err := m.Address.MarshalTo(dAtA[i:])
+		n15, err := m.Address.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n13
+		i += n15
 	}
 	if m.GasLimit != 0 {
 		dAtA[i] = 0x18
@@ -1335,19 +1474,19 @@ func (m *CallTx) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x2a
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.Data.Size()))
-	n14, err := m.Data.MarshalTo(dAtA[i:])
+	n16, err := m.Data.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n14
+	i += n16
 	dAtA[i] = 0x32
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.WASM.Size()))
-	n15, err := m.WASM.MarshalTo(dAtA[i:])
+	n17, err := m.WASM.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n15
+	i += n17
 	if len(m.ContractMeta) > 0 {
 		for _, msg := range m.ContractMeta {
 			dAtA[i] = 0x3a
@@ -1384,11 +1523,11 @@ func (m *ContractMeta) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.CodeHash.Size()))
-	n16, err := m.CodeHash.MarshalTo(dAtA[i:])
+	n18, err := m.CodeHash.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n16
+	i += n18
 	if len(m.Meta) > 0 {
 		dAtA[i] = 0x12
 		i++
@@ -1465,20 +1604,20 @@ func (m *PermsTx) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPayload(dAtA, i, uint64(m.Input.Size()))
-		n17, err := m.Input.MarshalTo(dAtA[i:])
+		n19, err := m.Input.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n17
+		i += n19
 	}
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.PermArgs.Size()))
-	n18, err := m.PermArgs.MarshalTo(dAtA[i:])
+	n20, err := m.PermArgs.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n18
+	i += n20
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -1504,11 +1643,11 @@ func (m *NameTx) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPayload(dAtA, i, uint64(m.Input.Size()))
-		n19, err := m.Input.MarshalTo(dAtA[i:])
+		n21, err := m.Input.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n19
+		i += n21
 	}
 	if len(m.Name) > 0 {
 		dAtA[i] = 0x12
@@ -1552,11 +1691,11 @@ func (m *BondTx) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPayload(dAtA, i, uint64(m.Input.Size()))
-		n20, err := m.Input.MarshalTo(dAtA[i:])
+		n22, err := m.Input.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n20
+		i += n22
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -1583,21 +1722,104 @@ func (m *UnbondTx) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPayload(dAtA, i, uint64(m.Input.Size()))
-		n21, err := m.Input.MarshalTo(dAtA[i:])
+		n23, err := m.Input.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n21
+		i += n23
 	}
 	if m.Output != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintPayload(dAtA, i, uint64(m.Output.Size()))
-		n22, err := m.Output.MarshalTo(dAtA[i:])
+		n24, err := m.Output.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n22
+		i += n24
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *DelegateTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateTx) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Input != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.Input.Size()))
+		n25, err := m.Input.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n25
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.Validator.Size()))
+	n26, err := m.Validator.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n26
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *UndelegateTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UndelegateTx) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Input != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.Input.Size()))
+		n27, err := m.Input.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n27
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.Validator.Size()))
+	n28, err := m.Validator.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n28
+	if m.Amount != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.Amount))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -1669,11 +1891,11 @@ func (m *ProposalTx) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPayload(dAtA, i, uint64(m.Input.Size()))
-		n23, err := m.Input.MarshalTo(dAtA[i:])
+		n29, err := m.Input.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n23
+		i += n29
 	}
 	if m.VotingWeight != 0 {
 		dAtA[i] = 0x10
@@ -1684,21 +1906,21 @@ func (m *ProposalTx) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintPayload(dAtA, i, uint64(m.ProposalHash.Size()))
-		n24, err := m.ProposalHash.MarshalTo(dAtA[i:])
+		n30, err := m.ProposalHash.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n24
+		i += n30
 	}
 	if m.Proposal != nil {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintPayload(dAtA, i, uint64(m.Proposal.Size()))
-		n25, err := m.Proposal.MarshalTo(dAtA[i:])
+		n31, err := m.Proposal.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n25
+		i += n31
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -1769,11 +1991,11 @@ func (m *Vote) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.Address.Size()))
-	n26, err := m.Address.MarshalTo(dAtA[i:])
+	n32, err := m.Address.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n26
+	i += n32
 	if m.VotingWeight != 0 {
 		dAtA[i] = 0x10
 		i++
@@ -1816,11 +2038,11 @@ func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintPayload(dAtA, i, uint64(m.BatchTx.Size()))
-		n27, err := m.BatchTx.MarshalTo(dAtA[i:])
+		n33, err := m.BatchTx.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n27
+		i += n33
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -1847,21 +2069,21 @@ func (m *Ballot) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPayload(dAtA, i, uint64(m.Proposal.Size()))
-		n28, err := m.Proposal.MarshalTo(dAtA[i:])
+		n34, err := m.Proposal.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n28
+		i += n34
 	}
 	if m.FinalizingTx != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintPayload(dAtA, i, uint64(m.FinalizingTx.Size()))
-		n29, err := m.FinalizingTx.MarshalTo(dAtA[i:])
+		n35, err := m.FinalizingTx.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n29
+		i += n35
 	}
 	if m.ProposalState != 0 {
 		dAtA[i] = 0x20
@@ -1937,6 +2159,14 @@ func (m *Any) Size() (n int) {
 		l = m.ProposalTx.Size()
 		n += 1 + l + sovPayload(uint64(l))
 	}
+	if m.DelegateTx != nil {
+		l = m.DelegateTx.Size()
+		n += 1 + l + sovPayload(uint64(l))
+	}
+	if m.UndelegateTx != nil {
+		l = m.UndelegateTx.Size()
+		n += 1 + l + sovPayload(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2139,6 +2369,45 @@ func (m *UnbondTx) Size() (n int) {
 	return n
 }
 
+func (m *DelegateTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovPayload(uint64(l))
+	}
+	l = m.Validator.Size()
+	n += 1 + l + sovPayload(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UndelegateTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovPayload(uint64(l))
+	}
+	l = m.Validator.Size()
+	n += 1 + l + sovPayload(uint64(l))
+	if m.Amount != 0 {
+		n += 1 + sovPayload(uint64(m.Amount))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *GovTx) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2325,6 +2594,12 @@ func (this *Any) GetValue() interface{} {
 	if this.ProposalTx != nil {
 		return this.ProposalTx
 	}
+	if this.DelegateTx != nil {
+		return this.DelegateTx
+	}
+	if this.UndelegateTx != nil {
+		return this.UndelegateTx
+	}
 	return nil
 }
 
@@ -2348,6 +2623,10 @@ func (this *Any) SetValue(value interface{}) bool {
 		this.BatchTx = vt
 	case *ProposalTx:
 		this.ProposalTx = vt
+	case *DelegateTx:
+		this.DelegateTx = vt
+	case *UndelegateTx:
+		this.UndelegateTx = vt
 	default:
 		return false
 	}
@@ -2706,22 +2985,94 @@ func (m *Any) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPayload(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateTx", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthPayload
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthPayload
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			if m.DelegateTx == nil {
+				m.DelegateTx = &DelegateTx{}
+			}
+			if err := m.DelegateTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UndelegateTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UndelegateTx == nil {
+				m.UndelegateTx = &UndelegateTx{}
+			}
+			if err := m.UndelegateTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayload(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
 			iNdEx += skippy
 		}
 	}
@@ -3978,6 +4329,271 @@ func (m *UnbondTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DelegateTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayload
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegateTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegateTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Input == nil {
+				m.Input = &TxInput{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayload(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UndelegateTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayload
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UndelegateTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UndelegateTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Input == nil {
+				m.Input = &TxInput{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayload(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GovTx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,35 @@
+package payload
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/crypto"
+)
+
+func NewUndelegateTx(delegator, validator crypto.Address, amount uint64) *UndelegateTx {
+	return &UndelegateTx{
+		Input: &TxInput{
+			Address: delegator,
+		},
+		Validator: validator,
+		Amount:    amount,
+	}
+}
+
+func (tx *UndelegateTx) Type() Type {
+	return TypeUndelegate
+}
+
+func (tx *UndelegateTx) GetInputs() []*TxInput {
+	return []*TxInput{tx.Input}
+}
+
+func (tx *UndelegateTx) String() string {
+	return fmt.Sprintf("UndelegateTx{%v <- %v: %v}", tx.Input.Address, tx.Validator, tx.Amount)
+}
+
+func (tx *UndelegateTx) Any() *Any {
+	return &Any{
+		UndelegateTx: tx,
+	}
+}
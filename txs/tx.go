@@ -248,5 +248,11 @@ func EnvelopeFromAny(chainID string, p *payload.Any) *Envelope {
 	if p.UnbondTx != nil {
 		return Enclose(chainID, p.UnbondTx)
 	}
+	if p.DelegateTx != nil {
+		return Enclose(chainID, p.DelegateTx)
+	}
+	if p.UndelegateTx != nil {
+		return Enclose(chainID, p.UndelegateTx)
+	}
 	return nil
 }
@@ -156,6 +156,22 @@ func TestUnbondTxSignable(t *testing.T) {
 	testTxSignVerify(t, unbondTx)
 }
 
+func TestDelegateTxSignable(t *testing.T) {
+	delegateTx := payload.NewDelegateTx(makePrivateAccount("input1").GetAddress(),
+		makePrivateAccount("validator1").GetAddress(), 12345)
+	delegateTx.Input.Sequence = 67890
+	testTxMarshalJSON(t, delegateTx)
+	testTxSignVerify(t, delegateTx)
+}
+
+func TestUndelegateTxSignable(t *testing.T) {
+	undelegateTx := payload.NewUndelegateTx(makePrivateAccount("input1").GetAddress(),
+		makePrivateAccount("validator1").GetAddress(), 12345)
+	undelegateTx.Input.Sequence = 67890
+	testTxMarshalJSON(t, undelegateTx)
+	testTxSignVerify(t, undelegateTx)
+}
+
 func TestPermissionsTxSignable(t *testing.T) {
 	permsTx := &payload.PermsTx{
 		Input: &payload.TxInput{
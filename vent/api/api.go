@@ -0,0 +1,217 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/graphql-go/graphql"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/vent/config"
+	"github.com/hyperledger/burrow/vent/sqldb"
+	"github.com/hyperledger/burrow/vent/sqldb/adapters"
+	"github.com/hyperledger/burrow/vent/sqlsol"
+	"github.com/hyperledger/burrow/vent/types"
+)
+
+const (
+	RESTPath    = "/api/v1/"
+	GraphQLPath = "/graphql"
+)
+
+// API serves a read API generated from the tables of a projection: REST endpoints under RESTPath and a GraphQL schema
+// at GraphQLPath. Both offer subscriptions to the Notify channels of the projection when the database adapter
+// supports notification triggers.
+type API struct {
+	db         *sqldb.SQLDB
+	projection *sqlsol.Projection
+	// Map from channel name to the columns included in its payload
+	channels map[string][]*types.SQLTableColumn
+	// Nil if notifications are not supported
+	notifier *Notifier
+	schema   graphql.Schema
+	// Map from subscription field name to channel name
+	subscriptionFields map[string]string
+	mux                *http.ServeMux
+	log                *logging.Logger
+}
+
+// QueryError is returned for queries that cannot be made of the projection
+type QueryError struct {
+	Err error
+}
+
+func (qe *QueryError) Error() string {
+	return qe.Err.Error()
+}
+
+// NewAPI connects to the database configured by cfg to serve the tables of projection
+func NewAPI(cfg *config.VentConfig, projection *sqlsol.Projection, log *logging.Logger) (*API, error) {
+	db, err := sqldb.NewSQLDB(types.SQLConnection{
+		DBAdapter: cfg.DBAdapter,
+		DBURL:     cfg.DBURL,
+		DBSchema:  cfg.DBSchema,
+		Log:       log,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("read API could not connect to database: %v", err)
+	}
+	a := &API{
+		db:         db,
+		projection: projection,
+		channels:   notifyChannels(projection),
+		mux:        http.NewServeMux(),
+		log:        log.WithScope("API"),
+	}
+	if _, ok := db.DBAdapter.(adapters.DBNotifyTriggerAdapter); ok && len(a.channels) > 0 {
+		channels := make([]string, 0, len(a.channels))
+		for channel := range a.channels {
+			channels = append(channels, channel)
+		}
+		sort.Strings(channels)
+		a.notifier, err = NewNotifier(cfg.DBURL, channels, a.log)
+		if err != nil {
+			db.Close()
+			return nil, err
+		}
+	}
+	err = a.buildSchema()
+	if err != nil {
+		a.Close()
+		return nil, fmt.Errorf("could not generate GraphQL schema from projection: %v", err)
+	}
+	a.mux.HandleFunc(RESTPath, a.serveREST)
+	a.mux.HandleFunc(GraphQLPath, a.serveGraphQL)
+	return a, nil
+}
+
+func (a *API) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	a.mux.ServeHTTP(w, r)
+}
+
+func (a *API) Close() {
+	if a.notifier != nil {
+		a.notifier.Close()
+	}
+	a.db.Close()
+}
+
+// Select returns a page of the rows of a table matching query and the total number of matching rows
+func (a *API) Select(ctx context.Context, tableName string, query Query) ([]map[string]interface{}, uint64, error) {
+	table, ok := a.projection.Tables[tableName]
+	if !ok {
+		return nil, 0, &QueryError{fmt.Errorf("no table named %s in projection", tableName)}
+	}
+	selectSQL, countSQL, args, err := selectQuery(a.db.DBAdapter, table, query)
+	if err != nil {
+		return nil, 0, &QueryError{err}
+	}
+	rows, err := a.db.DB.QueryContext(ctx, a.db.DB.Rebind(selectSQL), args...)
+	if err != nil {
+		a.log.InfoMsg("Error selecting rows", "err", err, "query", selectSQL)
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	results := make([]map[string]interface{}, 0)
+	values := make([]interface{}, len(table.Columns))
+	pointers := make([]interface{}, len(table.Columns))
+	for i := range values {
+		pointers[i] = &values[i]
+	}
+	for rows.Next() {
+		err = rows.Scan(pointers...)
+		if err != nil {
+			return nil, 0, err
+		}
+		row := make(map[string]interface{}, len(table.Columns))
+		for i, column := range table.Columns {
+			row[column.Name] = columnValue(column, values[i])
+		}
+		results = append(results, row)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var total uint64
+	err = a.db.DB.QueryRowContext(ctx, a.db.DB.Rebind(countSQL), args...).Scan(&total)
+	if err != nil {
+		a.log.InfoMsg("Error counting rows", "err", err, "query", countSQL)
+		return nil, 0, err
+	}
+	return results, total, nil
+}
+
+// Get returns the row of a table with the given primary key values (in column order) or nil if there is none
+func (a *API) Get(ctx context.Context, tableName string, key []string) (map[string]interface{}, error) {
+	table, ok := a.projection.Tables[tableName]
+	if !ok {
+		return nil, &QueryError{fmt.Errorf("no table named %s in projection", tableName)}
+	}
+	query := Query{Limit: 1}
+	for _, column := range table.Columns {
+		if column.Primary {
+			if len(query.Filters) == len(key) {
+				return nil, &QueryError{fmt.Errorf("table %s has more primary key columns than the %d values given",
+					tableName, len(key))}
+			}
+			query.Filters = append(query.Filters, Filter{
+				Column:   column.Name,
+				Operator: OperatorEqual,
+				Value:    key[len(query.Filters)],
+			})
+		}
+	}
+	if len(query.Filters) != len(key) {
+		return nil, &QueryError{fmt.Errorf("table %s has %d primary key columns but %d values were given",
+			tableName, len(query.Filters), len(key))}
+	}
+	rows, _, err := a.Select(ctx, tableName, query)
+	if err != nil || len(rows) == 0 {
+		return nil, err
+	}
+	return rows[0], nil
+}
+
+// Collect the columns included in the payload of each notification channel across all tables
+func notifyChannels(projection *sqlsol.Projection) map[string][]*types.SQLTableColumn {
+	channels := make(map[string][]*types.SQLTableColumn)
+	for _, table := range projection.Tables {
+		for channel, columnNames := range table.NotifyChannels {
+			for _, columnName := range columnNames {
+				column := table.GetColumn(columnName)
+				if column != nil && !hasColumn(channels[channel], columnName) {
+					channels[channel] = append(channels[channel], column)
+				}
+			}
+		}
+	}
+	for _, columns := range channels {
+		sort.Slice(columns, func(i, j int) bool {
+			return columns[i].Name < columns[j].Name
+		})
+	}
+	return channels
+}
+
+func hasColumn(columns []*types.SQLTableColumn, name string) bool {
+	for _, column := range columns {
+		if column.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the names of the projection tables in order
+func (a *API) tableNames() []string {
+	names := make([]string, 0, len(a.projection.Tables))
+	for name := range a.projection.Tables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
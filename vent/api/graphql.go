@@ -0,0 +1,416 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"sync"
+
+	"github.com/gorilla/websocket"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/hyperledger/burrow/vent/types"
+)
+
+// Websocket subprotocol used for GraphQL subscriptions (that of subscriptions-transport-ws)
+const graphqlWSProtocol = "graphql-ws"
+
+// graphql-ws message types
+const (
+	gqlConnectionInit      = "connection_init"
+	gqlConnectionAck       = "connection_ack"
+	gqlConnectionError     = "connection_error"
+	gqlConnectionTerminate = "connection_terminate"
+	gqlStart               = "start"
+	gqlStop                = "stop"
+	gqlData                = "data"
+	gqlError               = "error"
+	gqlComplete            = "complete"
+)
+
+var invalidNameChars = regexp.MustCompile("[^_0-9A-Za-z]")
+
+var upgrader = websocket.Upgrader{Subprotocols: []string{graphqlWSProtocol}}
+
+type graphqlRequest struct {
+	Query         string                 `json:"query"`
+	Variables     map[string]interface{} `json:"variables"`
+	OperationName string                 `json:"operationName"`
+}
+
+type graphqlMessage struct {
+	ID      string          `json:"id,omitempty"`
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+// Generates the GraphQL schema for the projection. For each table there is a query field named after the table taking
+// where, orderBy, limit, and offset arguments, and (if the table has a primary key) a <table>ByKey field taking the
+// primary key columns. For each notification channel there is a subscription field named after the channel.
+func (a *API) buildSchema() error {
+	query := graphql.Fields{}
+	for _, tableName := range a.tableNames() {
+		table := a.projection.Tables[tableName]
+		name := graphqlName(tableName)
+		if _, ok := query[name]; ok {
+			return fmt.Errorf("table %s has the same GraphQL name as another table", tableName)
+		}
+		rowType := graphql.NewObject(graphql.ObjectConfig{
+			Name:   name,
+			Fields: columnFields(table.Columns, columnType),
+		})
+
+		whereFields := graphql.InputObjectConfigFieldMap{}
+		filters := make(map[string]Filter)
+		var primaryArgs []*types.SQLTableColumn
+		for _, column := range table.Columns {
+			for _, op := range operators {
+				field := graphqlName(column.Name)
+				if op != OperatorEqual {
+					field += "_" + string(op)
+				}
+				whereFields[field] = &graphql.InputObjectFieldConfig{Type: graphql.String}
+				filters[field] = Filter{Column: column.Name, Operator: op}
+			}
+			if column.Primary {
+				primaryArgs = append(primaryArgs, column)
+			}
+		}
+
+		query[name] = &graphql.Field{
+			Type: graphql.NewList(rowType),
+			Args: graphql.FieldConfigArgument{
+				"where": &graphql.ArgumentConfig{
+					Type: graphql.NewInputObject(graphql.InputObjectConfig{
+						Name:   name + "Where",
+						Fields: whereFields,
+					}),
+					Description: "Filters on columns, <column>_<op> compares by op, bytes are given in hex",
+				},
+				"orderBy": &graphql.ArgumentConfig{
+					Type:        graphql.NewList(graphql.String),
+					Description: "Columns to order by, descending where prefixed with '-'",
+				},
+				"limit":  &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: DefaultLimit},
+				"offset": &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: 0},
+			},
+			Resolve: a.resolveRows(tableName, filters),
+		}
+
+		if len(primaryArgs) > 0 {
+			args := graphql.FieldConfigArgument{}
+			for _, column := range primaryArgs {
+				args[graphqlName(column.Name)] = &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)}
+			}
+			query[name+"ByKey"] = &graphql.Field{
+				Type:    rowType,
+				Args:    args,
+				Resolve: a.resolveRow(tableName, primaryArgs),
+			}
+		}
+	}
+
+	config := graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{Name: "Query", Fields: query}),
+	}
+
+	if len(a.channels) > 0 {
+		channels := make([]string, 0, len(a.channels))
+		for channel := range a.channels {
+			channels = append(channels, channel)
+		}
+		sort.Strings(channels)
+		subscription := graphql.Fields{}
+		a.subscriptionFields = make(map[string]string)
+		for _, channel := range channels {
+			name := graphqlName(channel)
+			if _, ok := subscription[name]; ok {
+				return fmt.Errorf("channel %s has the same GraphQL name as another channel", channel)
+			}
+			// Payload values are as encoded by the database so are all given as strings
+			columns := append([]*types.SQLTableColumn{{Name: types.DefaultSQLColumnNames.Action}},
+				a.channels[channel]...)
+			channel := channel
+			subscription[name] = &graphql.Field{
+				Type: graphql.NewObject(graphql.ObjectConfig{
+					Name: name + "Notification",
+					Fields: columnFields(columns, func(*types.SQLTableColumn) graphql.Output {
+						return graphql.String
+					}),
+				}),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					root, _ := p.Source.(map[string]interface{})
+					return root[channel], nil
+				},
+			}
+			a.subscriptionFields[name] = channel
+		}
+		config.Subscription = graphql.NewObject(graphql.ObjectConfig{Name: "Subscription", Fields: subscription})
+	}
+
+	var err error
+	a.schema, err = graphql.NewSchema(config)
+	return err
+}
+
+func (a *API) resolveRows(tableName string, filters map[string]Filter) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		query := Query{}
+		if where, ok := p.Args["where"].(map[string]interface{}); ok {
+			fields := make([]string, 0, len(where))
+			for field := range where {
+				fields = append(fields, field)
+			}
+			sort.Strings(fields)
+			for _, field := range fields {
+				filter := filters[field]
+				filter.Value = fmt.Sprint(where[field])
+				query.Filters = append(query.Filters, filter)
+			}
+		}
+		if orderBy, ok := p.Args["orderBy"].([]interface{}); ok {
+			for _, column := range orderBy {
+				query.Sort = append(query.Sort, ParseSort(fmt.Sprint(column))...)
+			}
+		}
+		if limit, ok := p.Args["limit"].(int); ok && limit > 0 {
+			query.Limit = uint64(limit)
+		}
+		if offset, ok := p.Args["offset"].(int); ok && offset > 0 {
+			query.Offset = uint64(offset)
+		}
+		rows, _, err := a.Select(p.Context, tableName, query)
+		return rows, err
+	}
+}
+
+func (a *API) resolveRow(tableName string, primary []*types.SQLTableColumn) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		key := make([]string, len(primary))
+		for i, column := range primary {
+			key[i] = fmt.Sprint(p.Args[graphqlName(column.Name)])
+		}
+		row, err := a.Get(p.Context, tableName, key)
+		if row == nil {
+			// Avoid returning a typed nil
+			return nil, err
+		}
+		return row, err
+	}
+}
+
+func (a *API) serveGraphQL(w http.ResponseWriter, r *http.Request) {
+	if websocket.IsWebSocketUpgrade(r) {
+		a.serveGraphQLWebsocket(w, r)
+		return
+	}
+	request := graphqlRequest{}
+	switch r.Method {
+	case http.MethodGet:
+		request.Query = r.URL.Query().Get("query")
+		request.OperationName = r.URL.Query().Get("operationName")
+		if variables := r.URL.Query().Get("variables"); variables != "" {
+			err := json.Unmarshal([]byte(variables), &request.Variables)
+			if err != nil {
+				writeError(w, http.StatusBadRequest, fmt.Errorf("could not decode variables: %v", err))
+				return
+			}
+		}
+	case http.MethodPost:
+		err := json.NewDecoder(r.Body).Decode(&request)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("could not decode GraphQL request: %v", err))
+			return
+		}
+	default:
+		writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+		return
+	}
+	op, err := operation(request)
+	if err == nil && op.Operation == ast.OperationTypeSubscription {
+		err = fmt.Errorf("subscriptions are served over a websocket with the %s protocol", graphqlWSProtocol)
+	}
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, graphql.Do(graphql.Params{
+		Schema:         a.schema,
+		RequestString:  request.Query,
+		VariableValues: request.Variables,
+		OperationName:  request.OperationName,
+		Context:        r.Context(),
+	}))
+}
+
+// Serves queries and subscriptions over the graphql-ws protocol
+func (a *API) serveGraphQLWebsocket(w http.ResponseWriter, r *http.Request) {
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// The upgrader has already responded
+		a.log.InfoMsg("Could not upgrade GraphQL websocket", "err", err)
+		return
+	}
+	defer conn.Close()
+
+	var writeMtx sync.Mutex
+	send := func(id, messageType string, payload interface{}) {
+		msg := graphqlMessage{ID: id, Type: messageType}
+		if payload != nil {
+			msg.Payload, _ = json.Marshal(payload)
+		}
+		writeMtx.Lock()
+		defer writeMtx.Unlock()
+		_ = conn.WriteJSON(msg)
+	}
+
+	subscriptions := make(map[string]func())
+	defer func() {
+		for _, cancel := range subscriptions {
+			cancel()
+		}
+	}()
+
+	for {
+		msg := graphqlMessage{}
+		err := conn.ReadJSON(&msg)
+		if err != nil {
+			return
+		}
+		switch msg.Type {
+		case gqlConnectionInit:
+			send("", gqlConnectionAck, nil)
+		case gqlConnectionTerminate:
+			return
+		case gqlStop:
+			if cancel, ok := subscriptions[msg.ID]; ok {
+				cancel()
+				delete(subscriptions, msg.ID)
+			}
+		case gqlStart:
+			request := graphqlRequest{}
+			err = json.Unmarshal(msg.Payload, &request)
+			if err != nil {
+				send(msg.ID, gqlError, errorPayload(err))
+				continue
+			}
+			op, err := operation(request)
+			if err != nil {
+				send(msg.ID, gqlError, errorPayload(err))
+				continue
+			}
+			params := graphql.Params{
+				Schema:         a.schema,
+				RequestString:  request.Query,
+				VariableValues: request.Variables,
+				OperationName:  request.OperationName,
+				Context:        r.Context(),
+			}
+			if op.Operation != ast.OperationTypeSubscription {
+				send(msg.ID, gqlData, graphql.Do(params))
+				send(msg.ID, gqlComplete, nil)
+				continue
+			}
+			if _, ok := subscriptions[msg.ID]; ok {
+				send(msg.ID, gqlError, errorPayload(fmt.Errorf("subscription %s already started", msg.ID)))
+				continue
+			}
+			if a.notifier == nil {
+				send(msg.ID, gqlError, errorPayload(fmt.Errorf("database adapter does not support notifications")))
+				continue
+			}
+			var channels []string
+			for _, selection := range op.SelectionSet.Selections {
+				if field, ok := selection.(*ast.Field); ok {
+					if channel, ok := a.subscriptionFields[field.Name.Value]; ok {
+						channels = append(channels, channel)
+					}
+				}
+			}
+			notifications, cancel := a.notifier.Subscribe(channels...)
+			subscriptions[msg.ID] = cancel
+			go func(id string) {
+				for notification := range notifications {
+					params.RootObject = map[string]interface{}{notification.Channel: notification.Payload}
+					send(id, gqlData, graphql.Do(params))
+				}
+				send(id, gqlComplete, nil)
+			}(msg.ID)
+		default:
+			send(msg.ID, gqlConnectionError, errorPayload(fmt.Errorf("unknown message type %s", msg.Type)))
+		}
+	}
+}
+
+// Parse the request to find the operation it selects
+func operation(request graphqlRequest) (*ast.OperationDefinition, error) {
+	doc, err := parser.Parse(parser.ParseParams{Source: request.Query})
+	if err != nil {
+		return nil, err
+	}
+	var op *ast.OperationDefinition
+	for _, definition := range doc.Definitions {
+		if od, ok := definition.(*ast.OperationDefinition); ok {
+			if request.OperationName == "" || (od.Name != nil && od.Name.Value == request.OperationName) {
+				if op != nil {
+					return nil, fmt.Errorf("operationName is required when a request contains multiple operations")
+				}
+				op = od
+			}
+		}
+	}
+	if op == nil {
+		return nil, fmt.Errorf("no operation named '%s' in request", request.OperationName)
+	}
+	return op, nil
+}
+
+func errorPayload(err error) map[string]interface{} {
+	return map[string]interface{}{"message": err.Error()}
+}
+
+// Fields of a row object, resolved from a map of column name to value
+func columnFields(columns []*types.SQLTableColumn,
+	fieldType func(*types.SQLTableColumn) graphql.Output) graphql.Fields {
+
+	fields := graphql.Fields{}
+	for _, column := range columns {
+		columnName := column.Name
+		fields[graphqlName(columnName)] = &graphql.Field{
+			Type: fieldType(column),
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				row, _ := p.Source.(map[string]interface{})
+				if raw, ok := row[columnName].(json.RawMessage); ok {
+					return string(raw), nil
+				}
+				return row[columnName], nil
+			},
+		}
+	}
+	return fields
+}
+
+// The GraphQL scalar for a column, integers that may exceed 32 bits are given as strings
+func columnType(column *types.SQLTableColumn) graphql.Output {
+	switch column.Type {
+	case types.SQLColumnTypeBool:
+		return graphql.Boolean
+	case types.SQLColumnTypeInt:
+		return graphql.Int
+	case types.SQLColumnTypeTimeStamp:
+		return graphql.DateTime
+	}
+	return graphql.String
+}
+
+// Make a valid GraphQL name from a table, column, or channel name
+func graphqlName(name string) string {
+	name = invalidNameChars.ReplaceAllString(name, "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "_" + name
+	}
+	return name
+}
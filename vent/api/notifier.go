@@ -0,0 +1,133 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/logging/structure"
+	"github.com/lib/pq"
+)
+
+// Number of notifications buffered for each subscriber before further notifications are dropped for it
+const notificationBufferSize = 100
+
+// Notification is a payload sent by a notification trigger on one of the Notify channels of a projection
+type Notification struct {
+	Channel string
+	// Map from column name to value, plus the _action that caused the notification
+	Payload map[string]interface{}
+}
+
+// Notifier listens on the notification channels of a projection in Postgres and fans out notifications to subscribers
+type Notifier struct {
+	listener *pq.Listener
+	mtx      sync.RWMutex
+	// Subscriber channels by notification channel
+	subscribers map[string]map[chan Notification]struct{}
+	closed      bool
+	log         *logging.Logger
+}
+
+func NewNotifier(dbURL string, channels []string, log *logging.Logger) (*Notifier, error) {
+	n := &Notifier{
+		subscribers: make(map[string]map[chan Notification]struct{}),
+		log:         log,
+	}
+	n.listener = pq.NewListener(dbURL, time.Second, time.Minute, func(event pq.ListenerEventType, err error) {
+		if err != nil {
+			n.log.InfoMsg("Notification listener error", structure.ErrorKey, err)
+		}
+	})
+	for _, channel := range channels {
+		err := n.listener.Listen(channel)
+		if err != nil {
+			n.listener.Close()
+			return nil, fmt.Errorf("could not listen for notifications on channel %s: %v", channel, err)
+		}
+	}
+	go n.run()
+	return n, nil
+}
+
+// Subscribe returns a channel receiving notifications sent on any of channels and a function that ends the
+// subscription and closes the channel
+func (n *Notifier) Subscribe(channels ...string) (<-chan Notification, func()) {
+	ch := make(chan Notification, notificationBufferSize)
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	if n.closed {
+		close(ch)
+		return ch, func() {}
+	}
+	for _, channel := range channels {
+		if n.subscribers[channel] == nil {
+			n.subscribers[channel] = make(map[chan Notification]struct{})
+		}
+		n.subscribers[channel][ch] = struct{}{}
+	}
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			n.mtx.Lock()
+			defer n.mtx.Unlock()
+			if n.closed {
+				return
+			}
+			for _, channel := range channels {
+				delete(n.subscribers[channel], ch)
+			}
+			close(ch)
+		})
+	}
+}
+
+func (n *Notifier) Close() error {
+	return n.listener.Close()
+}
+
+func (n *Notifier) run() {
+	for pn := range n.listener.Notify {
+		// A nil notification is sent when the connection is re-established, any notifications in between are lost
+		if pn == nil {
+			continue
+		}
+		payload := make(map[string]interface{})
+		decoder := json.NewDecoder(bytes.NewBufferString(pn.Extra))
+		// Preserve the precision of large integers
+		decoder.UseNumber()
+		err := decoder.Decode(&payload)
+		if err != nil {
+			n.log.InfoMsg("Could not decode notification payload", structure.ErrorKey, err,
+				"channel", pn.Channel, "payload", pn.Extra)
+			continue
+		}
+		notification := Notification{Channel: pn.Channel, Payload: payload}
+		n.mtx.RLock()
+		for ch := range n.subscribers[pn.Channel] {
+			select {
+			case ch <- notification:
+			default:
+				n.log.InfoMsg("Dropping notification for slow subscriber", "channel", pn.Channel)
+			}
+		}
+		n.mtx.RUnlock()
+	}
+	// The listener has been closed
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	n.closed = true
+	closed := make(map[chan Notification]struct{})
+	for _, subscribers := range n.subscribers {
+		for ch := range subscribers {
+			if _, ok := closed[ch]; !ok {
+				close(ch)
+				closed[ch] = struct{}{}
+			}
+		}
+	}
+	n.subscribers = nil
+}
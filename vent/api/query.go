@@ -0,0 +1,208 @@
+package api
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hyperledger/burrow/vent/sqldb/adapters"
+	"github.com/hyperledger/burrow/vent/types"
+)
+
+const (
+	DefaultLimit = 100
+	MaxLimit     = 1000
+)
+
+// Operator compares a column with a value in a Filter
+type Operator string
+
+const (
+	OperatorEqual              Operator = "eq"
+	OperatorNotEqual           Operator = "ne"
+	OperatorGreaterThan        Operator = "gt"
+	OperatorGreaterThanOrEqual Operator = "gte"
+	OperatorLessThan           Operator = "lt"
+	OperatorLessThanOrEqual    Operator = "lte"
+	OperatorLike               Operator = "like"
+)
+
+var operatorSQL = map[Operator]string{
+	OperatorEqual:              "=",
+	OperatorNotEqual:           "<>",
+	OperatorGreaterThan:        ">",
+	OperatorGreaterThanOrEqual: ">=",
+	OperatorLessThan:           "<",
+	OperatorLessThanOrEqual:    "<=",
+	OperatorLike:               "LIKE",
+}
+
+// Operators in the order they are offered by the API
+var operators = []Operator{OperatorEqual, OperatorNotEqual, OperatorGreaterThan, OperatorGreaterThanOrEqual,
+	OperatorLessThan, OperatorLessThanOrEqual, OperatorLike}
+
+type Filter struct {
+	Column   string
+	Operator Operator
+	// Value as given by the client, parsed according to the type of the column
+	Value string
+}
+
+type Sort struct {
+	Column     string
+	Descending bool
+}
+
+// Query selects, orders, and pages the rows of a projection table
+type Query struct {
+	Filters []Filter
+	Sort    []Sort
+	Limit   uint64
+	Offset  uint64
+}
+
+// ParseSort parses a comma separated list of columns, each optionally prefixed by '-' for descending order
+func ParseSort(sort string) []Sort {
+	var sorts []Sort
+	for _, column := range strings.Split(sort, ",") {
+		column = strings.TrimSpace(column)
+		if column == "" {
+			continue
+		}
+		if strings.HasPrefix(column, "-") {
+			sorts = append(sorts, Sort{Column: column[1:], Descending: true})
+		} else {
+			sorts = append(sorts, Sort{Column: column})
+		}
+	}
+	return sorts
+}
+
+// Builds a select query (and one counting all matching rows) for a table, the queries use '?' placeholders that
+// must be rebound for the database driver
+func selectQuery(adapter adapters.DBAdapter, table *types.SQLTable, query Query) (string, string, []interface{}, error) {
+	where, args, err := whereClause(adapter, table, query.Filters)
+	if err != nil {
+		return "", "", nil, err
+	}
+	from := adapter.SchemaName(table.Name)
+
+	columns := make([]string, len(table.Columns))
+	for i, column := range table.Columns {
+		columns[i] = adapter.SecureName(column.Name)
+	}
+
+	sorts := query.Sort
+	if len(sorts) == 0 {
+		// Order by primary key by default so that pages are stable
+		for _, column := range table.Columns {
+			if column.Primary {
+				sorts = append(sorts, Sort{Column: column.Name})
+			}
+		}
+	}
+	var orderBy []string
+	for _, sort := range sorts {
+		if table.GetColumn(sort.Column) == nil {
+			return "", "", nil, fmt.Errorf("cannot sort by unknown column '%s' of table %s", sort.Column, table.Name)
+		}
+		direction := "ASC"
+		if sort.Descending {
+			direction = "DESC"
+		}
+		orderBy = append(orderBy, fmt.Sprintf("%s %s", adapter.SecureName(sort.Column), direction))
+	}
+
+	limit := query.Limit
+	if limit == 0 {
+		limit = DefaultLimit
+	}
+	if limit > MaxLimit {
+		return "", "", nil, fmt.Errorf("limit %d is greater than the maximum of %d", limit, MaxLimit)
+	}
+
+	selectQuery := fmt.Sprintf("SELECT %s FROM %s%s", strings.Join(columns, ", "), from, where)
+	if len(orderBy) > 0 {
+		selectQuery += " ORDER BY " + strings.Join(orderBy, ", ")
+	}
+	selectQuery += fmt.Sprintf(" LIMIT %d OFFSET %d;", limit, query.Offset)
+
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM %s%s;", from, where)
+	return selectQuery, countQuery, args, nil
+}
+
+func whereClause(adapter adapters.DBAdapter, table *types.SQLTable, filters []Filter) (string, []interface{}, error) {
+	if len(filters) == 0 {
+		return "", nil, nil
+	}
+	conditions := make([]string, len(filters))
+	args := make([]interface{}, len(filters))
+	for i, filter := range filters {
+		column := table.GetColumn(filter.Column)
+		if column == nil {
+			return "", nil, fmt.Errorf("cannot filter on unknown column '%s' of table %s", filter.Column, table.Name)
+		}
+		op, ok := operatorSQL[filter.Operator]
+		if !ok {
+			return "", nil, fmt.Errorf("unknown filter operator '%s', must be one of %v", filter.Operator, operators)
+		}
+		value, err := parseValue(column, filter.Value)
+		if err != nil {
+			return "", nil, err
+		}
+		conditions[i] = fmt.Sprintf("%s %s ?", adapter.SecureName(column.Name), op)
+		args[i] = value
+	}
+	return " WHERE " + strings.Join(conditions, " AND "), args, nil
+}
+
+// Parse a value given by a client into the type expected by the driver for a column
+func parseValue(column *types.SQLTableColumn, value string) (interface{}, error) {
+	switch column.Type {
+	case types.SQLColumnTypeByteA:
+		bs, err := hex.DecodeString(value)
+		if err != nil {
+			return nil, fmt.Errorf("value for column %s must be hex encoded: %v", column.Name, err)
+		}
+		return bs, nil
+	case types.SQLColumnTypeBool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("value for column %s must be a boolean: %v", column.Name, err)
+		}
+		return b, nil
+	case types.SQLColumnTypeInt, types.SQLColumnTypeSerial, types.SQLColumnTypeBigInt:
+		i, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("value for column %s must be an integer: %v", column.Name, err)
+		}
+		return i, nil
+	}
+	return value, nil
+}
+
+// Convert a value scanned from a column to the form in which the API returns it
+func columnValue(column *types.SQLTableColumn, value interface{}) interface{} {
+	bs, ok := value.([]byte)
+	if !ok {
+		return value
+	}
+	switch column.Type {
+	case types.SQLColumnTypeByteA:
+		return strings.ToUpper(hex.EncodeToString(bs))
+	case types.SQLColumnTypeJSON:
+		return json.RawMessage(append([]byte(nil), bs...))
+	case types.SQLColumnTypeInt, types.SQLColumnTypeSerial, types.SQLColumnTypeBigInt:
+		// Some drivers return integers as text
+		if i, err := strconv.ParseInt(string(bs), 10, 64); err == nil {
+			return i
+		}
+	case types.SQLColumnTypeBool:
+		if b, err := strconv.ParseBool(string(bs)); err == nil {
+			return b
+		}
+	}
+	return string(bs)
+}
@@ -0,0 +1,78 @@
+package api
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/vent/sqldb/adapters"
+	"github.com/hyperledger/burrow/vent/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testTable = &types.SQLTable{
+	Name: "Accounts",
+	Columns: []*types.SQLTableColumn{
+		{Name: "address", Type: types.SQLColumnTypeByteA, Primary: true},
+		{Name: "name", Type: types.SQLColumnTypeText},
+		{Name: "balance", Type: types.SQLColumnTypeBigInt},
+	},
+}
+
+func TestParseQuery(t *testing.T) {
+	values, err := url.ParseQuery("name=frank&balance[gte]=10&sort=-balance,name&limit=5&offset=10")
+	require.NoError(t, err)
+	query, err := ParseQuery(values)
+	require.NoError(t, err)
+	assert.Equal(t, Query{
+		Filters: []Filter{
+			{Column: "balance", Operator: OperatorGreaterThanOrEqual, Value: "10"},
+			{Column: "name", Operator: OperatorEqual, Value: "frank"},
+		},
+		Sort:   []Sort{{Column: "balance", Descending: true}, {Column: "name"}},
+		Limit:  5,
+		Offset: 10,
+	}, query)
+
+	_, err = ParseQuery(url.Values{"limit": {"lots"}})
+	assert.Error(t, err)
+}
+
+func TestSelectQuery(t *testing.T) {
+	adapter := adapters.NewPostgresAdapter("vent", types.DefaultSQLNames, logging.NewNoopLogger())
+
+	selectSQL, countSQL, args, err := selectQuery(adapter, testTable, Query{
+		Filters: []Filter{
+			{Column: "address", Operator: OperatorEqual, Value: "0102"},
+			{Column: "balance", Operator: OperatorGreaterThan, Value: "10"},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, `SELECT "address", "name", "balance" FROM vent."Accounts" WHERE "address" = ? AND "balance" > ? `+
+		`ORDER BY "address" ASC LIMIT 100 OFFSET 0;`, selectSQL)
+	assert.Equal(t, `SELECT COUNT(*) FROM vent."Accounts" WHERE "address" = ? AND "balance" > ?;`, countSQL)
+	assert.Equal(t, []interface{}{[]byte{1, 2}, int64(10)}, args)
+
+	_, _, _, err = selectQuery(adapter, testTable, Query{Filters: []Filter{{Column: "nope", Operator: OperatorEqual}}})
+	assert.Error(t, err)
+	_, _, _, err = selectQuery(adapter, testTable, Query{Filters: []Filter{{Column: "name", Operator: "is"}}})
+	assert.Error(t, err)
+	_, _, _, err = selectQuery(adapter, testTable, Query{Sort: []Sort{{Column: "nope"}}})
+	assert.Error(t, err)
+	_, _, _, err = selectQuery(adapter, testTable, Query{Limit: MaxLimit + 1})
+	assert.Error(t, err)
+}
+
+func TestColumnValue(t *testing.T) {
+	assert.Equal(t, "0102", columnValue(testTable.Columns[0], []byte{1, 2}))
+	assert.Equal(t, "frank", columnValue(testTable.Columns[1], []byte("frank")))
+	assert.Equal(t, int64(42), columnValue(testTable.Columns[2], []byte("42")))
+	assert.Equal(t, int64(42), columnValue(testTable.Columns[2], int64(42)))
+}
+
+func TestGraphQLName(t *testing.T) {
+	assert.Equal(t, "Accounts", graphqlName("Accounts"))
+	assert.Equal(t, "user_accounts", graphqlName("user-accounts"))
+	assert.Equal(t, "_1st", graphqlName("1st"))
+}
@@ -0,0 +1,227 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// TableInfo describes a projection table in the REST API
+type TableInfo struct {
+	Name    string
+	Columns []ColumnInfo
+	// Notification channels on which changes to the table are published
+	NotifyChannels []string `json:",omitempty"`
+}
+
+type ColumnInfo struct {
+	Name    string
+	Type    string
+	Primary bool `json:",omitempty"`
+}
+
+// Page is the response to a REST collection request
+type Page struct {
+	Rows   []map[string]interface{}
+	Total  uint64
+	Limit  uint64
+	Offset uint64
+}
+
+// Serves:
+//
+//	GET /api/v1/tables                        - the projection tables
+//	GET /api/v1/tables/<table>                - rows of a table, see ParseQuery
+//	GET /api/v1/tables/<table>/<key>[/<key>]  - the row of a table with the given primary key
+//	GET /api/v1/subscriptions/<channel>       - notifications on a channel as server-sent events
+func (a *API) serveREST(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+		return
+	}
+	path := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, RESTPath), "/"), "/")
+	switch {
+	case path[0] == "tables" && len(path) == 1:
+		a.serveTables(w)
+	case path[0] == "tables" && len(path) == 2:
+		a.serveCollection(w, r, path[1])
+	case path[0] == "tables":
+		a.serveItem(w, r, path[1], path[2:])
+	case path[0] == "subscriptions" && len(path) == 2:
+		a.serveSubscription(w, r, path[1])
+	default:
+		writeError(w, http.StatusNotFound, fmt.Errorf("no such resource %s", r.URL.Path))
+	}
+}
+
+func (a *API) serveTables(w http.ResponseWriter) {
+	var tables []TableInfo
+	for _, name := range a.tableNames() {
+		table := a.projection.Tables[name]
+		info := TableInfo{Name: name}
+		for _, column := range table.Columns {
+			info.Columns = append(info.Columns, ColumnInfo{
+				Name:    column.Name,
+				Type:    column.Type.String(),
+				Primary: column.Primary,
+			})
+		}
+		for channel := range table.NotifyChannels {
+			info.NotifyChannels = append(info.NotifyChannels, channel)
+		}
+		sort.Strings(info.NotifyChannels)
+		tables = append(tables, info)
+	}
+	writeJSON(w, http.StatusOK, tables)
+}
+
+func (a *API) serveCollection(w http.ResponseWriter, r *http.Request, tableName string) {
+	if _, ok := a.projection.Tables[tableName]; !ok {
+		writeError(w, http.StatusNotFound, fmt.Errorf("no table named %s in projection", tableName))
+		return
+	}
+	query, err := ParseQuery(r.URL.Query())
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	rows, total, err := a.Select(r.Context(), tableName, query)
+	if err != nil {
+		writeQueryError(w, err)
+		return
+	}
+	limit := query.Limit
+	if limit == 0 {
+		limit = DefaultLimit
+	}
+	writeJSON(w, http.StatusOK, Page{
+		Rows:   rows,
+		Total:  total,
+		Limit:  limit,
+		Offset: query.Offset,
+	})
+}
+
+func (a *API) serveItem(w http.ResponseWriter, r *http.Request, tableName string, key []string) {
+	if _, ok := a.projection.Tables[tableName]; !ok {
+		writeError(w, http.StatusNotFound, fmt.Errorf("no table named %s in projection", tableName))
+		return
+	}
+	row, err := a.Get(r.Context(), tableName, key)
+	if err != nil {
+		writeQueryError(w, err)
+		return
+	}
+	if row == nil {
+		writeError(w, http.StatusNotFound, fmt.Errorf("no row in table %s with key %v", tableName, key))
+		return
+	}
+	writeJSON(w, http.StatusOK, row)
+}
+
+func (a *API) serveSubscription(w http.ResponseWriter, r *http.Request, channel string) {
+	if _, ok := a.channels[channel]; !ok {
+		writeError(w, http.StatusNotFound, fmt.Errorf("no notification channel named %s in projection", channel))
+		return
+	}
+	if a.notifier == nil {
+		writeError(w, http.StatusNotImplemented, fmt.Errorf("database adapter does not support notifications"))
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeError(w, http.StatusInternalServerError, fmt.Errorf("streaming not supported"))
+		return
+	}
+	notifications, cancel := a.notifier.Subscribe(channel)
+	defer cancel()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case notification, ok := <-notifications:
+			if !ok {
+				return
+			}
+			bs, err := json.Marshal(notification.Payload)
+			if err != nil {
+				a.log.InfoMsg("Could not encode notification", "err", err)
+				continue
+			}
+			_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", notification.Channel, bs)
+			if err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+// ParseQuery parses the query parameters of a REST collection request:
+//
+//	<column>=<value>         - rows where column equals value
+//	<column>[<op>]=<value>   - rows where column compares to value by op, one of eq, ne, gt, gte, lt, lte, or like
+//	sort=<column>,-<column>  - order by columns, descending where prefixed with '-'
+//	limit=<n>, offset=<n>    - page of rows
+//
+// Bytes are given in hex.
+func ParseQuery(values url.Values) (Query, error) {
+	query := Query{}
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		var err error
+		switch key {
+		case "sort":
+			for _, value := range values[key] {
+				query.Sort = append(query.Sort, ParseSort(value)...)
+			}
+		case "limit":
+			query.Limit, err = strconv.ParseUint(values.Get(key), 10, 64)
+		case "offset":
+			query.Offset, err = strconv.ParseUint(values.Get(key), 10, 64)
+		default:
+			column, op := key, OperatorEqual
+			if i := strings.Index(key, "["); i > 0 && strings.HasSuffix(key, "]") {
+				column, op = key[:i], Operator(key[i+1:len(key)-1])
+			}
+			for _, value := range values[key] {
+				query.Filters = append(query.Filters, Filter{Column: column, Operator: op, Value: value})
+			}
+		}
+		if err != nil {
+			return Query{}, fmt.Errorf("could not parse %s: %v", key, err)
+		}
+	}
+	return query, nil
+}
+
+func writeQueryError(w http.ResponseWriter, err error) {
+	if _, ok := err.(*QueryError); ok {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	writeError(w, http.StatusInternalServerError, err)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, struct{ Error string }{err.Error()})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -9,6 +9,8 @@ import (
 
 const DefaultPostgresDBURL = "postgres://postgres@localhost:5432/postgres?sslmode=disable"
 
+const DefaultMySQLDBURL = "root@tcp(localhost:3306)/"
+
 // VentConfig is a set of configuration parameters
 type VentConfig struct {
 	DBAdapter      string
@@ -22,6 +24,39 @@ type VentConfig struct {
 	SpecOpt        sqlsol.SpecOpt
 	// Announce status every AnnouncePeriod
 	AnnounceEvery time.Duration
+	// Sink to which projected rows are delivered, the DB* options configure the sql sink
+	Sink string
+	// File path (or - for stdout) for the jsonl sink, URL for the webhook sink, or receiver address for the grpc sink
+	SinkURL string
+	// File in which the reader of the jsonl sink writing to stdout keeps the last height it has committed
+	SinkCheckpoint string
+	// Serve a REST and GraphQL read API over the projection tables on HTTPAddr (requires the sql sink)
+	API bool
+	// Chains to consume from when following more than one chain, each in place of GRPCAddr, SpecFileOrDirs, and
+	// AbiFileOrDirs. The chains share the database (requires the sql sink) and may share tables if SpecOpt includes
+	// sqlsol.ChainID.
+	Chains []ChainConfig
+}
+
+// ChainConfig identifies a chain to consume from and the projection to make of it
+type ChainConfig struct {
+	GRPCAddr       string
+	SpecFileOrDirs []string
+	AbiFileOrDirs  []string
+}
+
+// ForChain returns a copy of the configuration that consumes from chain
+func (cfg *VentConfig) ForChain(chain ChainConfig) *VentConfig {
+	chainCfg := *cfg
+	chainCfg.GRPCAddr = chain.GRPCAddr
+	chainCfg.SpecFileOrDirs = chain.SpecFileOrDirs
+	chainCfg.AbiFileOrDirs = chain.AbiFileOrDirs
+	return &chainCfg
+}
+
+// MultiChain is true when following more than one chain
+func (cfg *VentConfig) MultiChain() bool {
+	return len(cfg.Chains) > 0
 }
 
 // DefaultFlags returns a configuration with default values
@@ -30,6 +65,7 @@ func DefaultVentConfig() *VentConfig {
 		DBAdapter:     types.PostgresDB,
 		DBURL:         DefaultPostgresDBURL,
 		DBSchema:      "vent",
+		Sink:          types.SQLSink,
 		GRPCAddr:      "localhost:10997",
 		HTTPAddr:      "0.0.0.0:8080",
 		LogLevel:      "debug",
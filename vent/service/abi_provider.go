@@ -12,6 +12,8 @@ import (
 
 type EventSpecGetter func(abi.EventID, crypto.Address) (*abi.EventSpec, error)
 
+type FunctionSpecGetter func(abi.FunctionID, crypto.Address) (string, *abi.FunctionSpec, error)
+
 // AbiProvider provides a method for loading ABIs from disk, and retrieving them from burrow on-demand
 type AbiProvider struct {
 	abiSpec *abi.Spec
@@ -43,23 +45,13 @@ func NewAbiProvider(paths []string, cli rpcquery.QueryClient, logger *logging.Lo
 func (p *AbiProvider) GetEventAbi(eventID abi.EventID, address crypto.Address) (*abi.EventSpec, error) {
 	evAbi, ok := p.abiSpec.EventsByID[eventID]
 	if !ok {
-		resp, err := p.cli.GetMetadata(context.Background(), &rpcquery.GetMetadataParam{Address: &address})
-		if err != nil {
-			p.logger.InfoMsg("Error retrieving abi for event", "address", address.String(), "eventid", eventID.String(), "error", err)
-			return nil, err
-		}
-		if resp == nil || resp.Metadata == "" {
-			p.logger.InfoMsg("ABI not found for contract", "address", address.String(), "eventid", eventID.String())
-			return nil, fmt.Errorf("No ABI present for contract at address %v", address)
-		}
-		a, err := abi.ReadSpec([]byte(resp.Metadata))
+		a, err := p.getContractAbi(address, "eventid", eventID.String())
 		if err != nil {
-			p.logger.InfoMsg("Failed to parse abi", "address", address.String(), "eventid", eventID.String(), "abi", resp.Metadata)
 			return nil, err
 		}
 		evAbi, ok = a.EventsByID[eventID]
 		if !ok {
-			p.logger.InfoMsg("Event missing from ABI spec for contract", "address", address.String(), "eventid", eventID.String(), "abi", resp.Metadata)
+			p.logger.InfoMsg("Event missing from ABI spec for contract", "address", address.String(), "eventid", eventID.String())
 			return nil, fmt.Errorf("Event missing from ABI spec for contract")
 		}
 
@@ -68,3 +60,45 @@ func (p *AbiProvider) GetEventAbi(eventID abi.EventID, address crypto.Address) (
 
 	return &evAbi, nil
 }
+
+// GetFunctionAbi gets the name and ABI of a function by its selector. If it is not known, it is retrieved from the
+// burrow node via the address for the contract
+func (p *AbiProvider) GetFunctionAbi(functionID abi.FunctionID, address crypto.Address) (string, *abi.FunctionSpec, error) {
+	name, fnAbi, err := p.abiSpec.GetFunctionAbi(functionID, address)
+	if err != nil {
+		a, err := p.getContractAbi(address, "functionid", fmt.Sprintf("%X", functionID[:]))
+		if err != nil {
+			return "", nil, err
+		}
+		name, fnAbi, err = a.GetFunctionAbi(functionID, address)
+		if err != nil {
+			p.logger.InfoMsg("Function missing from ABI spec for contract", "address", address.String(),
+				"functionid", fmt.Sprintf("%X", functionID[:]))
+			return "", nil, err
+		}
+
+		p.abiSpec = abi.MergeSpec([]*abi.Spec{p.abiSpec, a})
+	}
+
+	return name, fnAbi, nil
+}
+
+// getContractAbi retrieves the ABI of the contract at address from the metadata stored on chain
+func (p *AbiProvider) getContractAbi(address crypto.Address, keyvals ...interface{}) (*abi.Spec, error) {
+	keyvals = append([]interface{}{"address", address.String()}, keyvals...)
+	resp, err := p.cli.GetMetadata(context.Background(), &rpcquery.GetMetadataParam{Address: &address})
+	if err != nil {
+		p.logger.InfoMsg("Error retrieving abi", append(keyvals, "error", err)...)
+		return nil, err
+	}
+	if resp == nil || resp.Metadata == "" {
+		p.logger.InfoMsg("ABI not found for contract", keyvals...)
+		return nil, fmt.Errorf("No ABI present for contract at address %v", address)
+	}
+	a, err := abi.ReadSpec([]byte(resp.Metadata))
+	if err != nil {
+		p.logger.InfoMsg("Failed to parse abi", append(keyvals, "abi", resp.Metadata)...)
+		return nil, err
+	}
+	return a, nil
+}
@@ -4,9 +4,11 @@ import (
 	"io"
 	"reflect"
 
+	"github.com/hyperledger/burrow/event"
 	"github.com/hyperledger/burrow/event/query"
 	"github.com/hyperledger/burrow/execution/evm/abi"
 	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/names"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/logging/structure"
 	"github.com/hyperledger/burrow/vent/sqlsol"
@@ -15,7 +17,7 @@ import (
 )
 
 func NewBlockConsumer(projection *sqlsol.Projection, opt sqlsol.SpecOpt, getEventSpec EventSpecGetter,
-	eventCh chan<- types.EventData, doneCh chan struct{},
+	getFunctionSpec FunctionSpecGetter, eventCh chan<- types.EventData, doneCh chan struct{},
 	logger *logging.Logger) func(blockExecution *exec.BlockExecution) error {
 
 	logger = logger.WithScope("makeBlockConsumer")
@@ -71,10 +73,17 @@ func NewBlockConsumer(projection *sqlsol.Projection, opt sqlsol.SpecOpt, getEven
 					}
 				}
 
+				txCaller := decodeTxCaller(txe)
+
 				// get events for a given transaction
 				for _, event := range txe.Events {
 					if event.Log == nil {
-						// Only EVM events are of interest
+						err := matchEvent(projection, blockData, event, txCaller, func() (map[string]interface{}, error) {
+							return decodeExecutionEvent(event, txOrigin, getFunctionSpec, logger)
+						}, logger)
+						if err != nil {
+							return err
+						}
 						continue
 					}
 
@@ -93,6 +102,9 @@ func NewBlockConsumer(projection *sqlsol.Projection, opt sqlsol.SpecOpt, getEven
 
 					// see which spec filter matches with the one in event data
 					for _, eventClass := range projection.Spec {
+						if eventClass.Aggregate != nil {
+							continue
+						}
 						qry, err := eventClass.Query()
 
 						if err != nil {
@@ -111,7 +123,8 @@ func NewBlockConsumer(projection *sqlsol.Projection, opt sqlsol.SpecOpt, getEven
 								"filter", eventClass.Filter)
 
 							// unpack, decode & build event data
-							eventData, err := buildEventData(projection, eventClass, event, txOrigin, eventSpec, logger)
+							eventData, err := buildEventData(projection, eventClass, event, txOrigin, txCaller, eventSpec,
+								logger)
 							if err != nil {
 								return errors.Wrapf(err, "Error building event data")
 							}
@@ -121,6 +134,23 @@ func NewBlockConsumer(projection *sqlsol.Projection, opt sqlsol.SpecOpt, getEven
 						}
 					}
 				}
+
+				// a NameTx sets a name registry entry that is matched as if it were an event following the others
+				if entry := txe.GetResult().GetNameEntry(); entry != nil {
+					header := &exec.Header{
+						TxType: txe.TxType,
+						TxHash: txe.TxHash,
+						Height: txe.Height,
+						Index:  uint64(len(txe.Events)),
+					}
+					tagged := &nameEntryTagged{header: header, entry: entry}
+					err := matchEvent(projection, blockData, tagged, txCaller, func() (map[string]interface{}, error) {
+						return decodeNameEntry(header, entry, txOrigin), nil
+					}, logger)
+					if err != nil {
+						return err
+					}
+				}
 			}
 		}
 
@@ -140,6 +170,46 @@ func NewBlockConsumer(projection *sqlsol.Projection, opt sqlsol.SpecOpt, getEven
 	}
 }
 
+// matchEvent adds a row built from the decoded event for each event class whose filter matches tagged. The event is
+// only decoded if it is matched and decode may return nil for an event that cannot be projected.
+func matchEvent(projection *sqlsol.Projection, blockData *sqlsol.BlockData, tagged query.Tagged, txCaller string,
+	decode func() (map[string]interface{}, error), logger *logging.Logger) error {
+
+	var decodedData map[string]interface{}
+	for _, eventClass := range projection.Spec {
+		if eventClass.Aggregate != nil {
+			continue
+		}
+		qry, err := eventClass.Query()
+		if err != nil {
+			return errors.Wrapf(err, "Error parsing query from filter string")
+		}
+
+		if qry.Matches(tagged) {
+			if decodedData == nil {
+				decodedData, err = decode()
+				if err != nil {
+					return errors.Wrapf(err, "Error decoding event (filter: %s)", eventClass.Filter)
+				}
+				if decodedData == nil {
+					return nil
+				}
+				decodedData[types.TxCallerLabel] = txCaller
+			}
+
+			logger.InfoMsg("Matched event", "event_type", decodedData[types.EventTypeLabel],
+				"filter", eventClass.Filter)
+
+			row, err := buildRow(projection, eventClass, decodedData, logger)
+			if err != nil {
+				return errors.Wrapf(err, "Error building row (filter: %s)", eventClass.Filter)
+			}
+			blockData.AddRow(eventClass.TableName, row)
+		}
+	}
+	return nil
+}
+
 type eventSpecTagged struct {
 	Event abi.EventSpec
 }
@@ -147,3 +217,20 @@ type eventSpecTagged struct {
 func (e *eventSpecTagged) Get(key string) (value interface{}, ok bool) {
 	return query.GetReflect(reflect.ValueOf(e), key)
 }
+
+// nameEntryTagged tags a name registry entry with its fields and those of the header of the NameTx that set it
+type nameEntryTagged struct {
+	header *exec.Header
+	entry  *names.Entry
+}
+
+func (n *nameEntryTagged) Get(key string) (value interface{}, ok bool) {
+	if key == event.EventTypeKey {
+		return types.NameEntryEventType, true
+	}
+	value, ok = query.GetReflect(reflect.ValueOf(n.entry), key)
+	if ok {
+		return value, true
+	}
+	return query.GetReflect(reflect.ValueOf(n.header), key)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution/evm/abi"
 	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/names"
 	"github.com/hyperledger/burrow/execution/solidity"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/vent/sqlsol"
@@ -78,7 +79,7 @@ func TestBlockConsumer(t *testing.T) {
 			},
 		})
 		require.NoError(t, err)
-		blockConsumer := NewBlockConsumer(projection, sqlsol.None, spec.GetEventAbi, eventCh, doneCh, logger)
+		blockConsumer := NewBlockConsumer(projection, sqlsol.None, spec.GetEventAbi, spec.GetFunctionAbi, eventCh, doneCh, logger)
 		tables, err := consumeBlock(blockConsumer, eventCh, log)
 		require.NoError(t, err)
 		rows := tables[tableName]
@@ -103,7 +104,7 @@ func TestBlockConsumer(t *testing.T) {
 			},
 		})
 		require.NoError(t, err)
-		blockConsumer := NewBlockConsumer(projection, sqlsol.None, spec.GetEventAbi, eventCh, doneCh, logger)
+		blockConsumer := NewBlockConsumer(projection, sqlsol.None, spec.GetEventAbi, spec.GetFunctionAbi, eventCh, doneCh, logger)
 		_, err = consumeBlock(blockConsumer, eventCh, log)
 		require.Error(t, err)
 		require.Contains(t, err.Error(), "could not find ABI")
@@ -126,7 +127,7 @@ func TestBlockConsumer(t *testing.T) {
 			},
 		})
 		require.NoError(t, err)
-		blockConsumer := NewBlockConsumer(projection, sqlsol.None, spec.GetEventAbi, eventCh, doneCh, logger)
+		blockConsumer := NewBlockConsumer(projection, sqlsol.None, spec.GetEventAbi, spec.GetFunctionAbi, eventCh, doneCh, logger)
 		_, err = consumeBlock(blockConsumer, eventCh, log)
 		require.Equal(t, errTimeout, err)
 	})
@@ -150,19 +151,119 @@ func TestBlockConsumer(t *testing.T) {
 		spec, err := abi.ReadSpec(solidity.Abi_EventEmitter)
 		require.NoError(t, err)
 
-		blockConsumer := NewBlockConsumer(projection, sqlsol.None, spec.GetEventAbi, eventCh, doneCh, logger)
+		blockConsumer := NewBlockConsumer(projection, sqlsol.None, spec.GetEventAbi, spec.GetFunctionAbi, eventCh, doneCh, logger)
 		_, err = consumeBlock(blockConsumer, eventCh, log)
 		// Check matches
 		require.NoError(t, err)
 
 		// Now Remove the ABI - should timeout indicating we did not match the event, but it wasn't an error
 		delete(spec.EventsByID, manyTypesEventSpec.ID)
-		blockConsumer = NewBlockConsumer(projection, sqlsol.None, spec.GetEventAbi, eventCh, doneCh, logger)
+		blockConsumer = NewBlockConsumer(projection, sqlsol.None, spec.GetEventAbi, spec.GetFunctionAbi, eventCh, doneCh, logger)
 		_, err = consumeBlock(blockConsumer, eventCh, log)
 		require.Equal(t, errTimeout, err)
 	})
 }
 
+func TestBlockConsumerExecutionEvents(t *testing.T) {
+	doneCh := make(chan struct{})
+	eventCh := make(chan types.EventData, 100)
+	logger := logging.NewNoopLogger()
+
+	spec, err := abi.ReadSpec([]byte(`[{"constant":false,"inputs":[{"name":"to","type":"address"},` +
+		`{"name":"amount","type":"uint256"}],"name":"transfer","outputs":[{"name":"","type":"bool"}],` +
+		`"payable":false,"stateMutability":"nonpayable","type":"function"}]`))
+	require.NoError(t, err)
+
+	callee := crypto.Address{1, 2, 3}
+	to := crypto.Address{4, 5, 6}
+	input, transferSpec, err := spec.Pack("transfer", to.String(), 100)
+	require.NoError(t, err)
+	output, err := abi.Pack(transferSpec.Outputs, true)
+	require.NoError(t, err)
+
+	txe := &exec.TxExecution{
+		TxHeader: &exec.TxHeader{},
+	}
+	txe.Input(callee, nil)
+	err = txe.Call(&exec.CallEvent{
+		CallType: exec.CallTypeCall,
+		CallData: &exec.CallData{
+			Caller: crypto.Address{9},
+			Callee: callee,
+			Data:   input,
+			Value:  7,
+		},
+		Return: output,
+	}, nil)
+	require.NoError(t, err)
+	txe.Name(&names.Entry{
+		Name:    "frogs",
+		Owner:   crypto.Address{9},
+		Data:    "ribbit",
+		Expires: 42,
+	})
+
+	projection, err := sqlsol.NewProjection(types.ProjectionSpec{
+		{
+			TableName: "Calls",
+			Filter:    "EventType = 'CallEvent'",
+			FieldMappings: []*types.EventFieldMapping{
+				{Field: types.CalleeLabel, Type: types.EventFieldTypeAddress, ColumnName: "callee"},
+				{Field: types.ValueLabel, Type: "uint64", ColumnName: "value"},
+				{Field: "input.to", Type: types.EventFieldTypeAddress, ColumnName: "to"},
+				{Field: "input.amount", Type: "uint256", ColumnName: "amount"},
+				{Field: "output.0", Type: types.EventFieldTypeBool, ColumnName: "ok"},
+			},
+		},
+		{
+			TableName: "Inputs",
+			Filter:    "EventType = 'AccountInputEvent'",
+			FieldMappings: []*types.EventFieldMapping{
+				{Field: types.AddressLabel, Type: types.EventFieldTypeAddress, ColumnName: "address"},
+			},
+		},
+		{
+			TableName: "Names",
+			Filter:    "EventType = 'NameEntryEvent' AND Name = 'frogs'",
+			FieldMappings: []*types.EventFieldMapping{
+				{Field: types.NameLabel, Type: types.EventFieldTypeString, ColumnName: "name", Primary: true},
+				{Field: types.DataLabel, Type: types.EventFieldTypeString, ColumnName: "data"},
+				{Field: types.ExpiresLabel, Type: "uint64", ColumnName: "expires"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	block := &exec.BlockExecution{
+		Header: &tmTypes.Header{},
+	}
+	block.AppendTxs(txe)
+	blockConsumer := NewBlockConsumer(projection, sqlsol.None, spec.GetEventAbi, spec.GetFunctionAbi, eventCh,
+		doneCh, logger)
+	require.NoError(t, blockConsumer(block))
+	tables := (<-eventCh).Tables
+
+	require.Len(t, tables["Calls"], 1)
+	call := tables["Calls"][0].RowData
+	assert.Equal(t, callee.String(), call["callee"])
+	assert.Equal(t, "7", call["value"])
+	assert.Equal(t, to.String(), call["to"])
+	assert.Equal(t, "100", call["amount"])
+	assert.Equal(t, true, *call["ok"].(*bool))
+	assert.Equal(t, "transfer", call[columns.EventName])
+	assert.Equal(t, exec.TypeCall.String(), call[columns.EventType])
+
+	require.Len(t, tables["Inputs"], 1)
+	assert.Equal(t, callee.String(), tables["Inputs"][0].RowData["address"])
+
+	require.Len(t, tables["Names"], 1)
+	name := tables["Names"][0].RowData
+	assert.Equal(t, "frogs", name["name"])
+	assert.Equal(t, "ribbit", name["data"])
+	assert.Equal(t, "42", name["expires"])
+	assert.Equal(t, types.NameEntryEventType, name[columns.EventType])
+}
+
 const timeout = time.Second
 
 var errTimeout = fmt.Errorf("timed out after %s waiting for consumer to emit block event", timeout)
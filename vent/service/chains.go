@@ -0,0 +1,68 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/vent/sqlsol"
+)
+
+type chainResult struct {
+	consumer *Consumer
+	err      error
+}
+
+// RunChains runs a consumer for each of a number of chains, each making the projection at the same index of
+// projections. The consumers are started one after the other so that they do not race to set up shared tables. If any
+// consumer fails the others are shut down and its error is returned.
+func RunChains(consumers []*Consumer, projections []*sqlsol.Projection, stream bool) error {
+	if len(consumers) != len(projections) {
+		return fmt.Errorf("RunChains() expects one projection per consumer but got %d consumers and %d projections",
+			len(consumers), len(projections))
+	}
+
+	resultCh := make(chan chainResult, len(consumers))
+	running := 0
+	var started []*Consumer
+
+	// On error shut down the consumers that are running
+	finish := func(result chainResult) error {
+		running--
+		if result.err == nil {
+			return nil
+		}
+		for _, consumer := range started {
+			consumer.Shutdown()
+		}
+		return fmt.Errorf("consumer of chain at %s: %v", result.consumer.Config.GRPCAddr, result.err)
+	}
+
+	for i, consumer := range consumers {
+		consumer := consumer
+		projection := projections[i]
+		running++
+		go func() {
+			resultCh <- chainResult{consumer: consumer, err: consumer.Run(projection, stream)}
+		}()
+
+		for ready := false; !ready; {
+			select {
+			case <-consumer.Ready():
+				started = append(started, consumer)
+				ready = true
+			case result := <-resultCh:
+				if err := finish(result); err != nil {
+					return err
+				}
+				// A consumer may finish without error before it is ready if it has nothing to project
+				ready = result.consumer == consumer
+			}
+		}
+	}
+
+	for running > 0 {
+		if err := finish(<-resultCh); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hyperledger/burrow/rpc/rpcevents"
 	"github.com/hyperledger/burrow/rpc/rpcquery"
 	"github.com/hyperledger/burrow/vent/config"
+	"github.com/hyperledger/burrow/vent/sink"
 	"github.com/hyperledger/burrow/vent/sqldb"
 	"github.com/hyperledger/burrow/vent/sqlsol"
 	"github.com/hyperledger/burrow/vent/types"
@@ -23,13 +24,17 @@ import (
 
 // Consumer contains basic configuration for consumer to run
 type Consumer struct {
-	Config         *config.VentConfig
-	Logger         *logging.Logger
-	DB             *sqldb.SQLDB
+	Config *config.VentConfig
+	Logger *logging.Logger
+	// The SQL database projected into, nil when another sink is used
+	DB *sqldb.SQLDB
+	// Where the projected rows of each block are delivered (the same as DB for the SQL sink)
+	Sink           sink.Sink
 	GRPCConnection *grpc.ClientConn
 	// external events channel used for when vent is leveraged as a library
 	EventsChannel chan types.EventData
 	Done          chan struct{}
+	ready         chan struct{}
 	shutdownOnce  sync.Once
 	Status
 }
@@ -49,9 +54,16 @@ func NewConsumer(cfg *config.VentConfig, log *logging.Logger, eventChannel chan
 		Logger:        log,
 		EventsChannel: eventChannel,
 		Done:          make(chan struct{}),
+		ready:         make(chan struct{}),
 	}
 }
 
+// Ready is closed once the consumer has connected to its chain and sink and set up its tables and is about to start
+// consuming blocks
+func (c *Consumer) Ready() <-chan struct{} {
+	return c.ready
+}
+
 // Run connects to a grpc service and subscribes to log events,
 // then gets tables structures, maps them & parse event data.
 // Store data in SQL event tables, it runs forever
@@ -84,45 +96,89 @@ func (c *Consumer) Run(projection *sqlsol.Projection, stream bool) error {
 		return nil
 	}
 
-	c.Logger.InfoMsg("Connecting to SQL database")
+	c.Logger.InfoMsg("Connecting to sink", "sink", c.Config.Sink)
 
-	connection := types.SQLConnection{
-		DBAdapter: c.Config.DBAdapter,
-		DBURL:     c.Config.DBURL,
-		DBSchema:  c.Config.DBSchema,
-		Log:       c.Logger,
-	}
-
-	c.DB, err = sqldb.NewSQLDB(connection)
+	c.Sink, err = sink.NewSink(c.Config, c.Logger)
 	if err != nil {
-		return fmt.Errorf("error connecting to SQL database: %v", err)
+		return fmt.Errorf("error connecting to sink: %v", err)
 	}
-	defer c.DB.Close()
+	defer c.Sink.Close()
+	c.DB, _ = c.Sink.(*sqldb.SQLDB)
 
-	err = c.DB.Init(c.Burrow.ChainID, c.Burrow.BurrowVersion)
+	err = c.Sink.Init(c.Burrow.ChainID, c.Burrow.BurrowVersion)
 	if err != nil {
 		return fmt.Errorf("could not clean tables after ChainID change: %v", err)
 	}
 
+	// Tables whose event classes have changed since they were projected are rebuilt from the start of the chain, this
+	// relies on the spec hashes kept by SQLDB so is not available for other sinks nor for tables shared between chains
+	var reproj *reprojection
+	if c.DB != nil {
+		reproj, err = newReprojection(c.DB, c.Burrow.ChainID, projection, c.Logger)
+		if err != nil {
+			return errors.Wrap(err, "Error preparing reprojection")
+		}
+	}
+	liveTables := reproj.liveTables(projection)
+
 	c.Logger.InfoMsg("Synchronizing config and database projection structures")
 
-	err = c.DB.SynchronizeDB(c.Burrow.ChainID, projection.Tables)
+	err = c.Sink.SynchronizeDB(c.Burrow.ChainID, liveTables)
 	if err != nil {
 		return errors.Wrap(err, "Error trying to synchronize database")
 	}
 
+	cli := rpcevents.NewExecutionEventsClient(c.GRPCConnection)
+
+	// reprojectedCh receives once the rebuilt tables have been projected up to the height the live tables had reached
+	// when we started so that they can be caught up and swapped in
+	reprojectedCh := make(chan struct{})
+	reprojectErrCh := make(chan error, 1)
+	if reproj != nil {
+		reprojectTo, err := c.Sink.LastBlockHeight(c.Burrow.ChainID)
+		if err != nil {
+			return errors.Wrapf(err, "Error trying to get last processed block number")
+		}
+		if stream {
+			go func() {
+				err := reproj.project(cli, 0, reprojectTo, abiProvider.GetEventAbi, abiProvider.GetFunctionAbi, c.Done)
+				if err != nil {
+					if err != io.EOF {
+						reprojectErrCh <- err
+					}
+					return
+				}
+				close(reprojectedCh)
+			}()
+		} else {
+			// Without a stream we finish what we start so there is no need to keep the live tables going meanwhile
+			err = reproj.project(cli, 0, reprojectTo, abiProvider.GetEventAbi, abiProvider.GetFunctionAbi, c.Done)
+			if err != nil {
+				return err
+			}
+			err = reproj.swap()
+			if err != nil {
+				return err
+			}
+			reproj = nil
+			liveTables = projection.Tables
+		}
+	}
+
 	// doneCh is used for sending a "done" signal from each goroutine to the main thread
 	// eventCh is used for sending received events to the main thread to be stored in the db
 	errCh := make(chan error, 1)
 	eventCh := make(chan types.EventData)
 
+	close(c.ready)
+
 	go func() {
 		defer func() {
 			c.Shutdown()
 		}()
 		go c.announceEvery(c.Done)
 
-		c.Logger.InfoMsg("Getting last processed block number from SQL log table")
+		c.Logger.InfoMsg("Getting last processed block number from sink")
 
 		// NOTE [Silas]: I am preserving the comment below that dates from the early days of Vent. I have looked at the
 		// bosmarmot git history and I cannot see why the original author thought that it was the case that there was
@@ -134,7 +190,7 @@ func (c *Consumer) Run(projection *sqlsol.Projection, stream bool) error {
 		// right now there is no way to know if the last block of events was completely read
 		// so we have to begin processing from the last block number stored in database
 		// and update event data if already present
-		fromBlock, err := c.DB.LastBlockHeight(c.Burrow.ChainID)
+		fromBlock, err := c.Sink.LastBlockHeight(c.Burrow.ChainID)
 		if err != nil {
 			errCh <- errors.Wrapf(err, "Error trying to get last processed block number")
 			return
@@ -148,7 +204,6 @@ func (c *Consumer) Run(projection *sqlsol.Projection, stream bool) error {
 		}
 
 		// setup block range to get needed blocks server side
-		cli := rpcevents.NewExecutionEventsClient(c.GRPCConnection)
 		var end *rpcevents.Bound
 		if stream {
 			end = rpcevents.StreamBound()
@@ -172,7 +227,7 @@ func (c *Consumer) Run(projection *sqlsol.Projection, stream bool) error {
 		c.Logger.TraceMsg("Waiting for blocks...")
 
 		err = rpcevents.ConsumeBlockExecutions(stream,
-			NewBlockConsumer(projection, c.Config.SpecOpt, abiProvider.GetEventAbi, eventCh, c.Done, c.Logger))
+			NewBlockConsumer(projection, c.Config.SpecOpt, abiProvider.GetEventAbi, abiProvider.GetFunctionAbi, eventCh, c.Done, c.Logger))
 
 		if err != nil {
 			if err == io.EOF {
@@ -193,12 +248,37 @@ func (c *Consumer) Run(projection *sqlsol.Projection, stream bool) error {
 		// Process block events
 		case blk := <-eventCh:
 			c.Status.LastProcessedHeight = blk.BlockHeight
-			err := c.commitBlock(projection, blk)
+			err := c.commitBlock(liveTables, blk)
 			if err != nil {
 				c.Logger.InfoMsg("error committing block", "err", err)
 				return err
 			}
 
+		// Catch up the rebuilt tables with the live tables and swap them in
+		case <-reprojectedCh:
+			reprojectedCh = nil
+			height, err := c.Sink.LastBlockHeight(c.Burrow.ChainID)
+			if err != nil {
+				return errors.Wrapf(err, "Error trying to get last processed block number")
+			}
+			err = reproj.project(cli, reproj.height+1, height, abiProvider.GetEventAbi, abiProvider.GetFunctionAbi,
+				c.Done)
+			if err == io.EOF {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			err = reproj.swap()
+			if err != nil {
+				return err
+			}
+			liveTables = projection.Tables
+
+		case err := <-reprojectErrCh:
+			c.Logger.InfoMsg("error reprojecting tables", "err", err)
+			return err
+
 		// Await completion
 		case <-c.Done:
 			select {
@@ -217,10 +297,10 @@ func (c *Consumer) Run(projection *sqlsol.Projection, stream bool) error {
 	}
 }
 
-func (c *Consumer) commitBlock(projection *sqlsol.Projection, blockEvents types.EventData) error {
-	// upsert rows in specific SQL event tables and update block number
-	if err := c.DB.SetBlock(c.Burrow.ChainID, projection.Tables, blockEvents); err != nil {
-		return fmt.Errorf("error upserting rows in database: %v", err)
+func (c *Consumer) commitBlock(eventTables types.EventTables, blockEvents types.EventData) error {
+	// upsert rows in specific SQL event tables (or deliver them to another sink) and update block number
+	if err := c.Sink.SetBlock(c.Burrow.ChainID, eventTables, blockEvents); err != nil {
+		return fmt.Errorf("error upserting rows in sink: %v", err)
 	}
 
 	// send to the external events channel in a non-blocking manner
@@ -237,13 +317,13 @@ func (c *Consumer) Health() error {
 		return errors.New("closing service")
 	}
 
-	// check db status
-	if c.DB == nil {
-		return errors.New("database disconnected")
+	// check sink status
+	if c.Sink == nil {
+		return errors.New("sink disconnected")
 	}
 
-	if err := c.DB.Ping(); err != nil {
-		return errors.New("database unavailable")
+	if err := c.Sink.Ping(); err != nil {
+		return errors.New("sink unavailable")
 	}
 
 	// check grpc connection status
@@ -263,7 +343,9 @@ func (c *Consumer) Shutdown() {
 	c.shutdownOnce.Do(func() {
 		c.Logger.InfoMsg("Shutting down vent consumer...")
 		close(c.Done)
-		c.GRPCConnection.Close()
+		if c.GRPCConnection != nil {
+			c.GRPCConnection.Close()
+		}
 	})
 }
 
@@ -283,6 +365,7 @@ func (c *Consumer) statusMessage() []interface{} {
 	}
 	return []interface{}{
 		"msg", "status",
+		"chain_id", c.Burrow.ChainID,
 		"last_processed_height", c.LastProcessedHeight,
 		"fraction_caught_up", catchUpRatio,
 		"burrow_latest_block_height", c.Burrow.SyncInfo.LatestBlockHeight,
@@ -3,8 +3,11 @@
 package service_test
 
 import (
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"math/rand"
+	"os"
 	"path"
 	"runtime"
 	"testing"
@@ -126,6 +129,176 @@ func testDeleteEvent(t *testing.T, chainID string, cfg *config.VentConfig, tcli
 	// delete not allowed on log mode
 }
 
+func testReproject(t *testing.T, chainID string, cfg *config.VentConfig, tcli rpctransact.TransactClient, inputAddress crypto.Address) {
+	create := test.CreateContract(t, tcli, inputAddress)
+	eventColumnName := "EventTest"
+
+	db, closeDB := test.NewTestDB(t, cfg)
+	defer closeDB()
+	resolveSpec(cfg, testViewSpec)
+
+	txe := test.CallAddEvent(t, tcli, inputAddress, create.Receipt.ContractAddress, "TestEventReproject",
+		"Description of TestEventReproject")
+	runConsumer(t, cfg)
+	eventData := ensureEvents(t, db, chainID, eventColumnName, txe.Height, 1)
+	require.Contains(t, eventData.Tables[eventColumnName][0].RowData, "testdescription")
+
+	// Drop the description from the EventTest spec which should cause its table to be rebuilt without it
+	bs, err := ioutil.ReadFile(cfg.SpecFileOrDirs[0])
+	require.NoError(t, err)
+	spec := types.ProjectionSpec{}
+	require.NoError(t, json.Unmarshal(bs, &spec))
+	for _, eventClass := range spec {
+		if eventClass.TableName == eventColumnName {
+			eventClass.FieldMappings = eventClass.FieldMappings[:2]
+		}
+	}
+	bs, err = json.Marshal(spec)
+	require.NoError(t, err)
+	dir, err := ioutil.TempDir("", "vent-reproject")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	cfg.SpecFileOrDirs = []string{path.Join(dir, "sqlsol_reproject.json")}
+	require.NoError(t, ioutil.WriteFile(cfg.SpecFileOrDirs[0], bs, 0644))
+
+	runConsumer(t, cfg)
+	eventData = ensureEvents(t, db, chainID, eventColumnName, txe.Height, 1)
+	require.NotContains(t, eventData.Tables[eventColumnName][0].RowData, "testdescription")
+
+	specHashes, err := db.SpecHashes()
+	require.NoError(t, err)
+	require.Len(t, specHashes[chainID][eventColumnName], 1)
+	require.NotContains(t, specHashes[chainID], service.ReprojectionPrefix+eventColumnName)
+
+	// A table shared with another chain is not reprojected since the other chain's rows would be lost
+	require.NoError(t, db.SetSpecHashes("Other_Chain", eventColumnName, specHashes[chainID][eventColumnName]))
+	for _, eventClass := range spec {
+		if eventClass.TableName == eventColumnName {
+			eventClass.FieldMappings = eventClass.FieldMappings[:1]
+		}
+	}
+	bs, err = json.Marshal(spec)
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(cfg.SpecFileOrDirs[0], bs, 0644))
+	projection, err := sqlsol.SpecLoader(cfg.SpecFileOrDirs, cfg.SpecOpt)
+	require.NoError(t, err)
+	err = service.NewConsumer(cfg, logging.NewNoopLogger(), make(chan types.EventData, 100)).Run(projection, false)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "shared with chain Other_Chain")
+	require.NoError(t, db.SetSpecHashes("Other_Chain", eventColumnName, nil))
+}
+
+func testAggregate(t *testing.T, cfg *config.VentConfig, tcli rpctransact.TransactClient, inputAddress crypto.Address) {
+	create := test.CreateContract(t, tcli, inputAddress)
+
+	db, closeDB := test.NewTestDB(t, cfg)
+	defer closeDB()
+	resolveSpec(cfg, testViewSpec)
+
+	dir, err := ioutil.TempDir("", "vent-aggregate")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	cfg.SpecFileOrDirs = []string{path.Join(dir, "sqlsol_aggregate.json")}
+	require.NoError(t, ioutil.WriteFile(cfg.SpecFileOrDirs[0], []byte(`[
+  {
+    "TableName": "AggregatedEvents",
+    "Filter": "EventType = 'LogEvent'",
+    "DeleteMarkerField": "__DELETE__",
+    "FieldMappings": [
+      {"Field": "key", "ColumnName": "testkey", "Type": "bytes32", "Primary": true},
+      {"Field": "name", "ColumnName": "testname", "Type": "bytes32", "Primary": true, "BytesToString": true},
+      {"Field": "description", "ColumnName": "testdescription", "Type": "bytes32", "BytesToString": true}
+    ]
+  },
+  {
+    "TableName": "DescriptionCounts",
+    "Aggregate": {
+      "SourceTable": "AggregatedEvents",
+      "GroupBy": ["testdescription"],
+      "Columns": [
+        {"ColumnName": "things", "Function": "count"},
+        {"ColumnName": "heights", "Function": "sum", "SourceColumn": "_height"},
+        {"ColumnName": "firstname", "Function": "min", "SourceColumn": "testname"},
+        {"ColumnName": "latestname", "Function": "latest", "SourceColumn": "testname"}
+      ]
+    }
+  }
+]`), 0644))
+
+	type counts struct {
+		Things, Heights, FirstName, LatestName string
+	}
+	getCounts := func() map[string]counts {
+		rows, err := db.DB.Query(fmt.Sprintf("SELECT testdescription, things, heights, firstname, latestname FROM %s;",
+			db.DBAdapter.SchemaName("DescriptionCounts")))
+		require.NoError(t, err)
+		defer rows.Close()
+		descriptionCounts := make(map[string]counts)
+		for rows.Next() {
+			var description string
+			var c counts
+			require.NoError(t, rows.Scan(&description, &c.Things, &c.Heights, &c.FirstName, &c.LatestName))
+			descriptionCounts[description] = c
+		}
+		require.NoError(t, rows.Err())
+		return descriptionCounts
+	}
+
+	txeA := test.CallAddEvent(t, tcli, inputAddress, create.Receipt.ContractAddress, "AggregateA", "aggregated")
+	txeB := test.CallAddEvent(t, tcli, inputAddress, create.Receipt.ContractAddress, "AggregateB", "aggregated")
+	runConsumer(t, cfg)
+	require.Equal(t, counts{
+		Things:     "2",
+		Heights:    fmt.Sprint(txeA.Height + txeB.Height),
+		FirstName:  "AggregateA",
+		LatestName: "AggregateB",
+	}, getCounts()["aggregated"])
+
+	// Deleting a row rolls back its contribution
+	test.CallRemoveEvent(t, tcli, inputAddress, create.Receipt.ContractAddress, "AggregateB")
+	runConsumer(t, cfg)
+	require.Equal(t, counts{
+		Things:     "1",
+		Heights:    fmt.Sprint(txeA.Height),
+		FirstName:  "AggregateA",
+		LatestName: "AggregateA",
+	}, getCounts()["aggregated"])
+
+	// Moving the last row out of a group removes the group
+	txeA = test.CallAddEvent(t, tcli, inputAddress, create.Receipt.ContractAddress, "AggregateA", "moved")
+	runConsumer(t, cfg)
+	descriptionCounts := getCounts()
+	require.NotContains(t, descriptionCounts, "aggregated")
+	require.Equal(t, counts{
+		Things:     "1",
+		Heights:    fmt.Sprint(txeA.Height),
+		FirstName:  "AggregateA",
+		LatestName: "AggregateA",
+	}, descriptionCounts["moved"])
+
+	// Changing an aggregate rebuilds it from its source table
+	bs, err := ioutil.ReadFile(cfg.SpecFileOrDirs[0])
+	require.NoError(t, err)
+	spec := types.ProjectionSpec{}
+	require.NoError(t, json.Unmarshal(bs, &spec))
+	spec[1].Aggregate.Columns = append(spec[1].Aggregate.Columns, &types.AggregateColumn{
+		ColumnName:   "lastheight",
+		Function:     types.AggregateMax,
+		SourceColumn: "_height",
+	})
+	bs, err = json.Marshal(spec)
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(cfg.SpecFileOrDirs[0], bs, 0644))
+	runConsumer(t, cfg)
+
+	var lastHeight uint64
+	err = db.DB.QueryRow(db.DB.Rebind(fmt.Sprintf("SELECT lastheight FROM %s WHERE testdescription = ?;",
+		db.DBAdapter.SchemaName("DescriptionCounts"))), "moved").Scan(&lastHeight)
+	require.NoError(t, err)
+	require.Equal(t, txeA.Height, lastHeight)
+	require.Equal(t, descriptionCounts, getCounts())
+}
+
 func ensureEvents(t *testing.T, db *sqldb.SQLDB, chainID, column string, height, numEvents uint64) types.EventData {
 	eventData, err := db.GetBlock(chainID, height)
 	require.NoError(t, err)
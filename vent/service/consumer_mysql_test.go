@@ -0,0 +1,51 @@
+// +build integration,mysql
+
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/integration"
+	"github.com/hyperledger/burrow/integration/rpctest"
+
+	"github.com/hyperledger/burrow/vent/test"
+)
+
+func TestMySQLConsumer(t *testing.T) {
+	privateAccounts := rpctest.PrivateAccounts
+	kern, shutdown := integration.RunNode(t, rpctest.GenesisDoc, rpctest.PrivateAccounts)
+	defer shutdown()
+	inputAddress := privateAccounts[0].GetAddress()
+	grpcAddress := kern.GRPCListenAddress().String()
+	tcli := test.NewTransactClient(t, grpcAddress)
+
+	t.Parallel()
+	time.Sleep(2 * time.Second)
+
+	t.Run("Group", func(t *testing.T) {
+		t.Run("MySQLConsumer", func(t *testing.T) {
+			testConsumer(t, kern.Blockchain.ChainID(), test.MySQLVentConfig(grpcAddress), tcli, inputAddress)
+		})
+
+		t.Run("MySQLInvalidUTF8", func(t *testing.T) {
+			testInvalidUTF8(t, test.MySQLVentConfig(grpcAddress), tcli, inputAddress)
+		})
+
+		t.Run("MySQLDeleteEvent", func(t *testing.T) {
+			testDeleteEvent(t, kern.Blockchain.ChainID(), test.MySQLVentConfig(grpcAddress), tcli, inputAddress)
+		})
+
+		t.Run("MySQLResume", func(t *testing.T) {
+			testResume(t, test.MySQLVentConfig(grpcAddress))
+		})
+
+		t.Run("MySQLReproject", func(t *testing.T) {
+			testReproject(t, kern.Blockchain.ChainID(), test.MySQLVentConfig(grpcAddress), tcli, inputAddress)
+		})
+
+		t.Run("MySQLAggregate", func(t *testing.T) {
+			testAggregate(t, test.MySQLVentConfig(grpcAddress), tcli, inputAddress)
+		})
+	})
+}
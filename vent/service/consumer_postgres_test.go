@@ -48,6 +48,14 @@ func TestPostgresConsumer(t *testing.T) {
 			testResume(t, test.PostgresVentConfig(grpcAddress))
 		})
 
+		t.Run("PostgresReproject", func(t *testing.T) {
+			testReproject(t, kern.Blockchain.ChainID(), test.PostgresVentConfig(grpcAddress), tcli, inputAddress)
+		})
+
+		t.Run("PostgresAggregate", func(t *testing.T) {
+			testAggregate(t, test.PostgresVentConfig(grpcAddress), tcli, inputAddress)
+		})
+
 		t.Run("PostgresTriggers", func(t *testing.T) {
 			tCli := test.NewTransactClient(t, kern.GRPCListenAddress().String())
 			create := test.CreateContract(t, tCli, inputAddress)
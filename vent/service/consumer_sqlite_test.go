@@ -39,5 +39,13 @@ func TestSqliteConsumer(t *testing.T) {
 		t.Run("SqliteResume", func(t *testing.T) {
 			testResume(t, test.SqliteVentConfig(grpcAddress))
 		})
+
+		t.Run("SqliteReproject", func(t *testing.T) {
+			testReproject(t, kern.Blockchain.ChainID(), test.SqliteVentConfig(grpcAddress), tcli, inputAddress)
+		})
+
+		t.Run("SqliteAggregate", func(t *testing.T) {
+			testAggregate(t, test.SqliteVentConfig(grpcAddress), tcli, inputAddress)
+		})
 	})
 }
@@ -3,27 +3,23 @@ package service
 import (
 	"math/big"
 	"strconv"
+	"strings"
 
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution/evm/abi"
 	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/names"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/logging/structure"
 	"github.com/hyperledger/burrow/vent/types"
 	"github.com/pkg/errors"
 )
 
 // decodeEvent unpacks & decodes event data
 func decodeEvent(eventHeader *exec.Header, log *exec.LogEvent, txOrigin *exec.Origin, evAbi *abi.EventSpec) (map[string]interface{}, error) {
-	// to prepare decoded data and map to event item name
-	data := make(map[string]interface{})
-
 	// decode header to get context data for each event
+	data := decodeHeader(eventHeader, txOrigin)
 	data[types.EventNameLabel] = evAbi.Name
-	data[types.ChainIDLabel] = txOrigin.ChainID
-	data[types.BlockHeightLabel] = strconv.FormatUint(txOrigin.GetHeight(), 10)
-	data[types.TxIndexLabel] = strconv.FormatUint(txOrigin.GetIndex(), 10)
-	data[types.EventIndexLabel] = strconv.FormatUint(eventHeader.GetIndex(), 10)
-	data[types.EventTypeLabel] = eventHeader.GetEventType().String()
-	data[types.TxTxHashLabel] = eventHeader.TxHash.String()
 
 	// build expected interface type array to get log event values
 	unpackedData := abi.GetPackingTypes(evAbi.Inputs)
@@ -35,17 +31,182 @@ func decodeEvent(eventHeader *exec.Header, log *exec.LogEvent, txOrigin *exec.Or
 
 	// for each decoded item value, stores it in given item name
 	for i, input := range evAbi.Inputs {
-		switch v := unpackedData[i].(type) {
-		case *crypto.Address:
-			data[input.Name] = v.String()
-		case *big.Int:
-			data[input.Name] = v.String()
-		case *string:
-			data[input.Name] = *v
-		default:
-			data[input.Name] = v
-		}
+		data[input.Name] = decodeValue(unpackedData[i])
 	}
 
 	return data, nil
 }
+
+// decodeCallEvent decodes a call and, when the ABI of the function called is known, its arguments and return values
+// which are given as 'input.<name>' and 'output.<name>' (or by position for unnamed arguments)
+func decodeCallEvent(eventHeader *exec.Header, call *exec.CallEvent, txOrigin *exec.Origin, fnName string,
+	fnAbi *abi.FunctionSpec) (map[string]interface{}, error) {
+
+	data := decodeHeader(eventHeader, txOrigin)
+	data[types.EventNameLabel] = fnName
+	data[types.CallTypeLabel] = call.CallType.String()
+	data[types.OriginLabel] = call.Origin.String()
+	data[types.StackDepthLabel] = strconv.FormatUint(call.StackDepth, 10)
+	data[types.ReturnLabel] = []byte(call.Return)
+
+	callData := call.GetCallData()
+	if callData == nil {
+		return data, nil
+	}
+	data[types.CallerLabel] = callData.Caller.String()
+	data[types.CalleeLabel] = callData.Callee.String()
+	data[types.DataLabel] = []byte(callData.Data)
+	data[types.ValueLabel] = strconv.FormatUint(callData.Value, 10)
+	data[types.GasLabel] = strconv.FormatUint(callData.Gas, 10)
+
+	if fnAbi == nil || len(callData.Data) < abi.FunctionIDSize {
+		return data, nil
+	}
+	err := decodeArguments(data, "input", fnAbi.Inputs, callData.Data[abi.FunctionIDSize:])
+	if err != nil {
+		return nil, errors.Wrapf(err, "Could not unpack arguments of call to %s", fnName)
+	}
+	// The return data of a reverted call holds the revert reason rather than the outputs
+	if eventHeader.GetException() == nil {
+		err = decodeArguments(data, "output", fnAbi.Outputs, call.Return)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Could not unpack return values of call to %s", fnName)
+		}
+	}
+	return data, nil
+}
+
+// decodeInputOutputEvent decodes the address of an account that was the input or output of a transaction
+func decodeInputOutputEvent(eventHeader *exec.Header, address crypto.Address, txOrigin *exec.Origin) map[string]interface{} {
+	data := decodeHeader(eventHeader, txOrigin)
+	data[types.AddressLabel] = address.String()
+	return data
+}
+
+// decodeGovernAccountEvent decodes the update made to an account by a GovTx
+func decodeGovernAccountEvent(eventHeader *exec.Header, event *exec.GovernAccountEvent,
+	txOrigin *exec.Origin) map[string]interface{} {
+
+	data := decodeHeader(eventHeader, txOrigin)
+	account := event.GetAccountUpdate()
+	if account == nil {
+		return data
+	}
+	if account.Address != nil {
+		data[types.AddressLabel] = account.Address.String()
+	}
+	if account.PublicKey != nil {
+		data[types.PublicKeyLabel] = account.PublicKey.String()
+	}
+	if account.Code != nil {
+		data[types.CodeLabel] = []byte(*account.Code)
+	}
+	balances := make([]string, len(account.Amounts))
+	for i, amount := range account.Amounts {
+		balances[i] = amount.String()
+	}
+	data[types.NameLabel] = account.Name
+	data[types.BalancesLabel] = strings.Join(balances, ",")
+	data[types.PermissionsLabel] = strings.Join(account.Permissions, ",")
+	data[types.RolesLabel] = strings.Join(account.Roles, ",")
+	return data
+}
+
+// decodeNameEntry decodes the name registry entry set by a NameTx
+func decodeNameEntry(eventHeader *exec.Header, entry *names.Entry, txOrigin *exec.Origin) map[string]interface{} {
+	data := decodeHeader(eventHeader, txOrigin)
+	data[types.EventTypeLabel] = types.NameEntryEventType
+	data[types.NameLabel] = entry.Name
+	data[types.OwnerLabel] = entry.Owner.String()
+	data[types.DataLabel] = entry.Data
+	data[types.ExpiresLabel] = strconv.FormatUint(entry.Expires, 10)
+	return data
+}
+
+// decodeHeader decodes the context data common to all events
+func decodeHeader(eventHeader *exec.Header, txOrigin *exec.Origin) map[string]interface{} {
+	return map[string]interface{}{
+		types.EventNameLabel:   "",
+		types.ChainIDLabel:     txOrigin.ChainID,
+		types.BlockHeightLabel: strconv.FormatUint(txOrigin.GetHeight(), 10),
+		types.TxIndexLabel:     strconv.FormatUint(txOrigin.GetIndex(), 10),
+		types.BlockTimeLabel:   strconv.FormatInt(txOrigin.GetTime().Unix(), 10),
+		types.EventIndexLabel:  strconv.FormatUint(eventHeader.GetIndex(), 10),
+		types.EventTypeLabel:   eventHeader.GetEventType().String(),
+		types.TxTxHashLabel:    eventHeader.TxHash.String(),
+	}
+}
+
+// decodeTxCaller returns the (first) input address of a transaction, which for most transactions is its caller
+func decodeTxCaller(txe *exec.TxExecution) string {
+	if txe.Envelope == nil || txe.Envelope.Tx == nil || txe.Envelope.Tx.Payload == nil {
+		return ""
+	}
+	inputs := txe.Envelope.Tx.GetInputs()
+	if len(inputs) == 0 {
+		return ""
+	}
+	return inputs[0].Address.String()
+}
+
+func decodeArguments(data map[string]interface{}, prefix string, args []abi.Argument, packed []byte) error {
+	unpackedData := abi.GetPackingTypes(args)
+	if err := abi.Unpack(args, packed, unpackedData...); err != nil {
+		return err
+	}
+	for i, arg := range args {
+		name := arg.Name
+		if name == "" {
+			name = strconv.Itoa(i)
+		}
+		data[prefix+"."+name] = decodeValue(unpackedData[i])
+	}
+	return nil
+}
+
+func decodeValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case *crypto.Address:
+		return v.String()
+	case *big.Int:
+		return v.String()
+	case *string:
+		return *v
+	default:
+		return v
+	}
+}
+
+// decodeExecutionEvent decodes the execution events other than log events that can be projected, it returns nil for
+// those that cannot
+func decodeExecutionEvent(event *exec.Event, txOrigin *exec.Origin, getFunctionSpec FunctionSpecGetter,
+	logger *logging.Logger) (map[string]interface{}, error) {
+
+	switch {
+	case event.Call != nil:
+		var fnName string
+		var fnAbi *abi.FunctionSpec
+		callData := event.Call.GetCallData()
+		if callData != nil && len(callData.Data) >= abi.FunctionIDSize {
+			var functionID abi.FunctionID
+			copy(functionID[:], callData.Data)
+			var err error
+			fnName, fnAbi, err = getFunctionSpec(functionID, callData.Callee)
+			if err != nil {
+				// Not all calls are to contracts whose ABI we know (or to contracts at all) so we project the call
+				// without decoding its arguments
+				logger.TraceMsg("could not get ABI for function", structure.ErrorKey, err,
+					"function_id", functionID, "address", callData.Callee)
+				fnName, fnAbi = "", nil
+			}
+		}
+		return decodeCallEvent(event.Header, event.Call, txOrigin, fnName, fnAbi)
+	case event.Input != nil:
+		return decodeInputOutputEvent(event.Header, event.Input.Address, txOrigin), nil
+	case event.Output != nil:
+		return decodeInputOutputEvent(event.Header, event.Output.Address, txOrigin), nil
+	case event.GovernAccount != nil:
+		return decodeGovernAccountEvent(event.Header, event.GovernAccount, txOrigin), nil
+	}
+	return nil, nil
+}
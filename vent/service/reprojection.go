@@ -0,0 +1,257 @@
+package service
+
+import (
+	"context"
+	"io"
+
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/rpc/rpcevents"
+	"github.com/hyperledger/burrow/vent/sqldb"
+	"github.com/hyperledger/burrow/vent/sqlsol"
+	"github.com/hyperledger/burrow/vent/types"
+	"github.com/pkg/errors"
+)
+
+// ReprojectionPrefix is prepended to the name of a table to name the table it is reprojected into before being swapped
+// in to replace it
+const ReprojectionPrefix = "_reproject_"
+
+// reprojection rebuilds the tables whose event classes have changed since they were last projected. Each table is
+// projected from the first block into a shadow table that replaces it once it has caught up with the live tables.
+// Aggregate tables are not projected from blocks so are instead rebuilt from their source tables once any shadow tables
+// have been swapped in. Tables shared with other chains cannot be rebuilt since swapping in a shadow table would drop
+// the other chains' rows.
+type reprojection struct {
+	db      *sqldb.SQLDB
+	chainID string
+	// The live tables being rebuilt
+	tables types.EventTables
+	// All the live tables
+	liveProjection *sqlsol.Projection
+	// Projection of the rebuilt event classes into the shadow tables
+	projection *sqlsol.Projection
+	specHashes map[string][]string
+	// The last block height projected into the shadow tables
+	height uint64
+	logger *logging.Logger
+}
+
+// newReprojection compares the hashes of the event classes of projection with those stored in db and creates shadow
+// tables for the tables that need to be rebuilt, it returns nil if there is nothing to rebuild and an error if a table
+// that needs to be rebuilt is shared with another chain
+func newReprojection(db *sqldb.SQLDB, chainID string, projection *sqlsol.Projection,
+	logger *logging.Logger) (*reprojection, error) {
+
+	specHashes, err := projection.SpecHashes()
+	if err != nil {
+		return nil, err
+	}
+
+	chainSpecHashes, err := db.SpecHashes()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get stored spec hashes")
+	}
+	storedSpecHashes := chainSpecHashes[chainID]
+
+	for tableName := range storedSpecHashes {
+		if _, ok := specHashes[tableName]; !ok {
+			err = db.SetSpecHashes(chainID, tableName, nil)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	height, err := db.LastBlockHeight(chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	// If nothing has been projected yet or we have no record of what was projected (i.e. the tables predate spec
+	// hashes) then we take the tables as they are
+	if len(storedSpecHashes) == 0 || height == 0 {
+		for tableName, hashes := range specHashes {
+			err = db.SetSpecHashes(chainID, tableName, hashes)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+	}
+
+	r := &reprojection{
+		db:             db,
+		chainID:        chainID,
+		tables:         make(types.EventTables),
+		liveProjection: projection,
+		projection: &sqlsol.Projection{
+			Tables: make(types.EventTables),
+		},
+		specHashes: specHashes,
+		logger:     logger.WithScope("reprojection"),
+	}
+
+	for tableName, hashes := range specHashes {
+		if !equalHashes(hashes, storedSpecHashes[tableName]) {
+			table := projection.Tables[tableName]
+			r.tables[tableName] = table
+			if table.Aggregate == nil {
+				shadowTable := *table
+				shadowTable.Name = ReprojectionPrefix + tableName
+				shadowTable.NotifyChannels = nil
+				r.projection.Tables[shadowTable.Name] = &shadowTable
+			}
+		}
+	}
+
+	if len(r.tables) == 0 {
+		return nil, nil
+	}
+
+	for tableName, table := range projection.Tables {
+		_, changed := r.tables[tableName]
+		sourceChanged := false
+		if table.Aggregate != nil {
+			_, sourceChanged = r.tables[table.Aggregate.SourceTable]
+		}
+		if !changed && !sourceChanged {
+			continue
+		}
+		for otherChainID, otherSpecHashes := range chainSpecHashes {
+			if _, ok := otherSpecHashes[tableName]; ok && otherChainID != chainID {
+				return nil, errors.Errorf("table %s needs to be reprojected since its event classes have changed but "+
+					"it is shared with chain %s whose rows would be lost, use a new table name for the changed event "+
+					"classes", tableName, otherChainID)
+			}
+		}
+	}
+
+	for _, eventClass := range projection.Spec {
+		if _, ok := r.tables[eventClass.TableName]; ok && eventClass.Aggregate == nil {
+			shadowClass := *eventClass
+			shadowClass.TableName = ReprojectionPrefix + eventClass.TableName
+			r.projection.Spec = append(r.projection.Spec, &shadowClass)
+		}
+	}
+
+	for tableName, table := range r.projection.Tables {
+		r.logger.InfoMsg("Event classes have changed, reprojecting table", "table", tableName)
+		err = db.RecreateTable(chainID, table)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not create table %s for reprojection", tableName)
+		}
+	}
+
+	return r, nil
+}
+
+// liveTables returns the tables of projection that are not being rebuilt
+func (r *reprojection) liveTables(projection *sqlsol.Projection) types.EventTables {
+	if r == nil {
+		return projection.Tables
+	}
+	tables := make(types.EventTables, len(projection.Tables))
+	for tableName, table := range projection.Tables {
+		if _, ok := r.tables[tableName]; !ok {
+			tables[tableName] = table
+		}
+	}
+	return tables
+}
+
+// project projects the blocks from height from to height to (inclusive) into the shadow tables, it returns io.EOF
+// if the consumer is shut down before it finishes
+func (r *reprojection) project(cli rpcevents.ExecutionEventsClient, from, to uint64, getEventSpec EventSpecGetter,
+	getFunctionSpec FunctionSpecGetter, doneCh chan struct{}) error {
+
+	if from > to || len(r.projection.Tables) == 0 {
+		r.height = to
+		return nil
+	}
+
+	r.logger.InfoMsg("Reprojecting blocks", "from", from, "to", to)
+
+	request := &rpcevents.BlocksRequest{
+		BlockRange: rpcevents.AbsoluteRange(from, to),
+	}
+
+	stream, err := cli.Stream(context.Background(), request)
+	if err != nil {
+		return errors.Wrapf(err, "Error connecting to block stream")
+	}
+
+	eventCh := make(chan types.EventData, 1)
+	consumeBlock := NewBlockConsumer(r.projection, sqlsol.None, getEventSpec, getFunctionSpec, eventCh, doneCh,
+		r.logger)
+
+	err = rpcevents.ConsumeBlockExecutions(stream, func(blockExecution *exec.BlockExecution) error {
+		err := consumeBlock(blockExecution)
+		if err != nil {
+			return err
+		}
+		select {
+		case blk := <-eventCh:
+			return r.db.SetRows(r.chainID, r.projection.Tables, blk)
+		default:
+			return nil
+		}
+	})
+	if err != nil && err != io.EOF {
+		return errors.Wrapf(err, "Error reprojecting blocks")
+	}
+	if finished(doneCh) {
+		return io.EOF
+	}
+
+	r.height = to
+	return nil
+}
+
+// swap replaces each of the tables being rebuilt with its shadow table and then rebuilds the aggregate tables that
+// have changed or whose source tables have been swapped
+func (r *reprojection) swap() error {
+	for tableName, table := range r.tables {
+		if table.Aggregate != nil {
+			continue
+		}
+		r.logger.InfoMsg("Swapping in reprojected table", "table", tableName, "height", r.height)
+		err := r.db.SwapTable(r.chainID, table, ReprojectionPrefix+tableName, r.specHashes[tableName])
+		if err != nil {
+			return errors.Wrapf(err, "could not swap in reprojected table %s", tableName)
+		}
+	}
+
+	for tableName, table := range r.liveProjection.Tables {
+		if table.Aggregate == nil {
+			continue
+		}
+		_, changed := r.tables[tableName]
+		_, sourceChanged := r.tables[table.Aggregate.SourceTable]
+		if !changed && !sourceChanged {
+			continue
+		}
+		r.logger.InfoMsg("Rebuilding aggregate table", "table", tableName, "height", r.height)
+		err := r.db.RebuildAggregate(r.chainID, r.liveProjection.Tables, table, r.height)
+		if err != nil {
+			return errors.Wrapf(err, "could not rebuild aggregate table %s", tableName)
+		}
+		err = r.db.SetSpecHashes(r.chainID, tableName, r.specHashes[tableName])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func equalHashes(hashes, otherHashes []string) bool {
+	if len(hashes) != len(otherHashes) {
+		return false
+	}
+	for i, hash := range hashes {
+		if hash != otherHashes[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -17,10 +17,7 @@ import (
 
 // buildEventData builds event data from transactions
 func buildEventData(projection *sqlsol.Projection, eventClass *types.EventClass, event *exec.Event,
-	txOrigin *exec.Origin, evAbi *abi.EventSpec, logger *logging.Logger) (types.EventDataRow, error) {
-
-	// a fresh new row to store column/value data
-	row := make(map[string]interface{})
+	txOrigin *exec.Origin, txCaller string, evAbi *abi.EventSpec, logger *logging.Logger) (types.EventDataRow, error) {
 
 	// get header & log data for the given event
 	eventHeader := event.GetHeader()
@@ -31,9 +28,20 @@ func buildEventData(projection *sqlsol.Projection, eventClass *types.EventClass,
 	if err != nil {
 		return types.EventDataRow{}, errors.Wrapf(err, "Error decoding event (filter: %s)", eventClass.Filter)
 	}
+	decodedData[types.TxCallerLabel] = txCaller
 
 	logger.InfoMsg("Decoded event", decodedData)
 
+	return buildRow(projection, eventClass, decodedData, logger)
+}
+
+// buildRow maps decoded event data to the columns of the table of an event class
+func buildRow(projection *sqlsol.Projection, eventClass *types.EventClass, decodedData map[string]interface{},
+	logger *logging.Logger) (types.EventDataRow, error) {
+
+	// a fresh new row to store column/value data
+	row := make(map[string]interface{})
+
 	rowAction := types.ActionUpsert
 
 	// for each data element, maps to SQL columnName and gets its value
@@ -52,17 +60,26 @@ func buildEventData(projection *sqlsol.Projection, eventClass *types.EventClass,
 		if err == nil {
 			if fieldMapping.BytesToString {
 				if bs, ok := value.(*[]byte); ok {
-					str := sanitiseBytesForString(*bs, logger)
-					row[column.Name] = interface{}(str)
-					continue
+					value = sanitiseBytesForString(*bs, logger)
 				}
 			}
-			row[column.Name] = value
+			row[column.Name], err = transformValue(fieldMapping, value)
+			if err != nil {
+				return types.EventDataRow{}, errors.Wrapf(err, "could not transform field %s for column %s",
+					fieldName, column.Name)
+			}
 		} else {
 			logger.TraceMsg("could not get column", "err", err)
 		}
 	}
 
+	// constant columns are set regardless of the event
+	for _, fieldMapping := range eventClass.FieldMappings {
+		if fieldMapping.Constant != "" {
+			row[fieldMapping.ColumnName] = fieldMapping.Constant
+		}
+	}
+
 	return types.EventDataRow{Action: rowAction, RowData: row, EventClass: eventClass}, nil
 }
 
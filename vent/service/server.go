@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 
 	"github.com/hyperledger/burrow/logging"
@@ -10,26 +11,26 @@ import (
 
 // Server exposes HTTP endpoints for the service
 type Server struct {
-	Config   *config.VentConfig
-	Log      *logging.Logger
-	Consumer *Consumer
-	mux      *http.ServeMux
-	stopCh   chan bool
+	Config    *config.VentConfig
+	Log       *logging.Logger
+	Consumers []*Consumer
+	mux       *http.ServeMux
+	stopCh    chan bool
 }
 
-// NewServer returns a new HTTP server
-func NewServer(cfg *config.VentConfig, log *logging.Logger, consumer *Consumer) *Server {
+// NewServer returns a new HTTP server reporting on the health of consumers (one for each chain followed)
+func NewServer(cfg *config.VentConfig, log *logging.Logger, consumers ...*Consumer) *Server {
 	// setup handlers
 	mux := http.NewServeMux()
 
-	mux.HandleFunc("/health", healthHandler(consumer))
+	mux.HandleFunc("/health", healthHandler(consumers))
 
 	return &Server{
-		Config:   cfg,
-		Log:      log,
-		Consumer: consumer,
-		mux:      mux,
-		stopCh:   make(chan bool, 1),
+		Config:    cfg,
+		Log:       log,
+		Consumers: consumers,
+		mux:       mux,
+		stopCh:    make(chan bool, 1),
 	}
 }
 
@@ -53,6 +54,11 @@ func (s *Server) Run() {
 	httpServer.Shutdown(context.Background())
 }
 
+// Handle registers an additional handler, such as the read API, with the Server Mux
+func (s *Server) Handle(pattern string, handler http.Handler) {
+	s.mux.Handle(pattern, handler)
+}
+
 // ServeHTTP dispatches the HTTP requests using the Server Mux
 func (s *Server) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
 	s.mux.ServeHTTP(resp, req)
@@ -63,13 +69,25 @@ func (s *Server) Shutdown() {
 	s.stopCh <- true
 }
 
-func healthHandler(consumer *Consumer) func(resp http.ResponseWriter, req *http.Request) {
+// healthHandler responds with the health of each consumer keyed by the chain ID (or the gRPC address if it is not yet
+// known) and is unavailable if any consumer is unhealthy
+func healthHandler(consumers []*Consumer) func(resp http.ResponseWriter, req *http.Request) {
 	return func(resp http.ResponseWriter, req *http.Request) {
-		err := consumer.Health()
-		if err != nil {
-			resp.WriteHeader(http.StatusServiceUnavailable)
-		} else {
-			resp.WriteHeader(http.StatusOK)
+		status := http.StatusOK
+		health := make(map[string]string, len(consumers))
+		for _, consumer := range consumers {
+			chain := consumer.Config.GRPCAddr
+			if consumer.Burrow != nil {
+				chain = consumer.Burrow.ChainID
+			}
+			health[chain] = "ok"
+			if err := consumer.Health(); err != nil {
+				status = http.StatusServiceUnavailable
+				health[chain] = err.Error()
+			}
 		}
+		resp.Header().Set("Content-Type", "application/json")
+		resp.WriteHeader(status)
+		json.NewEncoder(resp).Encode(health)
 	}
 }
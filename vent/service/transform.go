@@ -0,0 +1,184 @@
+package service
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/crypto/sha3"
+	"github.com/hyperledger/burrow/vent/types"
+)
+
+// transformValue applies the Decimals scaling or Transform of a field mapping to a decoded value
+func transformValue(mapping *types.EventFieldMapping, value interface{}) (interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	if mapping.Decimals > 0 {
+		n, err := toBigInt(value)
+		if err != nil {
+			return nil, err
+		}
+		return fixedPoint(n, mapping.Decimals), nil
+	}
+
+	switch mapping.Transform {
+	case types.TransformHex:
+		bs, err := toBytes(value)
+		if err != nil {
+			return nil, err
+		}
+		return binary.HexBytes(bs).String(), nil
+
+	case types.TransformBase64:
+		bs, err := toBytes(value)
+		if err != nil {
+			return nil, err
+		}
+		return base64.StdEncoding.EncodeToString(bs), nil
+
+	case types.TransformChecksum:
+		str, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("cannot checksum %v of type %T as an address", value, value)
+		}
+		address, err := crypto.AddressFromHexString(str)
+		if err != nil {
+			return nil, err
+		}
+		return checksumAddress(address), nil
+
+	case types.TransformTimestamp:
+		n, err := toBigInt(value)
+		if err != nil {
+			return nil, err
+		}
+		if !n.IsInt64() {
+			return nil, fmt.Errorf("%v seconds is out of range for a timestamp", n)
+		}
+		return time.Unix(n.Int64(), 0).UTC(), nil
+
+	case types.TransformJSON:
+		str, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("cannot extract JSON from %v of type %T", value, value)
+		}
+		return extractJSON(str, mapping.JSONPath)
+	}
+
+	return value, nil
+}
+
+// fixedPoint formats n as a decimal with the given number of decimal places
+func fixedPoint(n *big.Int, decimals uint) string {
+	digits := new(big.Int).Abs(n).String()
+	if pad := int(decimals) + 1 - len(digits); pad > 0 {
+		digits = strings.Repeat("0", pad) + digits
+	}
+	point := len(digits) - int(decimals)
+	str := digits[:point] + "." + digits[point:]
+	if n.Sign() < 0 {
+		return "-" + str
+	}
+	return str
+}
+
+// checksumAddress gives the mixed-case checksum encoding of an address described by EIP-55 (without the 0x prefix)
+func checksumAddress(address crypto.Address) string {
+	lower := hex.EncodeToString(address.Bytes())
+	hash := sha3.Sha3([]byte(lower))
+	checksummed := []byte(lower)
+	for i, c := range checksummed {
+		// Upper case a letter when the corresponding nibble of the hash is at least 8
+		nibble := hash[i/2] >> 4
+		if i%2 == 1 {
+			nibble = hash[i/2] & 0xf
+		}
+		if c >= 'a' && nibble >= 8 {
+			checksummed[i] = c - 'a' + 'A'
+		}
+	}
+	return string(checksummed)
+}
+
+// extractJSON returns the value at path (as dot-separated object keys and array indices) in the JSON document str, a
+// string is returned as is and any other value as JSON, nil is returned if there is no value at path
+func extractJSON(str, path string) (interface{}, error) {
+	decoder := json.NewDecoder(strings.NewReader(str))
+	decoder.UseNumber()
+	var value interface{}
+	err := decoder.Decode(&value)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse JSON to extract %s: %v", path, err)
+	}
+
+	for _, key := range strings.Split(path, ".") {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			value = v[key]
+		case []interface{}:
+			i, err := strconv.Atoi(key)
+			if err != nil || i < 0 || i >= len(v) {
+				return nil, nil
+			}
+			value = v[i]
+		default:
+			return nil, nil
+		}
+	}
+
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return v, nil
+	default:
+		bs, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		return string(bs), nil
+	}
+}
+
+func toBytes(value interface{}) ([]byte, error) {
+	switch v := value.(type) {
+	case []byte:
+		return v, nil
+	case *[]byte:
+		return *v, nil
+	case binary.HexBytes:
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot encode %v of type %T as bytes", value, value)
+}
+
+func toBigInt(value interface{}) (*big.Int, error) {
+	switch v := value.(type) {
+	case *big.Int:
+		return v, nil
+	case string:
+		n, ok := new(big.Int).SetString(v, 10)
+		if !ok {
+			return nil, fmt.Errorf("could not parse integer from '%s'", v)
+		}
+		return n, nil
+	}
+	rv := reflect.Indirect(reflect.ValueOf(value))
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return big.NewInt(rv.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return new(big.Int).SetUint64(rv.Uint()), nil
+	}
+	return nil, fmt.Errorf("cannot convert %v of type %T to an integer", value, value)
+}
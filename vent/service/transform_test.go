@@ -0,0 +1,76 @@
+package service
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/vent/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransformValue(t *testing.T) {
+	value, err := transformValue(&types.EventFieldMapping{Decimals: 18}, big.NewInt(1230000000000000000))
+	require.NoError(t, err)
+	assert.Equal(t, "1.230000000000000000", value)
+
+	value, err = transformValue(&types.EventFieldMapping{Decimals: 4}, "-5")
+	require.NoError(t, err)
+	assert.Equal(t, "-0.0005", value)
+
+	value, err = transformValue(&types.EventFieldMapping{Transform: types.TransformHex}, &[]byte{0xca, 0xfe})
+	require.NoError(t, err)
+	assert.Equal(t, "CAFE", value)
+
+	value, err = transformValue(&types.EventFieldMapping{Transform: types.TransformBase64}, []byte("vent"))
+	require.NoError(t, err)
+	assert.Equal(t, "dmVudA==", value)
+
+	// Test vector from EIP-55
+	checksummed := "5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+	value, err = transformValue(&types.EventFieldMapping{Transform: types.TransformChecksum},
+		strings.ToUpper(checksummed))
+	require.NoError(t, err)
+	assert.Equal(t, checksummed, value)
+
+	value, err = transformValue(&types.EventFieldMapping{Transform: types.TransformTimestamp}, "1571212800")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2019, 10, 16, 8, 0, 0, 0, time.UTC), value)
+
+	value, err = transformValue(&types.EventFieldMapping{Transform: types.TransformTimestamp}, new(uint64))
+	require.NoError(t, err)
+	assert.Equal(t, time.Unix(0, 0).UTC(), value)
+
+	_, err = transformValue(&types.EventFieldMapping{Transform: types.TransformHex}, "not bytes")
+	assert.Error(t, err)
+}
+
+func TestExtractJSON(t *testing.T) {
+	doc := `{"name": "frank", "tags": ["a", "b"], "balance": 100000000000000000000, "nested": {"ok": true}}`
+
+	for path, expected := range map[string]interface{}{
+		"name":        "frank",
+		"tags.1":      "b",
+		"balance":     "100000000000000000000",
+		"nested":      `{"ok":true}`,
+		"nested.ok":   "true",
+		"tags.2":      nil,
+		"name.first":  nil,
+		"nonexistent": nil,
+	} {
+		value, err := extractJSON(doc, path)
+		require.NoError(t, err)
+		assert.Equal(t, expected, value, path)
+	}
+
+	_, err := extractJSON("{", "name")
+	assert.Error(t, err)
+}
+
+func TestChecksumAddress(t *testing.T) {
+	address := crypto.MustAddressFromHexString("FB6916095CA1DF60BB79CE92CE3EA74C37C5D359")
+	assert.Equal(t, "fB6916095ca1df60bB79Ce92cE3Ea74c37c5d359", checksumAddress(address))
+}
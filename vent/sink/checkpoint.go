@@ -0,0 +1,71 @@
+package sink
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// checkpointFile holds the last height committed for each chain by a reader of a JSONLSink writing to stdout, which
+// cannot read back what it has written so relies on its reader for the checkpoint. The file is a JSON object mapping
+// chain ID to height and is replaced atomically on each save so it always holds a complete checkpoint.
+type checkpointFile struct {
+	path    string
+	heights map[string]uint64
+}
+
+func loadCheckpointFile(path string) (*checkpointFile, error) {
+	cf := &checkpointFile{
+		path:    path,
+		heights: make(map[string]uint64),
+	}
+	bs, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return cf, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not read sink checkpoint file %s: %v", path, err)
+	}
+	err = json.Unmarshal(bs, &cf.heights)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode sink checkpoint file %s: %v", path, err)
+	}
+	return cf, nil
+}
+
+// SaveCheckpoint records height as the last height committed for chainID in the checkpoint file at path. A reader of
+// a JSONLSink writing to stdout should call it (or write the file in the same way) once it has committed the rows of
+// a block, atomically with them where it can, so that Vent resumes from the block after on restart.
+func SaveCheckpoint(path, chainID string, height uint64) error {
+	cf, err := loadCheckpointFile(path)
+	if err != nil {
+		return err
+	}
+	return cf.save(chainID, height)
+}
+
+func (cf *checkpointFile) save(chainID string, height uint64) error {
+	cf.heights[chainID] = height
+	bs, err := json.Marshal(cf.heights)
+	if err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(cf.path), filepath.Base(cf.path)+".tmp")
+	if err != nil {
+		return fmt.Errorf("could not create temporary sink checkpoint file: %v", err)
+	}
+	defer os.Remove(tmp.Name())
+	_, err = tmp.Write(bs)
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("could not write sink checkpoint file: %v", err)
+	}
+	return os.Rename(tmp.Name(), cf.path)
+}
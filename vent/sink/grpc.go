@@ -0,0 +1,124 @@
+package sink
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/vent/sink/ventsink"
+	"github.com/hyperledger/burrow/vent/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+)
+
+// GRPCSink pushes each block over a stream to a ventsink.Receiver and waits for the receiver to acknowledge it before
+// accepting the next. The receiver keeps the checkpoint: it must store the height of each block it acknowledges
+// atomically with the block's rows (and acknowledge without reprocessing any block at or below that height) which
+// gives exactly-once delivery across restarts of either side.
+type GRPCSink struct {
+	conn   *grpc.ClientConn
+	client ventsink.ReceiverClient
+	stream ventsink.Receiver_PushClient
+	cancel context.CancelFunc
+	log    *logging.Logger
+}
+
+var _ Sink = &GRPCSink{}
+
+func NewGRPCSink(address string, log *logging.Logger) (*GRPCSink, error) {
+	if address == "" {
+		return nil, fmt.Errorf("gRPC sink requires the address of a receiver")
+	}
+	conn, err := grpc.Dial(address, grpc.WithInsecure())
+	if err != nil {
+		return nil, fmt.Errorf("could not connect to gRPC receiver at %s: %v", address, err)
+	}
+	return &GRPCSink{
+		conn:   conn,
+		client: ventsink.NewReceiverClient(conn),
+		log:    log,
+	}, nil
+}
+
+func (gs *GRPCSink) Init(chainID, burrowVersion string) error {
+	return nil
+}
+
+func (gs *GRPCSink) SynchronizeDB(chainID string, eventTables types.EventTables) error {
+	return nil
+}
+
+func (gs *GRPCSink) SetBlock(chainID string, eventTables types.EventTables, eventData types.EventData) error {
+	block := &ventsink.Block{
+		ChainID: chainID,
+		Height:  eventData.BlockHeight,
+	}
+	for _, record := range Records(chainID, eventData) {
+		data, err := json.Marshal(record.Row)
+		if err != nil {
+			return fmt.Errorf("could not encode row for block %d: %v", eventData.BlockHeight, err)
+		}
+		block.Rows = append(block.Rows, &ventsink.Row{
+			Table:  record.Table,
+			Action: string(record.Action),
+			TxHash: record.TxHash,
+			Data:   data,
+		})
+	}
+	if gs.stream == nil {
+		ctx, cancel := context.WithCancel(context.Background())
+		stream, err := gs.client.Push(ctx)
+		if err != nil {
+			cancel()
+			return fmt.Errorf("could not open push stream to receiver: %v", err)
+		}
+		gs.stream = stream
+		gs.cancel = cancel
+	}
+	err := gs.stream.Send(block)
+	if err != nil {
+		gs.closeStream()
+		return fmt.Errorf("could not push block %d to receiver: %v", eventData.BlockHeight, err)
+	}
+	checkpoint, err := gs.stream.Recv()
+	if err != nil {
+		gs.closeStream()
+		return fmt.Errorf("did not receive checkpoint for block %d from receiver: %v", eventData.BlockHeight, err)
+	}
+	if checkpoint.ChainID != chainID || checkpoint.Height != eventData.BlockHeight {
+		gs.closeStream()
+		return fmt.Errorf("receiver sent checkpoint for height %d of chain %s after block %d of chain %s was pushed",
+			checkpoint.Height, checkpoint.ChainID, eventData.BlockHeight, chainID)
+	}
+	return nil
+}
+
+func (gs *GRPCSink) LastBlockHeight(chainID string) (uint64, error) {
+	checkpoint, err := gs.client.LastHeight(context.Background(), &ventsink.Checkpoint{ChainID: chainID})
+	if err != nil {
+		return 0, fmt.Errorf("could not get last height from receiver: %v", err)
+	}
+	return checkpoint.Height, nil
+}
+
+func (gs *GRPCSink) Ping() error {
+	switch state := gs.conn.GetState(); state {
+	case connectivity.TransientFailure, connectivity.Shutdown:
+		return fmt.Errorf("gRPC receiver connection is %v", state)
+	}
+	return nil
+}
+
+func (gs *GRPCSink) Close() {
+	gs.closeStream()
+	gs.conn.Close()
+}
+
+func (gs *GRPCSink) closeStream() {
+	if gs.stream != nil {
+		gs.stream.CloseSend()
+		gs.cancel()
+		gs.stream = nil
+	}
+}
@@ -0,0 +1,81 @@
+package sink
+
+import (
+	"context"
+	"io"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/vent/sink/ventsink"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+type testReceiver struct {
+	sync.Mutex
+	heights map[string]uint64
+	blocks  []*ventsink.Block
+}
+
+func (tr *testReceiver) LastHeight(ctx context.Context, checkpoint *ventsink.Checkpoint) (*ventsink.Checkpoint, error) {
+	tr.Lock()
+	defer tr.Unlock()
+	return &ventsink.Checkpoint{ChainID: checkpoint.ChainID, Height: tr.heights[checkpoint.ChainID]}, nil
+}
+
+func (tr *testReceiver) Push(stream ventsink.Receiver_PushServer) error {
+	for {
+		block, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		tr.Lock()
+		if block.Height > tr.heights[block.ChainID] {
+			tr.blocks = append(tr.blocks, block)
+			tr.heights[block.ChainID] = block.Height
+		}
+		tr.Unlock()
+		err = stream.Send(&ventsink.Checkpoint{ChainID: block.ChainID, Height: block.Height})
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func TestGRPCSink(t *testing.T) {
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	receiver := &testReceiver{heights: make(map[string]uint64)}
+	server := grpc.NewServer()
+	ventsink.RegisterReceiverServer(server, receiver)
+	go server.Serve(listener)
+	defer server.Stop()
+
+	gs, err := NewGRPCSink(listener.Addr().String(), logging.NewNoopLogger())
+	require.NoError(t, err)
+	defer gs.Close()
+
+	require.NoError(t, gs.SetBlock(chainID, nil, eventData(3)))
+	require.NoError(t, gs.SetBlock(chainID, nil, eventData(5)))
+	height, err := gs.LastBlockHeight(chainID)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), height)
+	require.NoError(t, gs.Ping())
+
+	receiver.Lock()
+	defer receiver.Unlock()
+	require.Len(t, receiver.blocks, 2)
+	block := receiver.blocks[1]
+	assert.Equal(t, uint64(5), block.Height)
+	require.Len(t, block.Rows, 2)
+	assert.Equal(t, "Things", block.Rows[0].Table)
+	assert.Equal(t, "UPSERT", block.Rows[0].Action)
+	assert.Equal(t, "ABCD", block.Rows[0].TxHash)
+	assert.JSONEq(t, `{"name":"foo","_txhash":"ABCD"}`, string(block.Rows[0].Data))
+}
@@ -0,0 +1,173 @@
+package sink
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/vent/types"
+)
+
+// Path that selects stdout as the destination of a JSONLSink
+const StdoutPath = "-"
+
+// JSONLSink writes a JSON encoded Record per line for each projected row of a block followed by a checkpoint Record
+// for the block. When writing to a file the checkpoint records are the checkpoint: on opening, anything written after
+// the last checkpoint (a block only partly written when Vent stopped) is truncated, so each block is written exactly
+// once. Stdout cannot be read back so when writing to stdout the reader keeps the checkpoint: it must save the height
+// of each block to the checkpoint file (see SaveCheckpoint) atomically with committing the block's rows. Vent never
+// writes the file, it resumes from the height in it, so each block is committed by the reader exactly once.
+type JSONLSink struct {
+	// The file written to or nil for stdout
+	file   *os.File
+	writer io.Writer
+	// The last height written for each chain
+	heights map[string]uint64
+	// The checkpoint file kept by the reader of stdout
+	checkpointPath string
+	log            *logging.Logger
+}
+
+var _ Sink = &JSONLSink{}
+
+// NewJSONLSink opens a JSONLSink writing to path or stdout if path is empty or StdoutPath, in which case the
+// checkpointPath kept by the reader is required
+func NewJSONLSink(path, checkpointPath string, log *logging.Logger) (*JSONLSink, error) {
+	js := &JSONLSink{
+		heights: make(map[string]uint64),
+		log:     log,
+	}
+	if path == "" || path == StdoutPath {
+		if checkpointPath == "" {
+			return nil, fmt.Errorf("writing JSON lines to stdout requires a checkpoint file")
+		}
+		checkpoints, err := loadCheckpointFile(checkpointPath)
+		if err != nil {
+			return nil, err
+		}
+		js.heights = checkpoints.heights
+		js.checkpointPath = checkpointPath
+		js.writer = os.Stdout
+		return js, nil
+	}
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("could not open JSON lines file %s: %v", path, err)
+	}
+	js.file = file
+	js.writer = file
+	err = js.recover()
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("could not recover checkpoint from JSON lines file %s: %v", path, err)
+	}
+	return js, nil
+}
+
+func (js *JSONLSink) Init(chainID, burrowVersion string) error {
+	return nil
+}
+
+func (js *JSONLSink) SynchronizeDB(chainID string, eventTables types.EventTables) error {
+	return nil
+}
+
+func (js *JSONLSink) SetBlock(chainID string, eventTables types.EventTables, eventData types.EventData) error {
+	if checkpointed(js.heights, chainID, eventData.BlockHeight) {
+		js.log.InfoMsg("Skipping block already written", "height", eventData.BlockHeight)
+		return nil
+	}
+	buf := new(bytes.Buffer)
+	encoder := json.NewEncoder(buf)
+	encoder.SetEscapeHTML(false)
+	for _, record := range append(Records(chainID, eventData), checkpointRecord(chainID, eventData.BlockHeight)) {
+		err := encoder.Encode(record)
+		if err != nil {
+			return fmt.Errorf("could not encode record for block %d: %v", eventData.BlockHeight, err)
+		}
+	}
+	// Write the block in one go to minimise the chance of it being interleaved or partially written
+	_, err := js.writer.Write(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("could not write block %d: %v", eventData.BlockHeight, err)
+	}
+	if js.file != nil {
+		err = js.file.Sync()
+		if err != nil {
+			return fmt.Errorf("could not sync block %d: %v", eventData.BlockHeight, err)
+		}
+	}
+	js.heights[chainID] = eventData.BlockHeight
+	return nil
+}
+
+func (js *JSONLSink) LastBlockHeight(chainID string) (uint64, error) {
+	height := js.heights[chainID]
+	if js.checkpointPath != "" {
+		// Blocks we have written may not have been committed by the reader yet so only move forward
+		checkpoints, err := loadCheckpointFile(js.checkpointPath)
+		if err != nil {
+			return 0, err
+		}
+		if checkpoints.heights[chainID] > height {
+			height = checkpoints.heights[chainID]
+		}
+	}
+	return height, nil
+}
+
+func (js *JSONLSink) Ping() error {
+	return nil
+}
+
+func (js *JSONLSink) Close() {
+	if js.file != nil {
+		js.file.Close()
+	}
+}
+
+// Read the checkpoints from the file and truncate anything after the last one
+func (js *JSONLSink) recover() error {
+	reader := bufio.NewReader(js.file)
+	var offset, checkpointOffset int64
+	for {
+		line, err := reader.ReadBytes('\n')
+		offset += int64(len(line))
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		// Only decode the fields of a checkpoint record
+		record := new(struct {
+			ChainID string
+			Height  uint64
+			Action  types.DBAction
+		})
+		if json.Unmarshal(line, record) == nil && record.Action == ActionCheckpoint {
+			js.heights[record.ChainID] = record.Height
+			checkpointOffset = offset
+		}
+	}
+	if checkpointOffset < offset {
+		js.log.InfoMsg("Truncating records written after last checkpoint",
+			"bytes_truncated", offset-checkpointOffset)
+		err := js.file.Truncate(checkpointOffset)
+		if err != nil {
+			return err
+		}
+	}
+	_, err := js.file.Seek(checkpointOffset, io.SeekStart)
+	return err
+}
+
+// Returns true if the block at height has already been checkpointed for chainID
+func checkpointed(heights map[string]uint64, chainID string, height uint64) bool {
+	last, ok := heights[chainID]
+	return ok && height <= last
+}
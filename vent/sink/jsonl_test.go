@@ -0,0 +1,137 @@
+package sink
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/vent/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const chainID = "CHAIN_123"
+
+func TestJSONLSink(t *testing.T) {
+	dir, err := ioutil.TempDir("", "vent-sink")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "vent.jsonl")
+
+	js, err := NewJSONLSink(path, "", logging.NewNoopLogger())
+	require.NoError(t, err)
+	height, err := js.LastBlockHeight(chainID)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), height)
+
+	require.NoError(t, js.SetBlock(chainID, nil, eventData(3)))
+	require.NoError(t, js.SetBlock(chainID, nil, eventData(5)))
+	// Already written
+	require.NoError(t, js.SetBlock(chainID, nil, eventData(5)))
+	js.Close()
+
+	// Simulate stopping part way through a block
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0644)
+	require.NoError(t, err)
+	_, err = file.WriteString(`{"ChainID":"CHAIN_123","Height":7,"Table":"Things","Action":"UPSERT","Row":{"na`)
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	js, err = NewJSONLSink(path, "", logging.NewNoopLogger())
+	require.NoError(t, err)
+	height, err = js.LastBlockHeight(chainID)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), height)
+	require.NoError(t, js.SetBlock(chainID, nil, eventData(7)))
+	js.Close()
+
+	records := readRecords(t, path)
+	require.Len(t, records, 9)
+	var heights []uint64
+	for _, record := range records {
+		if record.Action == ActionCheckpoint {
+			heights = append(heights, record.Height)
+		}
+	}
+	assert.Equal(t, []uint64{3, 5, 7}, heights)
+	assert.Equal(t, Record{ChainID: chainID, Height: 7, TxHash: "ABCD", Table: "Things", Action: types.ActionUpsert,
+		Row: map[string]interface{}{"name": "foo", "_txhash": "ABCD"}}, records[6])
+}
+
+func TestJSONLSinkStdout(t *testing.T) {
+	dir, err := ioutil.TempDir("", "vent-sink")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	checkpointPath := filepath.Join(dir, "checkpoint.json")
+
+	_, err = NewJSONLSink(StdoutPath, "", logging.NewNoopLogger())
+	require.Error(t, err)
+
+	open := func() (*JSONLSink, *bytes.Buffer) {
+		js, err := NewJSONLSink(StdoutPath, checkpointPath, logging.NewNoopLogger())
+		require.NoError(t, err)
+		buf := new(bytes.Buffer)
+		js.writer = buf
+		return js, buf
+	}
+
+	js, buf := open()
+	require.NoError(t, js.SetBlock(chainID, nil, eventData(3)))
+	assert.Equal(t, 3, strings.Count(buf.String(), "\n"))
+	height, err := js.LastBlockHeight(chainID)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), height)
+	// Vent leaves the checkpoint to the reader
+	_, err = os.Stat(checkpointPath)
+	assert.True(t, os.IsNotExist(err))
+
+	// The reader did not commit the block so it is written again after a restart
+	js, buf = open()
+	height, err = js.LastBlockHeight(chainID)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), height)
+
+	// Once committed by the reader it is not
+	require.NoError(t, SaveCheckpoint(checkpointPath, chainID, 3))
+	js, buf = open()
+	height, err = js.LastBlockHeight(chainID)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), height)
+	require.NoError(t, js.SetBlock(chainID, nil, eventData(3)))
+	assert.Equal(t, 0, buf.Len())
+	require.NoError(t, js.SetBlock(chainID, nil, eventData(4)))
+	assert.Equal(t, 3, strings.Count(buf.String(), "\n"))
+}
+
+func eventData(height uint64) types.EventData {
+	return types.EventData{
+		BlockHeight: height,
+		Tables: map[string]types.EventDataTable{
+			"Things": {
+				{Action: types.ActionUpsert, RowData: map[string]interface{}{"name": "foo", "_txhash": "ABCD"}},
+				{Action: types.ActionDelete, RowData: map[string]interface{}{"name": "bar", "_txhash": "ABCD"}},
+			},
+		},
+	}
+}
+
+func readRecords(t *testing.T, path string) []Record {
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+	var records []Record
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		record := Record{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+		records = append(records, record)
+	}
+	require.NoError(t, scanner.Err())
+	return records
+}
@@ -0,0 +1,118 @@
+package sink
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/vent/config"
+	"github.com/hyperledger/burrow/vent/sqldb"
+	"github.com/hyperledger/burrow/vent/types"
+)
+
+// Action of the Record that marks the end of the records for a block in a stream
+const ActionCheckpoint types.DBAction = "CHECKPOINT"
+
+// Sink is a destination for the rows projected from each block. The consumer resumes from LastBlockHeight so a Sink
+// never skips a block. Each Sink records the height of each block atomically with the block's rows, or relies on its
+// receiver to (GRPCSink, WebhookSink, and JSONLSink writing to stdout), so delivers each block exactly once as SQLDB
+// does with _vent_log.
+type Sink interface {
+	// Init prepares the sink for chainID, dropping anything kept for a previous chain where the sink is able to
+	Init(chainID, burrowVersion string) error
+	// SynchronizeDB brings any structure kept by the sink into line with the projection tables
+	SynchronizeDB(chainID string, eventTables types.EventTables) error
+	// SetBlock delivers the rows of a block and checkpoints its height
+	SetBlock(chainID string, eventTables types.EventTables, eventData types.EventData) error
+	// LastBlockHeight returns the height of the last block checkpointed for chainID
+	LastBlockHeight(chainID string) (uint64, error)
+	Ping() error
+	Close()
+}
+
+var _ Sink = &sqldb.SQLDB{}
+
+// Record is the form in which stream sinks deliver a projected row
+type Record struct {
+	ChainID string
+	Height  uint64
+	// Hash of the transaction that caused the row if it is projected
+	TxHash string `json:",omitempty"`
+	Table  string `json:",omitempty"`
+	Action types.DBAction
+	Row    map[string]interface{} `json:",omitempty"`
+}
+
+// NewSink connects the sink selected by cfg
+func NewSink(cfg *config.VentConfig, log *logging.Logger) (Sink, error) {
+	switch cfg.Sink {
+	case "", types.SQLSink:
+		db, err := sqldb.NewSQLDB(types.SQLConnection{
+			DBAdapter:  cfg.DBAdapter,
+			DBURL:      cfg.DBURL,
+			DBSchema:   cfg.DBSchema,
+			MultiChain: cfg.MultiChain(),
+			Log:        log,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return db, nil
+	case types.JSONLSink:
+		js, err := NewJSONLSink(cfg.SinkURL, cfg.SinkCheckpoint, log)
+		if err != nil {
+			return nil, err
+		}
+		return js, nil
+	case types.WebhookSink:
+		ws, err := NewWebhookSink(cfg.SinkURL, log)
+		if err != nil {
+			return nil, err
+		}
+		return ws, nil
+	case types.GRPCSink:
+		gs, err := NewGRPCSink(cfg.SinkURL, log)
+		if err != nil {
+			return nil, err
+		}
+		return gs, nil
+	default:
+		return nil, fmt.Errorf("sink '%s' not recognised, must be one of %s, %s, %s, or %s", cfg.Sink,
+			types.SQLSink, types.JSONLSink, types.WebhookSink, types.GRPCSink)
+	}
+}
+
+// Records flattens the rows of a block into Records ordered by table name then row order
+func Records(chainID string, eventData types.EventData) []Record {
+	tableNames := make([]string, 0, len(eventData.Tables))
+	for name := range eventData.Tables {
+		tableNames = append(tableNames, name)
+	}
+	sort.Strings(tableNames)
+
+	var records []Record
+	for _, name := range tableNames {
+		for _, row := range eventData.Tables[name] {
+			record := Record{
+				ChainID: chainID,
+				Height:  eventData.BlockHeight,
+				Table:   name,
+				Action:  row.Action,
+				Row:     row.RowData,
+			}
+			if txHash, ok := row.RowData[types.DefaultSQLColumnNames.TxHash].(string); ok {
+				record.TxHash = txHash
+			}
+			records = append(records, record)
+		}
+	}
+	return records
+}
+
+func checkpointRecord(chainID string, height uint64) Record {
+	return Record{
+		ChainID: chainID,
+		Height:  height,
+		Action:  ActionCheckpoint,
+	}
+}
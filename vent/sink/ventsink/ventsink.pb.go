@@ -0,0 +1,469 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ventsink.proto
+
+package ventsink
+
+import (
+	context "context"
+	fmt "fmt"
+	math "math"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	golang_proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = golang_proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type Checkpoint struct {
+	ChainID              string   `protobuf:"bytes,1,opt,name=ChainID,proto3" json:"ChainID,omitempty"`
+	Height               uint64   `protobuf:"varint,2,opt,name=Height,proto3" json:"Height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Checkpoint) Reset()         { *m = Checkpoint{} }
+func (m *Checkpoint) String() string { return proto.CompactTextString(m) }
+func (*Checkpoint) ProtoMessage()    {}
+func (*Checkpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac901fa52865328f, []int{0}
+}
+func (m *Checkpoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Checkpoint.Unmarshal(m, b)
+}
+func (m *Checkpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Checkpoint.Marshal(b, m, deterministic)
+}
+func (m *Checkpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Checkpoint.Merge(m, src)
+}
+func (m *Checkpoint) XXX_Size() int {
+	return xxx_messageInfo_Checkpoint.Size(m)
+}
+func (m *Checkpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_Checkpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Checkpoint proto.InternalMessageInfo
+
+func (m *Checkpoint) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *Checkpoint) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (*Checkpoint) XXX_MessageName() string {
+	return "ventsink.Checkpoint"
+}
+
+type Block struct {
+	ChainID              string   `protobuf:"bytes,1,opt,name=ChainID,proto3" json:"ChainID,omitempty"`
+	Height               uint64   `protobuf:"varint,2,opt,name=Height,proto3" json:"Height,omitempty"`
+	Rows                 []*Row   `protobuf:"bytes,3,rep,name=Rows,proto3" json:"Rows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Block) Reset()         { *m = Block{} }
+func (m *Block) String() string { return proto.CompactTextString(m) }
+func (*Block) ProtoMessage()    {}
+func (*Block) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac901fa52865328f, []int{1}
+}
+func (m *Block) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Block.Unmarshal(m, b)
+}
+func (m *Block) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Block.Marshal(b, m, deterministic)
+}
+func (m *Block) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Block.Merge(m, src)
+}
+func (m *Block) XXX_Size() int {
+	return xxx_messageInfo_Block.Size(m)
+}
+func (m *Block) XXX_DiscardUnknown() {
+	xxx_messageInfo_Block.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Block proto.InternalMessageInfo
+
+func (m *Block) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *Block) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Block) GetRows() []*Row {
+	if m != nil {
+		return m.Rows
+	}
+	return nil
+}
+
+func (*Block) XXX_MessageName() string {
+	return "ventsink.Block"
+}
+
+type Row struct {
+	// Name of the projection table
+	Table string `protobuf:"bytes,1,opt,name=Table,proto3" json:"Table,omitempty"`
+	// UPSERT or DELETE
+	Action string `protobuf:"bytes,2,opt,name=Action,proto3" json:"Action,omitempty"`
+	// Hash of the transaction that caused the row, if it is projected
+	TxHash string `protobuf:"bytes,3,opt,name=TxHash,proto3" json:"TxHash,omitempty"`
+	// Column values of the row as a JSON object
+	Data                 []byte   `protobuf:"bytes,4,opt,name=Data,proto3" json:"Data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Row) Reset()         { *m = Row{} }
+func (m *Row) String() string { return proto.CompactTextString(m) }
+func (*Row) ProtoMessage()    {}
+func (*Row) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac901fa52865328f, []int{2}
+}
+func (m *Row) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Row.Unmarshal(m, b)
+}
+func (m *Row) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Row.Marshal(b, m, deterministic)
+}
+func (m *Row) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Row.Merge(m, src)
+}
+func (m *Row) XXX_Size() int {
+	return xxx_messageInfo_Row.Size(m)
+}
+func (m *Row) XXX_DiscardUnknown() {
+	xxx_messageInfo_Row.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Row proto.InternalMessageInfo
+
+func (m *Row) GetTable() string {
+	if m != nil {
+		return m.Table
+	}
+	return ""
+}
+
+func (m *Row) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *Row) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *Row) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (*Row) XXX_MessageName() string {
+	return "ventsink.Row"
+}
+func init() {
+	proto.RegisterType((*Checkpoint)(nil), "ventsink.Checkpoint")
+	golang_proto.RegisterType((*Checkpoint)(nil), "ventsink.Checkpoint")
+	proto.RegisterType((*Block)(nil), "ventsink.Block")
+	golang_proto.RegisterType((*Block)(nil), "ventsink.Block")
+	proto.RegisterType((*Row)(nil), "ventsink.Row")
+	golang_proto.RegisterType((*Row)(nil), "ventsink.Row")
+}
+
+func init() { proto.RegisterFile("ventsink.proto", fileDescriptor_ac901fa52865328f) }
+func init() { golang_proto.RegisterFile("ventsink.proto", fileDescriptor_ac901fa52865328f) }
+
+var fileDescriptor_ac901fa52865328f = []byte{
+	// 323 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0x3f, 0x4f, 0xc2, 0x40,
+	0x14, 0xc0, 0x73, 0xb6, 0x20, 0x3c, 0xff, 0x25, 0x17, 0x62, 0x1a, 0x86, 0xa6, 0x32, 0x75, 0x91,
+	0x22, 0x2e, 0x4e, 0x26, 0x02, 0x03, 0x26, 0x0e, 0xe6, 0xc2, 0x64, 0x4c, 0x4c, 0x5b, 0xcf, 0xde,
+	0x05, 0xec, 0x23, 0xed, 0x95, 0xea, 0xb7, 0x73, 0xf4, 0x23, 0x38, 0x1a, 0xf8, 0x22, 0xa6, 0xd7,
+	0x62, 0x1d, 0x58, 0xdc, 0xee, 0xf7, 0xfe, 0xfc, 0xf2, 0xee, 0x3d, 0x38, 0x5e, 0xf1, 0x58, 0xa5,
+	0x32, 0x9e, 0xf7, 0x97, 0x09, 0x2a, 0xa4, 0xad, 0x2d, 0x77, 0xcf, 0x23, 0xa9, 0x44, 0x16, 0xf4,
+	0x43, 0x7c, 0xf5, 0x22, 0x8c, 0xd0, 0xd3, 0x05, 0x41, 0xf6, 0xa2, 0x49, 0x83, 0x7e, 0x95, 0x8d,
+	0xbd, 0x6b, 0x80, 0xb1, 0xe0, 0xe1, 0x7c, 0x89, 0x32, 0x56, 0xd4, 0x82, 0xfd, 0xb1, 0xf0, 0x65,
+	0x7c, 0x3b, 0xb1, 0x88, 0x43, 0xdc, 0x36, 0xdb, 0x22, 0x3d, 0x85, 0xe6, 0x94, 0xcb, 0x48, 0x28,
+	0x6b, 0xcf, 0x21, 0xae, 0xc9, 0x2a, 0xea, 0x3d, 0x42, 0x63, 0xb4, 0xc0, 0x70, 0xfe, 0xff, 0x56,
+	0x7a, 0x06, 0x26, 0xc3, 0x3c, 0xb5, 0x0c, 0xc7, 0x70, 0x0f, 0x86, 0x47, 0xfd, 0xdf, 0x2f, 0x31,
+	0xcc, 0x99, 0x4e, 0xf5, 0x9e, 0xc0, 0x60, 0x98, 0xd3, 0x0e, 0x34, 0x66, 0x7e, 0xb0, 0xe0, 0x95,
+	0xb9, 0x84, 0xc2, 0x7b, 0x13, 0x2a, 0x89, 0xb1, 0xf6, 0xb6, 0x59, 0x45, 0x45, 0x7c, 0xf6, 0x36,
+	0xf5, 0x53, 0x61, 0x19, 0x65, 0xbc, 0x24, 0x4a, 0xc1, 0x9c, 0xf8, 0xca, 0xb7, 0x4c, 0x87, 0xb8,
+	0x87, 0x4c, 0xbf, 0x87, 0x39, 0xb4, 0x18, 0x0f, 0xb9, 0x5c, 0xf1, 0x84, 0x5e, 0x01, 0xdc, 0xf9,
+	0xa9, 0xaa, 0xa6, 0xeb, 0xd4, 0xf3, 0xd4, 0x0b, 0xea, 0xee, 0x8c, 0xd2, 0x0b, 0x30, 0xef, 0xb3,
+	0x54, 0xd0, 0x93, 0x3a, 0xab, 0x97, 0xb2, 0xbb, 0xdc, 0x25, 0x03, 0x32, 0x1a, 0x7d, 0xad, 0x6d,
+	0xf2, 0xbd, 0xb6, 0xc9, 0xc7, 0xc6, 0x26, 0x9f, 0x1b, 0x9b, 0x3c, 0x0c, 0xfe, 0x1c, 0x4e, 0xbc,
+	0x2f, 0x79, 0xb2, 0xe0, 0xcf, 0x11, 0x4f, 0xbc, 0x20, 0x4b, 0x12, 0xcc, 0xbd, 0x42, 0xe4, 0x15,
+	0x26, 0x6f, 0xab, 0x0c, 0x9a, 0xfa, 0x84, 0x97, 0x3f, 0x03, 0x00, 0x1c, 0x13, 0xf0, 0xfb, 0x0d,
+	0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ReceiverClient is the client API for Receiver service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ReceiverClient interface {
+	// Returns the height of the last block the receiver has acknowledged for a chain
+	LastHeight(ctx context.Context, in *Checkpoint, opts ...grpc.CallOption) (*Checkpoint, error)
+	// Vent pushes each block in height order, the receiver replies with a Checkpoint once a block is processed
+	Push(ctx context.Context, opts ...grpc.CallOption) (Receiver_PushClient, error)
+}
+
+type receiverClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewReceiverClient(cc *grpc.ClientConn) ReceiverClient {
+	return &receiverClient{cc}
+}
+
+func (c *receiverClient) LastHeight(ctx context.Context, in *Checkpoint, opts ...grpc.CallOption) (*Checkpoint, error) {
+	out := new(Checkpoint)
+	err := c.cc.Invoke(ctx, "/ventsink.Receiver/LastHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *receiverClient) Push(ctx context.Context, opts ...grpc.CallOption) (Receiver_PushClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Receiver_serviceDesc.Streams[0], "/ventsink.Receiver/Push", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &receiverPushClient{stream}
+	return x, nil
+}
+
+type Receiver_PushClient interface {
+	Send(*Block) error
+	Recv() (*Checkpoint, error)
+	grpc.ClientStream
+}
+
+type receiverPushClient struct {
+	grpc.ClientStream
+}
+
+func (x *receiverPushClient) Send(m *Block) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *receiverPushClient) Recv() (*Checkpoint, error) {
+	m := new(Checkpoint)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ReceiverServer is the server API for Receiver service.
+type ReceiverServer interface {
+	// Returns the height of the last block the receiver has acknowledged for a chain
+	LastHeight(context.Context, *Checkpoint) (*Checkpoint, error)
+	// Vent pushes each block in height order, the receiver replies with a Checkpoint once a block is processed
+	Push(Receiver_PushServer) error
+}
+
+func RegisterReceiverServer(s *grpc.Server, srv ReceiverServer) {
+	s.RegisterService(&_Receiver_serviceDesc, srv)
+}
+
+func _Receiver_LastHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Checkpoint)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReceiverServer).LastHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ventsink.Receiver/LastHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReceiverServer).LastHeight(ctx, req.(*Checkpoint))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Receiver_Push_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ReceiverServer).Push(&receiverPushServer{stream})
+}
+
+type Receiver_PushServer interface {
+	Send(*Checkpoint) error
+	Recv() (*Block, error)
+	grpc.ServerStream
+}
+
+type receiverPushServer struct {
+	grpc.ServerStream
+}
+
+func (x *receiverPushServer) Send(m *Checkpoint) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *receiverPushServer) Recv() (*Block, error) {
+	m := new(Block)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+var _Receiver_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ventsink.Receiver",
+	HandlerType: (*ReceiverServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "LastHeight",
+			Handler:    _Receiver_LastHeight_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Push",
+			Handler:       _Receiver_Push_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "ventsink.proto",
+}
+
+func (m *Checkpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovVentsink(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovVentsink(uint64(m.Height))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Block) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovVentsink(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovVentsink(uint64(m.Height))
+	}
+	if len(m.Rows) > 0 {
+		for _, e := range m.Rows {
+			l = e.Size()
+			n += 1 + l + sovVentsink(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Row) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Table)
+	if l > 0 {
+		n += 1 + l + sovVentsink(uint64(l))
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovVentsink(uint64(l))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovVentsink(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovVentsink(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovVentsink(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozVentsink(x uint64) (n int) {
+	return sovVentsink(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
@@ -0,0 +1,172 @@
+package sink
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/logging/structure"
+	"github.com/hyperledger/burrow/vent/types"
+)
+
+const (
+	DefaultWebhookMaxAttempts = 5
+	DefaultWebhookBackoff     = time.Second
+	DefaultWebhookTimeout     = 30 * time.Second
+	// Header identifying the block delivered so that a receiver can discard a block delivered twice
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// Query parameter naming the chain whose checkpoint is asked for by a GET request to the webhook
+	WebhookChainIDParam = "chainID"
+)
+
+// WebhookPayload is the JSON body POSTed to a webhook for each block
+type WebhookPayload struct {
+	ChainID string
+	Height  uint64
+	Records []Record
+}
+
+// WebhookCheckpoint is the JSON body a webhook responds with to a GET request for the last height it has committed
+type WebhookCheckpoint struct {
+	ChainID string
+	Height  uint64
+}
+
+// WebhookSink POSTs a WebhookPayload for each block to a URL, retrying with exponential backoff on connection errors,
+// 5xx responses, and 429 Too Many Requests. Any other non-2xx response fails the block. As with GRPCSink the receiver
+// keeps the checkpoint: it must store the height of each block atomically with the block's rows before responding
+// with 2xx, acknowledge without reprocessing any block at or below that height, and respond to a GET request with
+// the WebhookCheckpoint for the chain named by the chainID query parameter. Vent resumes from that checkpoint and
+// checks it before retrying a block, which gives exactly-once delivery across restarts of either side.
+type WebhookSink struct {
+	URL    string
+	Client *http.Client
+	// Number of times delivery of a block is attempted before failing
+	MaxAttempts int
+	// Delay before the first retry, doubled for each subsequent retry
+	Backoff time.Duration
+	log     *logging.Logger
+}
+
+var _ Sink = &WebhookSink{}
+
+func NewWebhookSink(webhookURL string, log *logging.Logger) (*WebhookSink, error) {
+	if webhookURL == "" {
+		return nil, fmt.Errorf("webhook sink requires a URL")
+	}
+	_, err := url.Parse(webhookURL)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse webhook URL: %v", err)
+	}
+	return &WebhookSink{
+		URL:         webhookURL,
+		Client:      &http.Client{Timeout: DefaultWebhookTimeout},
+		MaxAttempts: DefaultWebhookMaxAttempts,
+		Backoff:     DefaultWebhookBackoff,
+		log:         log,
+	}, nil
+}
+
+func (ws *WebhookSink) Init(chainID, burrowVersion string) error {
+	return nil
+}
+
+func (ws *WebhookSink) SynchronizeDB(chainID string, eventTables types.EventTables) error {
+	return nil
+}
+
+func (ws *WebhookSink) SetBlock(chainID string, eventTables types.EventTables, eventData types.EventData) error {
+	body, err := json.Marshal(WebhookPayload{
+		ChainID: chainID,
+		Height:  eventData.BlockHeight,
+		Records: Records(chainID, eventData),
+	})
+	if err != nil {
+		return fmt.Errorf("could not encode block %d: %v", eventData.BlockHeight, err)
+	}
+	key := fmt.Sprintf("%s/%d", chainID, eventData.BlockHeight)
+	backoff := ws.Backoff
+	for attempt := 1; ; attempt++ {
+		var retry bool
+		retry, err = ws.post(body, key)
+		if err == nil {
+			return nil
+		}
+		if !retry || attempt >= ws.MaxAttempts {
+			return fmt.Errorf("could not deliver block %d to webhook after %d attempts: %v",
+				eventData.BlockHeight, attempt, err)
+		}
+		ws.log.InfoMsg("Retrying webhook delivery", structure.ErrorKey, err,
+			"height", eventData.BlockHeight, "attempt", attempt, "backoff", backoff.String())
+		time.Sleep(backoff)
+		backoff *= 2
+		// The receiver may have committed the block without us hearing back, in which case we are done
+		height, heightErr := ws.LastBlockHeight(chainID)
+		if heightErr == nil && height >= eventData.BlockHeight {
+			return nil
+		}
+	}
+}
+
+func (ws *WebhookSink) LastBlockHeight(chainID string) (uint64, error) {
+	webhookURL, err := url.Parse(ws.URL)
+	if err != nil {
+		return 0, err
+	}
+	query := webhookURL.Query()
+	query.Set(WebhookChainIDParam, chainID)
+	webhookURL.RawQuery = query.Encode()
+	response, err := ws.Client.Get(webhookURL.String())
+	if err != nil {
+		return 0, fmt.Errorf("could not get last height from webhook: %v", err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return 0, fmt.Errorf("webhook responded to request for last height with status %s", response.Status)
+	}
+	checkpoint := new(WebhookCheckpoint)
+	err = json.NewDecoder(response.Body).Decode(checkpoint)
+	if err != nil {
+		return 0, fmt.Errorf("could not decode last height from webhook: %v", err)
+	}
+	if checkpoint.ChainID != chainID {
+		return 0, fmt.Errorf("webhook sent checkpoint for chain %s when asked for chain %s", checkpoint.ChainID,
+			chainID)
+	}
+	return checkpoint.Height, nil
+}
+
+func (ws *WebhookSink) Ping() error {
+	return nil
+}
+
+func (ws *WebhookSink) Close() {
+}
+
+// Post body returning whether the request may be retried if it failed
+func (ws *WebhookSink) post(body []byte, key string) (bool, error) {
+	request, err := http.NewRequest(http.MethodPost, ws.URL, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(IdempotencyKeyHeader, key)
+	response, err := ws.Client.Do(request)
+	if err != nil {
+		return true, err
+	}
+	// Drain the body so that the connection can be reused
+	_, _ = io.Copy(ioutil.Discard, response.Body)
+	response.Body.Close()
+	if response.StatusCode >= 200 && response.StatusCode < 300 {
+		return false, nil
+	}
+	err = fmt.Errorf("webhook responded with status %s", response.Status)
+	return response.StatusCode >= 500 || response.StatusCode == http.StatusTooManyRequests, err
+}
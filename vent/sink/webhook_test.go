@@ -0,0 +1,87 @@
+package sink
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookSink(t *testing.T) {
+	var keys []string
+	var payloads []WebhookPayload
+	heights := make(map[string]uint64)
+	// Number of requests to fail before committing
+	failures := 2
+	// Whether to fail requests after committing, as if the acknowledgement were lost
+	loseAcks := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			chainID := r.URL.Query().Get(WebhookChainIDParam)
+			_ = json.NewEncoder(w).Encode(WebhookCheckpoint{ChainID: chainID, Height: heights[chainID]})
+			return
+		}
+		keys = append(keys, r.Header.Get(IdempotencyKeyHeader))
+		if failures > 0 {
+			failures--
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		payload := WebhookPayload{}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		// Commit the block and its checkpoint together, acknowledging without reprocessing a block already committed
+		if payload.Height > heights[payload.ChainID] {
+			payloads = append(payloads, payload)
+			heights[payload.ChainID] = payload.Height
+		}
+		if loseAcks {
+			w.WriteHeader(http.StatusGatewayTimeout)
+		}
+	}))
+	defer server.Close()
+
+	ws, err := NewWebhookSink(server.URL, logging.NewNoopLogger())
+	require.NoError(t, err)
+	ws.Backoff = time.Millisecond
+
+	height, err := ws.LastBlockHeight(chainID)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), height)
+
+	require.NoError(t, ws.SetBlock(chainID, nil, eventData(3)))
+	assert.Equal(t, []string{"CHAIN_123/3", "CHAIN_123/3", "CHAIN_123/3"}, keys)
+	require.Len(t, payloads, 1)
+	assert.Equal(t, uint64(3), payloads[0].Height)
+	assert.Len(t, payloads[0].Records, 2)
+
+	// The receiver's checkpoint survives restart
+	ws, err = NewWebhookSink(server.URL, logging.NewNoopLogger())
+	require.NoError(t, err)
+	ws.Backoff = time.Millisecond
+	height, err = ws.LastBlockHeight(chainID)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), height)
+
+	// A block committed whose acknowledgement is lost is not delivered again
+	keys = nil
+	loseAcks = true
+	require.NoError(t, ws.SetBlock(chainID, nil, eventData(4)))
+	assert.Equal(t, []string{"CHAIN_123/4"}, keys)
+	require.Len(t, payloads, 2)
+	loseAcks = false
+
+	// Retries exhausted
+	failures = 10
+	require.Error(t, ws.SetBlock(chainID, nil, eventData(5)))
+	height, err = ws.LastBlockHeight(chainID)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(4), height)
+}
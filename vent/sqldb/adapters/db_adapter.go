@@ -43,6 +43,8 @@ type DBAdapter interface {
 	CleanDBQueries() types.SQLCleanDBQuery
 	// DropTableQuery builds a DROP TABLE query to delete a table
 	DropTableQuery(tableName string) string
+	// RenameTableQuery builds an ALTER TABLE (or similar) query to rename a table along with any objects named after it
+	RenameTableQuery(tableName, newName string) string
 	// Get the schema qualified name of the given table
 	SchemaName(tableName string) string
 }
@@ -56,6 +58,13 @@ type DBNotifyTriggerAdapter interface {
 	CreateTriggerQuery(triggerName, tableName, functionName string) string
 }
 
+// DBRenameTablesAdapter is implemented by adapters for databases where DDL commits implicitly, so that renames cannot be
+// grouped in a transaction, but that can rename several tables atomically in one statement
+type DBRenameTablesAdapter interface {
+	// RenameTablesQuery builds a single query renaming each of tableNames to the new name at the same index
+	RenameTablesQuery(tableNames, newNames []string) string
+}
+
 // clean queries from tabs, spaces  and returns
 func clean(parameter string) string {
 	replacer := strings.NewReplacer("\n", " ", "\t", "")
@@ -0,0 +1,498 @@
+package adapters
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/vent/types"
+	"github.com/jmoiron/sqlx"
+)
+
+var mysqlDataTypes = map[types.SQLColumnType]string{
+	types.SQLColumnTypeBool:  "BOOLEAN",
+	types.SQLColumnTypeByteA: "BLOB",
+	types.SQLColumnTypeInt:   "INTEGER",
+	// SERIAL is an alias for BIGINT UNSIGNED NOT NULL AUTO_INCREMENT UNIQUE
+	types.SQLColumnTypeSerial:  "SERIAL",
+	types.SQLColumnTypeText:    "TEXT",
+	types.SQLColumnTypeVarchar: "VARCHAR",
+	// TIMESTAMP is limited to 2038 and may be given automatic defaults
+	types.SQLColumnTypeTimeStamp: "DATETIME",
+	types.SQLColumnTypeNumeric:   "NUMERIC",
+	// MariaDB only has JSON as an alias for LONGTEXT and MySQL will not accept binary parameters into JSON columns, the
+	// JSON functions of both operate on text
+	types.SQLColumnTypeJSON:   "LONGTEXT",
+	types.SQLColumnTypeBigInt: "BIGINT",
+}
+
+const (
+	// Length given to unbounded VARCHAR and TEXT columns used in keys since MySQL cannot index values of unbounded length
+	mysqlKeyLength = 255
+	// The maximum precision of a MySQL DECIMAL
+	mysqlMaxNumericPrecision = 65
+)
+
+// MySQLAdapter implements DBAdapter for MySQL and MariaDB. The schema is a MySQL database which is created if it does
+// not exist, if no schema is supplied tables are created in the database named in the connection URL.
+type MySQLAdapter struct {
+	Schema string
+	types.SQLNames
+	Log *logging.Logger
+}
+
+var _ DBAdapter = &MySQLAdapter{}
+
+// NewMySQLAdapter constructs a new db adapter
+func NewMySQLAdapter(schema string, sqlNames types.SQLNames, log *logging.Logger) *MySQLAdapter {
+	return &MySQLAdapter{
+		Schema:   schema,
+		SQLNames: sqlNames,
+		Log:      log,
+	}
+}
+
+func (ma *MySQLAdapter) Open(dbURL string) (*sqlx.DB, error) {
+	db, err := sqlx.Open("mysql", dbURL)
+	if err != nil {
+		ma.Log.InfoMsg("Error creating database connection", "err", err)
+		return nil, err
+	}
+
+	if err := db.Ping(); err != nil {
+		ma.Log.InfoMsg("Error opening database connection", "err", err)
+		return nil, err
+	}
+
+	if ma.Schema != "" {
+		query := Cleanf("CREATE DATABASE IF NOT EXISTS %s;", ma.SecureName(ma.Schema))
+		ma.Log.InfoMsg("CREATE SCHEMA", "query", query)
+		if _, err = db.Exec(query); err != nil {
+			ma.Log.InfoMsg("Error creating schema", "err", err)
+			return nil, err
+		}
+	}
+
+	return db, nil
+}
+
+// TypeMapping convert generic dataTypes to database dependent dataTypes
+func (ma *MySQLAdapter) TypeMapping(sqlColumnType types.SQLColumnType) (string, error) {
+	if sqlDataType, ok := mysqlDataTypes[sqlColumnType]; ok {
+		return sqlDataType, nil
+	}
+
+	return "", fmt.Errorf("datatype %v not recognized", sqlColumnType)
+}
+
+// SecureColumnName return columns between appropriate security containers
+func (ma *MySQLAdapter) SecureName(name string) string {
+	return "`" + name + "`"
+}
+
+// CreateTableQuery builds query for creating a new table
+func (ma *MySQLAdapter) CreateTableQuery(tableName string, columns []*types.SQLTableColumn) (string, string) {
+	// build query
+	columnsDef := ""
+	primaryKey := ""
+	dictionaryValues := ""
+
+	for i, column := range columns {
+		secureColumn := ma.SecureName(column.Name)
+		pKey := 0
+
+		if columnsDef != "" {
+			columnsDef += ", "
+			dictionaryValues += ", "
+		}
+
+		columnsDef += Cleanf("%s %s", secureColumn, ma.columnType(column.Type, column.Length, column.Primary))
+
+		if column.Primary {
+			pKey = 1
+			columnsDef += " NOT NULL"
+			if primaryKey != "" {
+				primaryKey += ", "
+			}
+			primaryKey += secureColumn
+		}
+
+		dictionaryValues += Cleanf("('%s','%s',%d,%d,%d,%d)",
+			tableName,
+			column.Name,
+			column.Type,
+			column.Length,
+			pKey,
+			i)
+	}
+
+	query := Cleanf("CREATE TABLE %s (%s", ma.SchemaName(tableName), columnsDef)
+	if primaryKey != "" {
+		query += "," + Cleanf("CONSTRAINT %s PRIMARY KEY (%s)", ma.SecureName(tableName+"_pkey"), primaryKey)
+	}
+	query += ");"
+
+	dictionaryQuery := Cleanf("INSERT INTO %s (%s,%s,%s,%s,%s,%s) VALUES %s;",
+		ma.SchemaName(ma.Tables.Dictionary),
+		ma.Columns.TableName, ma.Columns.ColumnName,
+		ma.Columns.ColumnType, ma.Columns.ColumnLength,
+		ma.Columns.PrimaryKey, ma.Columns.ColumnOrder,
+		dictionaryValues)
+
+	return query, dictionaryQuery
+}
+
+// FindTableQuery returns a query that checks if a table exists
+func (ma *MySQLAdapter) FindTableQuery() string {
+	query := "SELECT COUNT(*) found FROM %s WHERE %s = ?;"
+
+	return Cleanf(query,
+		ma.SchemaName(ma.Tables.Dictionary), // from
+		ma.Columns.TableName)                // where
+}
+
+// TableDefinitionQuery returns a query with table structure
+func (ma *MySQLAdapter) TableDefinitionQuery() string {
+	query := `
+		SELECT
+			%s,%s,%s,%s
+		FROM
+			%s
+		WHERE
+			%s = ?
+		ORDER BY
+			%s;`
+
+	return Cleanf(query,
+		ma.Columns.ColumnName, ma.Columns.ColumnType, // select
+		ma.Columns.ColumnLength, ma.Columns.PrimaryKey, // select
+		ma.SchemaName(ma.Tables.Dictionary), // from
+		ma.Columns.TableName,                // where
+		ma.Columns.ColumnOrder)              // order by
+}
+
+// AlterColumnQuery returns a query for adding a new column to a table
+func (ma *MySQLAdapter) AlterColumnQuery(tableName, columnName string, sqlColumnType types.SQLColumnType, length, order int) (string, string) {
+	query := Cleanf("ALTER TABLE %s ADD COLUMN %s %s;",
+		ma.SchemaName(tableName),
+		ma.SecureName(columnName),
+		ma.columnType(sqlColumnType, length, false))
+
+	dictionaryQuery := Cleanf(`
+		INSERT INTO %s (%s,%s,%s,%s,%s,%s)
+		VALUES ('%s','%s',%d,%d,%d,%d);`,
+
+		ma.SchemaName(ma.Tables.Dictionary),
+
+		ma.Columns.TableName, ma.Columns.ColumnName,
+		ma.Columns.ColumnType, ma.Columns.ColumnLength,
+		ma.Columns.PrimaryKey, ma.Columns.ColumnOrder,
+
+		tableName, columnName, sqlColumnType, length, 0, order)
+
+	return query, dictionaryQuery
+}
+
+// SelectRowQuery returns a query for selecting row values
+func (ma *MySQLAdapter) SelectRowQuery(tableName, fields, indexValue string) string {
+	return Cleanf("SELECT %s FROM %s WHERE %s = '%s';",
+		fields,                        // select
+		ma.SchemaName(tableName),      // from
+		ma.Columns.Height, indexValue, // where
+	)
+}
+
+// SelectLogQuery returns a query for selecting all tables involved in a block trn
+func (ma *MySQLAdapter) SelectLogQuery() string {
+	query := `
+		SELECT DISTINCT %s,%s FROM %s l WHERE %s = ? AND %s = ?;`
+
+	return Cleanf(query,
+		ma.Columns.TableName, ma.Columns.EventName, // select
+		ma.SchemaName(ma.Tables.Log), // from
+		ma.Columns.Height,
+		ma.Columns.ChainID) // where
+}
+
+// InsertLogQuery returns a query to insert a row in log table
+func (ma *MySQLAdapter) InsertLogQuery() string {
+	query := `
+		INSERT INTO %s (%s,%s,%s,%s,%s,%s,%s,%s,%s,%s,%s)
+		VALUES (CURRENT_TIMESTAMP, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);`
+
+	return Cleanf(query,
+		ma.SchemaName(ma.Tables.Log), // insert
+		//fields
+		ma.Columns.TimeStamp,
+		ma.Columns.ChainID, ma.Columns.TableName, ma.Columns.EventName, ma.Columns.EventFilter,
+		ma.Columns.Height, ma.Columns.TxHash, ma.Columns.Action, ma.Columns.DataRow,
+		ma.Columns.SqlStmt, ma.Columns.SqlValues)
+}
+
+// ErrorEquals verify if an error is of a given SQL type
+func (ma *MySQLAdapter) ErrorEquals(err error, sqlErrorType types.SQLErrorType) bool {
+	if err, ok := err.(*mysql.MySQLError); ok {
+		switch sqlErrorType {
+		case types.SQLErrorTypeGeneric:
+			return true
+		case types.SQLErrorTypeDuplicatedColumn:
+			// ER_DUP_FIELDNAME
+			return err.Number == 1060
+		case types.SQLErrorTypeDuplicatedTable:
+			// ER_TABLE_EXISTS_ERROR
+			return err.Number == 1050
+		case types.SQLErrorTypeDuplicatedSchema:
+			// ER_DB_CREATE_EXISTS
+			return err.Number == 1007
+		case types.SQLErrorTypeUndefinedTable:
+			// ER_NO_SUCH_TABLE or ER_BAD_TABLE_ERROR
+			return err.Number == 1146 || err.Number == 1051
+		case types.SQLErrorTypeUndefinedColumn:
+			// ER_BAD_FIELD_ERROR
+			return err.Number == 1054
+		case types.SQLErrorTypeInvalidType:
+			// NOT SUPPORTED - unknown types are reported as syntax errors
+			return false
+		}
+	}
+
+	return false
+}
+
+func (ma *MySQLAdapter) UpsertQuery(table *types.SQLTable, row types.EventDataRow) (types.UpsertDeleteQuery, interface{}, error) {
+
+	pointers := make([]interface{}, 0)
+
+	columns := ""
+	insValues := ""
+	updValues := ""
+	pkColumn := ""
+	values := ""
+	var txHash interface{} = nil
+
+	// for each column in table
+	for _, column := range table.Columns {
+		secureColumn := ma.SecureName(column.Name)
+
+		// INSERT INTO TABLE (*columns).........
+		if columns != "" {
+			columns += ", "
+			insValues += ", "
+			values += ", "
+		}
+		columns += secureColumn
+		insValues += "?"
+
+		// find data for column
+		if value, ok := row.RowData[column.Name]; ok {
+			// load hash value
+			if column.Name == ma.Columns.TxHash {
+				txHash = value
+			}
+
+			// column found (not null)
+			// load values
+			pointers = append(pointers, &value)
+			values += fmt.Sprint(value)
+
+			if !column.Primary {
+				// column is not PK
+				// add to update list
+				// INSERT........... ON DUPLICATE KEY UPDATE (*updValues)
+				if updValues != "" {
+					updValues += ", "
+				}
+				updValues += Cleanf("%s = VALUES(%s)", secureColumn, secureColumn)
+			}
+		} else if column.Primary {
+			// column NOT found (is null) and is PK
+			return types.UpsertDeleteQuery{}, nil, fmt.Errorf("error null primary key for column %s", secureColumn)
+		} else {
+			// column NOT found (is null) and is NOT PK
+			pointers = append(pointers, nil)
+			values += "null"
+		}
+
+		if column.Primary && pkColumn == "" {
+			pkColumn = secureColumn
+		}
+	}
+
+	query := Cleanf("INSERT INTO %s (%s) VALUES (%s) ", ma.SchemaName(table.Name), columns, insValues)
+
+	if updValues != "" {
+		query += Cleanf("ON DUPLICATE KEY UPDATE %s", updValues)
+	} else if pkColumn != "" {
+		// Assigning a column to itself makes the update a no-op
+		query += Cleanf("ON DUPLICATE KEY UPDATE %s = %s", pkColumn, pkColumn)
+	}
+	query += ";"
+
+	return types.UpsertDeleteQuery{Query: query, Values: values, Pointers: pointers}, txHash, nil
+}
+
+func (ma *MySQLAdapter) DeleteQuery(table *types.SQLTable, row types.EventDataRow) (types.UpsertDeleteQuery, error) {
+
+	pointers := make([]interface{}, 0)
+	columns := ""
+	values := ""
+
+	// for each column in table
+	for _, column := range table.Columns {
+
+		//only PK for delete
+		if column.Primary {
+			secureColumn := ma.SecureName(column.Name)
+
+			// WHERE ..........
+			if columns != "" {
+				columns += " AND "
+				values += ", "
+			}
+
+			columns += Cleanf("%s = ?", secureColumn)
+
+			//find data for column
+			if value, ok := row.RowData[column.Name]; ok {
+				// column found (not null)
+				// load values
+				pointers = append(pointers, &value)
+				values += fmt.Sprint(value)
+
+			} else {
+				// column NOT found (is null) and is PK
+				return types.UpsertDeleteQuery{}, fmt.Errorf("error null primary key for column %s", secureColumn)
+			}
+		}
+	}
+
+	if columns == "" {
+		return types.UpsertDeleteQuery{}, fmt.Errorf("error primary key not found for deletion")
+	}
+
+	query := Cleanf("DELETE FROM %s WHERE %s;", ma.SchemaName(table.Name), columns)
+
+	return types.UpsertDeleteQuery{Query: query, Values: values, Pointers: pointers}, nil
+}
+
+func (ma *MySQLAdapter) RestoreDBQuery() string {
+	return Cleanf(`SELECT %s, %s, %s, %s, %s FROM %s
+								WHERE %s != '%s' AND  %s != '%s' AND DATE_FORMAT(%s,'%%Y-%%m-%%d %%H:%%i:%%s')<=?
+								ORDER BY %s;`,
+		ma.Columns.Id, ma.Columns.TableName, ma.Columns.Action, // select id, table, action
+		ma.Columns.SqlStmt, ma.Columns.SqlValues, // select stmt, values
+		ma.SchemaName(ma.Tables.Log),          // from
+		ma.Columns.TableName, ma.Tables.Block, // where not _vent_block
+		ma.Columns.TableName, ma.Tables.Tx, // where not _vent_tx
+		ma.Columns.TimeStamp, // where time
+		ma.Columns.Id)
+}
+
+func (ma *MySQLAdapter) CleanDBQueries() types.SQLCleanDBQuery {
+
+	// Chain info
+	selectChainIDQry := Cleanf(`
+		SELECT
+		COUNT(*) REGISTERS,
+		COALESCE(MAX(%s),'') CHAINID,
+		COALESCE(MAX(%s),'') BVERSION
+		FROM %s;`,
+		ma.Columns.ChainID, ma.Columns.BurrowVersion,
+		ma.SchemaName(ma.Tables.ChainInfo))
+
+	deleteChainIDQry := Cleanf(`
+		DELETE FROM %s;`,
+		ma.SchemaName(ma.Tables.ChainInfo))
+
+	insertChainIDQry := Cleanf(`
+		INSERT INTO %s (%s,%s,%s) VALUES(?,?,?)`,
+		ma.SchemaName(ma.Tables.ChainInfo),
+		ma.Columns.ChainID, ma.Columns.BurrowVersion, ma.Columns.Height)
+
+	// Dictionary
+	selectDictionaryQry := Cleanf(`
+		SELECT DISTINCT %s
+		FROM %s
+ 		WHERE %s
+		NOT IN ('%s','%s','%s');`,
+		ma.Columns.TableName,
+		ma.SchemaName(ma.Tables.Dictionary),
+		ma.Columns.TableName,
+		ma.Tables.Log, ma.Tables.Dictionary, ma.Tables.ChainInfo)
+
+	deleteDictionaryQry := Cleanf(`
+		DELETE FROM %s
+		WHERE %s
+		NOT IN ('%s','%s','%s');`,
+		ma.SchemaName(ma.Tables.Dictionary),
+		ma.Columns.TableName,
+		ma.Tables.Log, ma.Tables.Dictionary, ma.Tables.ChainInfo)
+
+	// log
+	deleteLogQry := Cleanf(`
+		DELETE FROM %s;`,
+		ma.SchemaName(ma.Tables.Log))
+
+	return types.SQLCleanDBQuery{
+		SelectChainIDQry:    selectChainIDQry,
+		DeleteChainIDQry:    deleteChainIDQry,
+		InsertChainIDQry:    insertChainIDQry,
+		SelectDictionaryQry: selectDictionaryQry,
+		DeleteDictionaryQry: deleteDictionaryQry,
+		DeleteLogQry:        deleteLogQry,
+	}
+}
+
+func (ma *MySQLAdapter) DropTableQuery(tableName string) string {
+	// MySQL accepts but ignores CASCADE, views over a dropped table remain but are invalid until it is recreated
+	return Cleanf(`DROP TABLE IF EXISTS %s;`, ma.SchemaName(tableName))
+}
+
+func (ma *MySQLAdapter) RenameTableQuery(tableName, newName string) string {
+	return Cleanf(`RENAME TABLE %s TO %s;`, ma.SchemaName(tableName), ma.SchemaName(newName))
+}
+
+// RenameTablesQuery renames the tables in a single statement since MySQL commits implicitly before each RENAME TABLE
+func (ma *MySQLAdapter) RenameTablesQuery(tableNames, newNames []string) string {
+	renames := make([]string, len(tableNames))
+	for i, tableName := range tableNames {
+		renames[i] = fmt.Sprintf("%s TO %s", ma.SchemaName(tableName), ma.SchemaName(newNames[i]))
+	}
+	return Cleanf(`RENAME TABLE %s;`, strings.Join(renames, ", "))
+}
+
+func (ma *MySQLAdapter) SchemaName(tableName string) string {
+	if ma.Schema == "" {
+		return ma.SecureName(tableName)
+	}
+	return fmt.Sprintf("%s.%s", ma.SecureName(ma.Schema), ma.SecureName(tableName))
+}
+
+// Get the column definition type for a generic type accounting for the lengths MySQL requires
+func (ma *MySQLAdapter) columnType(sqlColumnType types.SQLColumnType, length int, primary bool) string {
+	sqlType, _ := ma.TypeMapping(sqlColumnType)
+	switch sqlColumnType {
+	case types.SQLColumnTypeVarchar, types.SQLColumnTypeText:
+		if length == 0 {
+			if !primary {
+				return mysqlDataTypes[types.SQLColumnTypeText]
+			}
+			length = mysqlKeyLength
+		}
+		return Cleanf("VARCHAR(%d)", length)
+	case types.SQLColumnTypeByteA:
+		if primary {
+			return Cleanf("VARBINARY(%d)", mysqlKeyLength)
+		}
+	case types.SQLColumnTypeNumeric:
+		if length == 0 || length > mysqlMaxNumericPrecision {
+			// Values beyond 65 digits (i.e. the largest uint256 values) cannot be stored
+			length = mysqlMaxNumericPrecision
+		}
+	}
+	if length > 0 {
+		return Cleanf("%s(%d)", sqlType, length)
+	}
+	return sqlType
+}
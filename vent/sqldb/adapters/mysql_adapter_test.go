@@ -0,0 +1,52 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/vent/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMySQLAdapter_UpsertQuery(t *testing.T) {
+	ma := NewMySQLAdapter("vent", types.DefaultSQLNames, logging.NewNoopLogger())
+	table := &types.SQLTable{
+		Name: "Things",
+		Columns: []*types.SQLTableColumn{
+			{Name: "Key", Type: types.SQLColumnTypeVarchar, Primary: true},
+			{Name: "Value", Type: types.SQLColumnTypeText},
+		},
+	}
+	row := types.EventDataRow{RowData: map[string]interface{}{"Key": "foo", "Value": "bar"}}
+	udq, _, err := ma.UpsertQuery(table, row)
+	require.NoError(t, err)
+	assert.Equal(t, "INSERT INTO `vent`.`Things` (`Key`, `Value`) VALUES (?, ?) "+
+		"ON DUPLICATE KEY UPDATE `Value` = VALUES(`Value`);", udq.Query)
+
+	// Only a primary key to update
+	table.Columns = table.Columns[:1]
+	udq, _, err = ma.UpsertQuery(table, row)
+	require.NoError(t, err)
+	assert.Equal(t, "INSERT INTO `vent`.`Things` (`Key`) VALUES (?) ON DUPLICATE KEY UPDATE `Key` = `Key`;",
+		udq.Query)
+}
+
+func TestMySQLAdapter_CreateTableQuery(t *testing.T) {
+	ma := NewMySQLAdapter("", types.DefaultSQLNames, logging.NewNoopLogger())
+	query, _ := ma.CreateTableQuery("Things", []*types.SQLTableColumn{
+		{Name: "Key", Type: types.SQLColumnTypeVarchar, Primary: true},
+		{Name: "Hash", Type: types.SQLColumnTypeByteA, Primary: true},
+		{Name: "Value", Type: types.SQLColumnTypeVarchar, Length: 100},
+		{Name: "Amount", Type: types.SQLColumnTypeNumeric},
+	})
+	assert.Equal(t, "CREATE TABLE `Things` (`Key` VARCHAR(255) NOT NULL, `Hash` VARBINARY(255) NOT NULL, "+
+		"`Value` VARCHAR(100), `Amount` NUMERIC(65),CONSTRAINT `Things_pkey` PRIMARY KEY (`Key`, `Hash`));", query)
+}
+
+func TestMySQLAdapter_RenameTablesQuery(t *testing.T) {
+	ma := NewMySQLAdapter("vent", types.DefaultSQLNames, logging.NewNoopLogger())
+	query := ma.RenameTablesQuery([]string{"Things", "_reproject_Things"}, []string{"_swapped_Things", "Things"})
+	assert.Equal(t, "RENAME TABLE `vent`.`Things` TO `vent`.`_swapped_Things`, "+
+		"`vent`.`_reproject_Things` TO `vent`.`Things`;", query)
+}
@@ -481,6 +481,12 @@ func (pa *PostgresAdapter) DropTableQuery(tableName string) string {
 	return Cleanf(`DROP TABLE IF EXISTS %s CASCADE;`, pa.SchemaName(tableName))
 }
 
+// RenameTableQuery renames a table and the index of its primary key constraint, which is named after the table
+func (pa *PostgresAdapter) RenameTableQuery(tableName, newName string) string {
+	return Cleanf(`ALTER TABLE %s RENAME TO %s; ALTER INDEX IF EXISTS %s.%s_pkey RENAME TO %s_pkey;`,
+		pa.SchemaName(tableName), pa.SecureName(newName), pa.Schema, tableName, newName)
+}
+
 func (pa *PostgresAdapter) CreateNotifyFunctionQuery(function, channel string, columns ...string) string {
 	return Cleanf(`CREATE OR REPLACE FUNCTION %s() RETURNS trigger AS
 		$trigger$
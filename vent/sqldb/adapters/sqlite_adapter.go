@@ -453,6 +453,10 @@ func (sla *SQLiteAdapter) DropTableQuery(tableName string) string {
 	return Cleanf(`DROP TABLE IF EXISTS %s;`, sla.SecureName(tableName))
 }
 
+func (sla *SQLiteAdapter) RenameTableQuery(tableName, newName string) string {
+	return Cleanf(`ALTER TABLE %s RENAME TO %s;`, sla.SecureName(tableName), sla.SecureName(newName))
+}
+
 func (sla *SQLiteAdapter) SchemaName(tableName string) string {
 	return secureName(tableName)
 }
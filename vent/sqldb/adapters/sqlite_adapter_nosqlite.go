@@ -86,6 +86,10 @@ func (*SQLiteAdapter) DropTableQuery(tableName string) string {
 	panic("implement me")
 }
 
+func (*SQLiteAdapter) RenameTableQuery(tableName, newName string) string {
+	panic("implement me")
+}
+
 func (*SQLiteAdapter) SchemaName(tableName string) string {
 	panic("implement me")
 }
@@ -0,0 +1,305 @@
+package sqldb
+
+import (
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hyperledger/burrow/vent/types"
+	"github.com/jmoiron/sqlx"
+)
+
+// aggregateGroups holds the values of the group columns of each group of an aggregate table that needs to be brought up
+// to date keyed by aggregate table name and then by the string form of the values
+type aggregateGroups map[string]map[string][]interface{}
+
+func (groups aggregateGroups) add(aggregate *types.SQLTable, rowData map[string]interface{}) {
+	values := make([]interface{}, len(aggregate.Aggregate.GroupBy))
+	for i, columnName := range aggregate.Aggregate.GroupBy {
+		values[i] = rowData[columnName]
+	}
+	if groups[aggregate.Name] == nil {
+		groups[aggregate.Name] = make(map[string][]interface{})
+	}
+	groups[aggregate.Name][groupKey(values)] = values
+}
+
+// aggregatesBySource returns the aggregate tables among eventTables keyed by the name of the table they aggregate
+func aggregatesBySource(eventTables types.EventTables) map[string][]*types.SQLTable {
+	aggregates := make(map[string][]*types.SQLTable)
+	for _, table := range eventTables {
+		if table.Aggregate != nil {
+			aggregates[table.Aggregate.SourceTable] = append(aggregates[table.Aggregate.SourceTable], table)
+		}
+	}
+	return aggregates
+}
+
+// RebuildAggregate recreates an aggregate table with a row for each group of the rows currently in its source table
+func (db *SQLDB) RebuildAggregate(chainID string, eventTables types.EventTables, table *types.SQLTable,
+	height uint64) error {
+
+	source, ok := eventTables[table.Aggregate.SourceTable]
+	if !ok {
+		return fmt.Errorf("source table %s of aggregate table %s not found", table.Aggregate.SourceTable, table.Name)
+	}
+
+	err := db.RecreateTable(chainID, table)
+	if err != nil {
+		return err
+	}
+
+	tx, err := db.DB.Beginx()
+	if err != nil {
+		db.Log.InfoMsg("Error beginning transaction", "err", err)
+		return err
+	}
+	defer tx.Rollback()
+
+	query := fmt.Sprintf("SELECT DISTINCT %s FROM %s;", db.secureNames(table.Aggregate.GroupBy),
+		db.DBAdapter.SchemaName(source.Name))
+	rows, err := tx.Query(query)
+	if err != nil {
+		db.Log.InfoMsg("Error querying groups", "err", err, "query", query)
+		return err
+	}
+
+	groups := make(aggregateGroups)
+	for rows.Next() {
+		values, err := scanValues(rows, table.Columns[:len(table.Aggregate.GroupBy)])
+		if err != nil {
+			rows.Close()
+			db.Log.InfoMsg("Error scanning groups", "err", err)
+			return err
+		}
+		groups.add(table, groupRowData(table, values))
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		db.Log.InfoMsg("Error during rows iteration", "err", err)
+		return err
+	}
+
+	logStmt, err := tx.Prepare(db.DBAdapter.InsertLogQuery())
+	if err != nil {
+		db.Log.InfoMsg("Error preparing log stmt", "err", err)
+		return err
+	}
+	defer logStmt.Close()
+
+	err = db.setAggregates(tx, logStmt, chainID, eventTables, groups, height)
+	if err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		db.Log.InfoMsg("Error commiting transaction", "err", err)
+		return err
+	}
+	return nil
+}
+
+// addPreviousGroup adds the group to which the row of source with the same primary key as row belongs before row is
+// set (since it may be moved out of that group or deleted)
+func (db *SQLDB) addPreviousGroup(tx *sqlx.Tx, groups aggregateGroups, aggregate, source *types.SQLTable,
+	row types.EventDataRow) error {
+
+	var where []string
+	var args []interface{}
+	for _, column := range source.Columns {
+		if column.Primary {
+			value, ok := row.RowData[column.Name]
+			if !ok {
+				// The upsert or delete will fail without the key
+				return nil
+			}
+			where = append(where, db.DBAdapter.SecureName(column.Name)+" = ?")
+			args = append(args, value)
+		}
+	}
+	if len(where) == 0 {
+		return nil
+	}
+
+	query := db.DB.Rebind(fmt.Sprintf("SELECT %s FROM %s WHERE %s;", db.secureNames(aggregate.Aggregate.GroupBy),
+		db.DBAdapter.SchemaName(source.Name), strings.Join(where, " AND ")))
+	rows, err := tx.Query(query, args...)
+	if err != nil {
+		db.Log.InfoMsg("Error querying previous group", "err", err, "query", query)
+		return err
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		values, err := scanValues(rows, aggregate.Columns[:len(aggregate.Aggregate.GroupBy)])
+		if err != nil {
+			db.Log.InfoMsg("Error scanning previous group", "err", err)
+			return err
+		}
+		groups.add(aggregate, groupRowData(aggregate, values))
+	}
+	return rows.Err()
+}
+
+// setAggregates recomputes the row of each of the groups from the rows of the source table, upserting it or deleting
+// it if the group no longer has any rows
+func (db *SQLDB) setAggregates(tx *sqlx.Tx, logStmt *sql.Stmt, chainID string, eventTables types.EventTables,
+	groups aggregateGroups, height uint64) error {
+
+	tableNames := make([]string, 0, len(groups))
+	for tableName := range groups {
+		tableNames = append(tableNames, tableName)
+	}
+	sort.Strings(tableNames)
+
+	for _, tableName := range tableNames {
+		table := eventTables[tableName]
+		source := eventTables[table.Aggregate.SourceTable]
+
+		keys := make([]string, 0, len(groups[tableName]))
+		for key := range groups[tableName] {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			values := groups[tableName][key]
+			rowData, err := db.aggregateRow(tx, table, source, values)
+			if err != nil {
+				return err
+			}
+
+			row := types.EventDataRow{Action: types.ActionUpsert, RowData: rowData}
+			if rowData == nil {
+				row = types.EventDataRow{Action: types.ActionDelete, RowData: groupRowData(table, values)}
+			} else {
+				rowData[db.Columns.Height] = height
+			}
+
+			err = db.setRow(tx, logStmt, chainID, table, row, height)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// aggregateRow computes the row of an aggregate table for a group from its source table, returning nil if the source
+// table has no rows in the group
+func (db *SQLDB) aggregateRow(tx *sqlx.Tx, table, source *types.SQLTable, values []interface{}) (map[string]interface{},
+	error) {
+
+	agg := table.Aggregate
+	var where []string
+	var whereArgs []interface{}
+	for i, columnName := range agg.GroupBy {
+		if values[i] == nil {
+			where = append(where, db.DBAdapter.SecureName(columnName)+" IS NULL")
+		} else {
+			where = append(where, db.DBAdapter.SecureName(columnName)+" = ?")
+			whereArgs = append(whereArgs, values[i])
+		}
+	}
+	whereClause := strings.Join(where, " AND ")
+
+	selects := []string{db.secureNames(agg.GroupBy)}
+	var args []interface{}
+	for _, column := range agg.Columns {
+		sourceColumn := db.DBAdapter.SecureName(column.SourceColumn)
+		switch column.Function {
+		case types.AggregateSum:
+			selects = append(selects, "SUM("+sourceColumn+")")
+		case types.AggregateCount:
+			selects = append(selects, "COUNT(*)")
+		case types.AggregateMin:
+			selects = append(selects, "MIN("+sourceColumn+")")
+		case types.AggregateMax:
+			selects = append(selects, "MAX("+sourceColumn+")")
+		case types.AggregateLatest:
+			selects = append(selects, fmt.Sprintf("(SELECT %s FROM %s WHERE %s ORDER BY %s DESC, %s DESC, %s DESC LIMIT 1)",
+				sourceColumn, db.DBAdapter.SchemaName(source.Name), whereClause,
+				db.DBAdapter.SecureName(db.Columns.Height), db.DBAdapter.SecureName(db.Columns.TxIndex),
+				db.DBAdapter.SecureName(db.Columns.EventIndex)))
+			args = append(args, whereArgs...)
+		default:
+			return nil, fmt.Errorf("unknown aggregate function %s for column %s of aggregate table %s",
+				column.Function, column.ColumnName, table.Name)
+		}
+	}
+	args = append(args, whereArgs...)
+
+	query := db.DB.Rebind(fmt.Sprintf("SELECT %s FROM %s WHERE %s GROUP BY %s;", strings.Join(selects, ", "),
+		db.DBAdapter.SchemaName(source.Name), whereClause, db.secureNames(agg.GroupBy)))
+	rows, err := tx.Query(query, args...)
+	if err != nil {
+		db.Log.InfoMsg("Error querying aggregate", "err", err, "query", query)
+		return nil, err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return nil, rows.Err()
+	}
+
+	aggValues, err := scanValues(rows, table.Columns[:len(agg.GroupBy)+len(agg.Columns)])
+	if err != nil {
+		db.Log.InfoMsg("Error scanning aggregate", "err", err)
+		return nil, err
+	}
+
+	rowData := groupRowData(table, aggValues)
+	for i, column := range agg.Columns {
+		rowData[column.ColumnName] = aggValues[len(agg.GroupBy)+i]
+	}
+	return rowData, nil
+}
+
+func (db *SQLDB) secureNames(columnNames []string) string {
+	secureNames := make([]string, len(columnNames))
+	for i, columnName := range columnNames {
+		secureNames[i] = db.DBAdapter.SecureName(columnName)
+	}
+	return strings.Join(secureNames, ", ")
+}
+
+// groupRowData returns the group columns of an aggregate table with the first of values
+func groupRowData(table *types.SQLTable, values []interface{}) map[string]interface{} {
+	rowData := make(map[string]interface{}, len(table.Columns))
+	for i, columnName := range table.Aggregate.GroupBy {
+		rowData[columnName] = values[i]
+	}
+	return rowData
+}
+
+// scanValues scans the current row into values for columns converting the bytes returned for other types by some
+// drivers to strings
+func scanValues(rows *sql.Rows, columns []*types.SQLTableColumn) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	pointers := make([]interface{}, len(columns))
+	for i := range values {
+		pointers[i] = &values[i]
+	}
+	if err := rows.Scan(pointers...); err != nil {
+		return nil, err
+	}
+	for i, value := range values {
+		if bs, ok := value.([]byte); ok && columns[i].Type != types.SQLColumnTypeByteA {
+			values[i] = string(bs)
+		}
+	}
+	return values, nil
+}
+
+func groupKey(values []interface{}) string {
+	strs := make([]string, len(values))
+	for i, value := range values {
+		if value == nil {
+			strs[i] = "NULL"
+		} else {
+			strs[i] = fmt.Sprintf("'%v'", value)
+		}
+	}
+	return strings.Join(strs, ",")
+}
@@ -0,0 +1,278 @@
+package sqldb
+
+import (
+	"database/sql"
+	"fmt"
+	"sort"
+
+	"github.com/hyperledger/burrow/vent/sqldb/adapters"
+	"github.com/hyperledger/burrow/vent/types"
+)
+
+// Prefix given to a table while it is being replaced by SwapTable
+const swappedTablePrefix = "_swapped_"
+
+// SpecHashes returns the (sorted) hashes of the event classes that were last used by each chain to project each table
+// keyed by chain ID and then table name
+func (db *SQLDB) SpecHashes() (map[string]map[string][]string, error) {
+	query := fmt.Sprintf("SELECT %s, %s, %s FROM %s;",
+		db.DBAdapter.SecureName(db.Columns.ChainID), db.DBAdapter.SecureName(db.Columns.TableName),
+		db.DBAdapter.SecureName(db.Columns.SpecHash), db.DBAdapter.SchemaName(db.Tables.Spec))
+
+	rows, err := db.DB.Query(query)
+	if err != nil {
+		db.Log.InfoMsg("Error querying spec hashes", "err", err, "query", query)
+		return nil, err
+	}
+	defer rows.Close()
+
+	specHashes := make(map[string]map[string][]string)
+	for rows.Next() {
+		var chainID, tableName, hash string
+		if err = rows.Scan(&chainID, &tableName, &hash); err != nil {
+			db.Log.InfoMsg("Error scanning spec hashes", "err", err)
+			return nil, err
+		}
+		if specHashes[chainID] == nil {
+			specHashes[chainID] = make(map[string][]string)
+		}
+		specHashes[chainID][tableName] = append(specHashes[chainID][tableName], hash)
+	}
+
+	if err = rows.Err(); err != nil {
+		db.Log.InfoMsg("Error during rows iteration", "err", err)
+		return nil, err
+	}
+
+	for _, tableHashes := range specHashes {
+		for _, hashes := range tableHashes {
+			sort.Strings(hashes)
+		}
+	}
+	return specHashes, nil
+}
+
+// SetSpecHashes replaces the hashes of the event classes projected by a chain into a table, passing no hashes removes
+// the table from the chain's entries in the spec table
+func (db *SQLDB) SetSpecHashes(chainID, tableName string, hashes []string) error {
+	tx, err := db.DB.Begin()
+	if err != nil {
+		db.Log.InfoMsg("Error beginning transaction", "err", err)
+		return err
+	}
+	defer tx.Rollback()
+
+	if err = db.setSpecHashes(tx, chainID, tableName, hashes); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		db.Log.InfoMsg("Error commiting transaction", "err", err)
+		return err
+	}
+	return nil
+}
+
+// createSpecTable creates the spec table if it does not exist. A spec table from before spec hashes were kept for each
+// chain is created anew so that the tables are taken as they are, as they are when they predate the spec table.
+func (db *SQLDB) createSpecTable(chainID string, table *types.SQLTable) error {
+	err := db.createTable(chainID, table, true)
+	if err == nil || !db.DBAdapter.ErrorEquals(err, types.SQLErrorTypeDuplicatedTable) {
+		return err
+	}
+
+	currentTable, err := db.getTableDef(table.Name)
+	if err != nil {
+		return err
+	}
+	for _, column := range currentTable.Columns {
+		if column.Name == db.Columns.ChainID {
+			return nil
+		}
+	}
+
+	db.Log.InfoMsg("Recreating spec table to keep spec hashes for each chain", "value", table.Name)
+	tx, err := db.DB.Begin()
+	if err != nil {
+		db.Log.InfoMsg("Error beginning transaction", "err", err)
+		return err
+	}
+	defer tx.Rollback()
+
+	if err = db.dropTable(tx, table.Name); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		db.Log.InfoMsg("Error commiting transaction", "err", err)
+		return err
+	}
+	return db.createTable(chainID, table, true)
+}
+
+// RecreateTable drops a table (along with its dictionary and log entries) if it exists and creates it anew
+func (db *SQLDB) RecreateTable(chainID string, table *types.SQLTable) error {
+	db.Log.InfoMsg("Recreating Table", "value", table.Name)
+
+	tx, err := db.DB.Begin()
+	if err != nil {
+		db.Log.InfoMsg("Error beginning transaction", "err", err)
+		return err
+	}
+	defer tx.Rollback()
+
+	if err = db.dropTable(tx, table.Name); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		db.Log.InfoMsg("Error commiting transaction", "err", err)
+		return err
+	}
+
+	return db.createTable(chainID, table, false)
+}
+
+// SwapTable replaces a table with another table (that has been projected in its place) by renaming the first out of
+// the way and the second into its place before dropping the first, the dictionary, log and spec hashes are updated to
+// match. The table must hold only the rows of chainID, whose spec hashes are set.
+func (db *SQLDB) SwapTable(chainID string, table *types.SQLTable, fromTableName string, specHashes []string) error {
+	db.Log.InfoMsg("Swapping Table", "value", table.Name, "from", fromTableName)
+
+	oldTableName := swappedTablePrefix + table.Name
+	// Drop anything left behind by a swap that stopped before dropping the table it replaced
+	if err := db.dropTableQuietly(oldTableName); err != nil {
+		return err
+	}
+
+	tx, err := db.DB.Begin()
+	if err != nil {
+		db.Log.InfoMsg("Error beginning transaction", "err", err)
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, systemTable := range []string{db.Tables.Dictionary, db.Tables.Log} {
+		query := db.DB.Rebind(fmt.Sprintf("DELETE FROM %s WHERE %s = ?;",
+			db.DBAdapter.SchemaName(systemTable), db.DBAdapter.SecureName(db.Columns.TableName)))
+		if _, err = tx.Exec(query, table.Name); err != nil {
+			db.Log.InfoMsg("Error deleting table entries", "err", err, "value", table.Name, "query", query)
+			return err
+		}
+	}
+
+	query := db.DB.Rebind(fmt.Sprintf("UPDATE %s SET %s = ? WHERE %s = ?;",
+		db.DBAdapter.SchemaName(db.Tables.Dictionary), // update
+		db.DBAdapter.SecureName(db.Columns.TableName), // set
+		db.DBAdapter.SecureName(db.Columns.TableName), // where
+	))
+	if _, err = tx.Exec(query, table.Name, fromTableName); err != nil {
+		db.Log.InfoMsg("Error updating dictionary", "err", err, "query", query)
+		return err
+	}
+
+	query = db.DB.Rebind(fmt.Sprintf("UPDATE %s SET %s = ?, %s = REPLACE(%s, ?, ?) WHERE %s = ?;",
+		db.DBAdapter.SchemaName(db.Tables.Log),        // update
+		db.DBAdapter.SecureName(db.Columns.TableName), // set
+		db.DBAdapter.SecureName(db.Columns.SqlStmt), db.DBAdapter.SecureName(db.Columns.SqlStmt),
+		db.DBAdapter.SecureName(db.Columns.TableName), // where
+	))
+	if _, err = tx.Exec(query, table.Name, fromTableName, table.Name, fromTableName); err != nil {
+		db.Log.InfoMsg("Error updating log", "err", err, "query", query)
+		return err
+	}
+
+	if err = db.setSpecHashes(tx, chainID, table.Name, specHashes); err != nil {
+		return err
+	}
+
+	// The tables are renamed last since on databases where DDL commits implicitly (MySQL) the rename commits the
+	// updates above, these databases rename both tables in one statement so the table is never missing
+	var queries []string
+	if adapter, ok := db.DBAdapter.(adapters.DBRenameTablesAdapter); ok {
+		queries = []string{adapter.RenameTablesQuery([]string{table.Name, fromTableName},
+			[]string{oldTableName, table.Name})}
+	} else {
+		queries = []string{db.DBAdapter.RenameTableQuery(table.Name, oldTableName),
+			db.DBAdapter.RenameTableQuery(fromTableName, table.Name)}
+	}
+	for _, query = range queries {
+		db.Log.InfoMsg("RENAME TABLE", "query", query)
+		if _, err = tx.Exec(query); err != nil {
+			db.Log.InfoMsg("Error renaming table", "err", err, "query", query)
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		db.Log.InfoMsg("Error commiting transaction", "err", err)
+		return err
+	}
+
+	if err = db.dropTableQuietly(oldTableName); err != nil {
+		return err
+	}
+
+	err = db.createTableTriggers(table)
+	if err != nil {
+		db.Log.InfoMsg("error creating notification triggers", "err", err, "value", fmt.Sprintf("%v", table))
+		return fmt.Errorf("could not create table notification triggers: %v", err)
+	}
+	return nil
+}
+
+// dropTableQuietly drops a table that has no dictionary or log entries if it exists
+func (db *SQLDB) dropTableQuietly(tableName string) error {
+	query := db.DBAdapter.DropTableQuery(tableName)
+	db.Log.InfoMsg("DROP TABLE", "query", query)
+	if _, err := db.DB.Exec(query); err != nil {
+		if !db.DBAdapter.ErrorEquals(err, types.SQLErrorTypeUndefinedTable) {
+			db.Log.InfoMsg("Error dropping table", "err", err, "value", tableName, "query", query)
+			return err
+		}
+	}
+	return nil
+}
+
+// dropTable drops a table if it exists and removes its dictionary and log entries
+func (db *SQLDB) dropTable(tx *sql.Tx, tableName string) error {
+	query := db.DBAdapter.DropTableQuery(tableName)
+	db.Log.InfoMsg("DROP TABLE", "query", query)
+	if _, err := tx.Exec(query); err != nil {
+		if !db.DBAdapter.ErrorEquals(err, types.SQLErrorTypeUndefinedTable) {
+			db.Log.InfoMsg("Error dropping table", "err", err, "value", tableName, "query", query)
+			return err
+		}
+	}
+
+	for _, systemTable := range []string{db.Tables.Dictionary, db.Tables.Log} {
+		query = db.DB.Rebind(fmt.Sprintf("DELETE FROM %s WHERE %s = ?;",
+			db.DBAdapter.SchemaName(systemTable), db.DBAdapter.SecureName(db.Columns.TableName)))
+		if _, err := tx.Exec(query, tableName); err != nil {
+			db.Log.InfoMsg("Error deleting table entries", "err", err, "value", tableName, "query", query)
+			return err
+		}
+	}
+	return nil
+}
+
+func (db *SQLDB) setSpecHashes(tx *sql.Tx, chainID, tableName string, hashes []string) error {
+	query := db.DB.Rebind(fmt.Sprintf("DELETE FROM %s WHERE %s = ? AND %s = ?;",
+		db.DBAdapter.SchemaName(db.Tables.Spec), db.DBAdapter.SecureName(db.Columns.ChainID),
+		db.DBAdapter.SecureName(db.Columns.TableName)))
+	if _, err := tx.Exec(query, chainID, tableName); err != nil {
+		db.Log.InfoMsg("Error deleting spec hashes", "err", err, "value", tableName, "query", query)
+		return err
+	}
+
+	query = db.DB.Rebind(fmt.Sprintf("INSERT INTO %s (%s, %s, %s) VALUES (?, ?, ?);",
+		db.DBAdapter.SchemaName(db.Tables.Spec), db.DBAdapter.SecureName(db.Columns.ChainID),
+		db.DBAdapter.SecureName(db.Columns.TableName), db.DBAdapter.SecureName(db.Columns.SpecHash)))
+	for _, hash := range hashes {
+		if _, err := tx.Exec(query, chainID, tableName, hash); err != nil {
+			db.Log.InfoMsg("Error inserting spec hash", "err", err, "value", tableName, "query", query)
+			return err
+		}
+	}
+	return nil
+}
@@ -28,16 +28,19 @@ type SQLDB struct {
 	Schema  string
 	Queries Queries
 	types.SQLNames
-	Log *logging.Logger
+	// Whether rows from multiple chains are kept side by side
+	MultiChain bool
+	Log        *logging.Logger
 }
 
 // NewSQLDB delegates work to a specific database adapter implementation,
 // opens database connection and create log tables
 func NewSQLDB(connection types.SQLConnection) (*SQLDB, error) {
 	db := &SQLDB{
-		Schema:   connection.DBSchema,
-		SQLNames: types.DefaultSQLNames,
-		Log:      connection.Log,
+		Schema:     connection.DBSchema,
+		SQLNames:   types.DefaultSQLNames,
+		MultiChain: connection.MultiChain,
+		Log:        connection.Log,
 	}
 
 	switch connection.DBAdapter {
@@ -46,6 +49,9 @@ func NewSQLDB(connection types.SQLConnection) (*SQLDB, error) {
 
 	case types.SQLiteDB:
 		db.DBAdapter = adapters.NewSQLiteAdapter(db.SQLNames, connection.Log)
+
+	case types.MySQLDB:
+		db.DBAdapter = adapters.NewMySQLAdapter(safe(connection.DBSchema), db.SQLNames, connection.Log)
 	default:
 		return nil, errors.New("invalid database adapter")
 	}
@@ -66,7 +72,7 @@ func NewSQLDB(connection types.SQLConnection) (*SQLDB, error) {
 }
 
 // Initialise the system and chain tables in case this is the first run - is idempotent though will drop tables
-// if ChainID has changed
+// if ChainID has changed (unless MultiChain in which case a new chain is added alongside the others)
 func (db *SQLDB) Init(chainID, burrowVersion string) error {
 	db.Log.InfoMsg("Initializing DB")
 
@@ -97,7 +103,13 @@ func (db *SQLDB) Init(chainID, burrowVersion string) error {
 		}
 	}
 
-	chainIDChanged, err := db.InitChain(chainID, burrowVersion)
+	var chainIDChanged bool
+	var err error
+	if db.MultiChain {
+		err = db.AddChain(chainID, burrowVersion)
+	} else {
+		chainIDChanged, err = db.InitChain(chainID, burrowVersion)
+	}
 	if err != nil {
 		return fmt.Errorf("could not initialise chain in database: %v", err)
 	}
@@ -110,6 +122,12 @@ func (db *SQLDB) Init(chainID, burrowVersion string) error {
 		}
 	}
 
+	// The spec table is created after cleaning since it is dropped along with the projection tables
+	if err := db.createSpecTable(chainID, sysTables[db.Tables.Spec]); err != nil {
+		db.Log.InfoMsg("Error creating Spec table", "err", err)
+		return err
+	}
+
 	db.Queries, err = db.prepareQueries()
 	if err != nil {
 		db.Log.InfoMsg("Could not prepare queries", "err", err)
@@ -169,6 +187,29 @@ func (db *SQLDB) InitChain(chainID, burrowVersion string) (chainIDChanged bool,
 
 }
 
+// AddChain stores chainID alongside any other chains if it is not already stored
+func (db *SQLDB) AddChain(chainID, burrowVersion string) error {
+	query := db.DB.Rebind(fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE %s = ?;",
+		db.DBAdapter.SchemaName(db.Tables.ChainInfo), db.DBAdapter.SecureName(db.Columns.ChainID)))
+
+	savedRows := 0
+	if err := db.DB.QueryRow(query, chainID).Scan(&savedRows); err != nil {
+		db.Log.InfoMsg("Error selecting CHAIN ID", "err", err, "query", query)
+		return err
+	}
+
+	if savedRows > 0 {
+		return nil
+	}
+
+	query = db.DBAdapter.CleanDBQueries().InsertChainIDQry
+	if _, err := db.DB.Exec(query, chainID, burrowVersion, 0); err != nil {
+		db.Log.InfoMsg("Error inserting CHAIN ID", "err", err, "query", query)
+		return err
+	}
+	return nil
+}
+
 // CleanTables drop tables if stored chainID is different from the given one & store new chainID
 // if the chainID is the same, do nothing
 func (db *SQLDB) CleanTables(chainID, burrowVersion string) error {
@@ -297,6 +338,17 @@ func (db *SQLDB) SynchronizeDB(chainID string, eventTables types.EventTables) er
 
 // SetBlock inserts or updates multiple rows and stores log info in SQL tables
 func (db *SQLDB) SetBlock(chainID string, eventTables types.EventTables, eventData types.EventData) error {
+	return db.setBlock(chainID, eventTables, eventData, true)
+}
+
+// SetRows is like SetBlock but does not advance the last block height of the chain, it is used to write the rows of
+// tables that are being reprojected
+func (db *SQLDB) SetRows(chainID string, eventTables types.EventTables, eventData types.EventData) error {
+	return db.setBlock(chainID, eventTables, eventData, false)
+}
+
+func (db *SQLDB) setBlock(chainID string, eventTables types.EventTables, eventData types.EventData,
+	setHeight bool) error {
 	db.Log.InfoMsg("Synchronize Block", "action", "SYNC")
 
 	// Begin tx
@@ -316,6 +368,10 @@ func (db *SQLDB) SetBlock(chainID string, eventTables types.EventTables, eventDa
 	}
 	defer logStmt.Close()
 
+	// The groups of aggregate tables affected by the rows of the block
+	aggregates := aggregatesBySource(eventTables)
+	groups := make(aggregateGroups)
+
 	var tableName string
 loop:
 	// for each table in the block
@@ -324,78 +380,26 @@ loop:
 		dataRows := eventData.Tables[table.Name]
 		// for Each Row
 		for _, row := range dataRows {
-			var queryVal types.UpsertDeleteQuery
-			var txHash interface{}
-			var errQuery error
-
-			switch row.Action {
-			case types.ActionUpsert:
-				//Prepare Upsert
-				if queryVal, txHash, errQuery = db.DBAdapter.UpsertQuery(table, row); errQuery != nil {
-					db.Log.InfoMsg("Error building upsert query", "err", errQuery, "value", fmt.Sprintf("%v %v", table, row))
+			for _, aggregate := range aggregates[table.Name] {
+				if err = db.addPreviousGroup(tx, groups, aggregate, table, row); err != nil {
 					break loop // exits from all loops -> continue in close log stmt
 				}
-
-			case types.ActionDelete:
-				//Prepare Delete
-				if queryVal, errQuery = db.DBAdapter.DeleteQuery(table, row); errQuery != nil {
-					db.Log.InfoMsg("Error building delete query", "err", errQuery, "value", fmt.Sprintf("%v %v", table, row))
-					break loop // exits from all loops -> continue in close log stmt
+				if row.Action == types.ActionUpsert {
+					groups.add(aggregate, row.RowData)
 				}
-			default:
-				//Invalid Action
-				db.Log.InfoMsg("invalid action", "value", row.Action)
-				err = fmt.Errorf("invalid row action %s", row.Action)
-				break loop // exits from all loops -> continue in close log stmt
-			}
-
-			sqlQuery := queryVal.Query
-
-			// Perform row action
-			db.Log.InfoMsg("msg", "action", row.Action, "query", sqlQuery, "value", queryVal.Values)
-			if _, err = tx.Exec(sqlQuery, queryVal.Pointers...); err != nil {
-				db.Log.InfoMsg(fmt.Sprintf("error performing %s on row", row.Action), "err", err, "value", queryVal.Values)
-				break loop // exits from all loops -> continue in close log stmt
-			}
-
-			// Marshal the rowData map
-			rowData, err := getJSON(row.RowData)
-			if err != nil {
-				db.Log.InfoMsg("error marshaling rowData", "err", err, "value", fmt.Sprintf("%v", row.RowData))
-				break loop // exits from all loops -> continue in close log stmt
-			}
-
-			// Marshal sql values
-			sqlValues, err := getJSONFromValues(queryVal.Pointers)
-			if err != nil {
-				db.Log.InfoMsg("error marshaling rowdata", "err", err, "value", fmt.Sprintf("%v", row.RowData))
-				break loop // exits from all loops -> continue in close log stmt
 			}
 
-			eventName, _ := row.RowData[db.Columns.EventName].(string)
-			// Insert in log
-			db.Log.InfoMsg("INSERT LOG",
-				"log_query", logQuery,
-				"chain_id", chainID,
-				"table_name", tableName,
-				"event_name", eventName,
-				"event_filter", row.EventClass.GetFilter(),
-				"block_height", eventData.BlockHeight,
-				"tx_hash", txHash,
-				"row_action", row.Action,
-				"row_data", string(rowData),
-				"sql_query", sqlQuery,
-				"sql_values", string(sqlValues),
-			)
-
-			if _, err = logStmt.Exec(chainID, tableName, eventName, row.EventClass.GetFilter(), eventData.BlockHeight,
-				txHash, row.Action, rowData, sqlQuery, sqlValues); err != nil {
-				db.Log.InfoMsg("Error inserting into log", "err", err)
+			if err = db.setRow(tx, logStmt, chainID, table, row, eventData.BlockHeight); err != nil {
 				break loop // exits from all loops -> continue in close log stmt
 			}
 		}
 	}
 
+	// Aggregates are brought up to date once the rows they aggregate have been
+	if err == nil {
+		err = db.setAggregates(tx, logStmt, chainID, eventTables, groups, eventData.BlockHeight)
+	}
+
 	// Close log statement
 	if err == nil {
 		if err = logStmt.Close(); err != nil {
@@ -422,7 +426,7 @@ loop:
 					return err
 				}
 				//Retry
-				return db.SetBlock(chainID, eventTables, eventData)
+				return db.setBlock(chainID, eventTables, eventData, setHeight)
 			}
 
 			// Columns do not match
@@ -433,7 +437,7 @@ loop:
 					return err
 				}
 				//Retry
-				return db.SetBlock(chainID, eventTables, eventData)
+				return db.setBlock(chainID, eventTables, eventData, setHeight)
 			}
 			return err
 		}
@@ -442,10 +446,12 @@ loop:
 
 	db.Log.InfoMsg("COMMIT", "action", "COMMIT")
 
-	err = db.SetBlockHeight(tx, chainID, eventData.BlockHeight)
-	if err != nil {
-		db.Log.InfoMsg("Could not commit block height", "err", err)
-		return err
+	if setHeight {
+		err = db.SetBlockHeight(tx, chainID, eventData.BlockHeight)
+		if err != nil {
+			db.Log.InfoMsg("Could not commit block height", "err", err)
+			return err
+		}
 	}
 
 	err = tx.Commit()
@@ -457,6 +463,82 @@ loop:
 	return nil
 }
 
+// setRow performs the action of row on table and records it in the log
+func (db *SQLDB) setRow(tx *sqlx.Tx, logStmt *sql.Stmt, chainID string, table *types.SQLTable,
+	row types.EventDataRow, height uint64) error {
+
+	var queryVal types.UpsertDeleteQuery
+	var txHash interface{}
+	var err error
+
+	switch row.Action {
+	case types.ActionUpsert:
+		//Prepare Upsert
+		if queryVal, txHash, err = db.DBAdapter.UpsertQuery(table, row); err != nil {
+			db.Log.InfoMsg("Error building upsert query", "err", err, "value", fmt.Sprintf("%v %v", table, row))
+			return err
+		}
+
+	case types.ActionDelete:
+		//Prepare Delete
+		if queryVal, err = db.DBAdapter.DeleteQuery(table, row); err != nil {
+			db.Log.InfoMsg("Error building delete query", "err", err, "value", fmt.Sprintf("%v %v", table, row))
+			return err
+		}
+	default:
+		//Invalid Action
+		db.Log.InfoMsg("invalid action", "value", row.Action)
+		return fmt.Errorf("invalid row action %s", row.Action)
+	}
+
+	sqlQuery := queryVal.Query
+
+	// Perform row action
+	db.Log.InfoMsg("msg", "action", row.Action, "query", sqlQuery, "value", queryVal.Values)
+	if _, err = tx.Exec(sqlQuery, queryVal.Pointers...); err != nil {
+		db.Log.InfoMsg(fmt.Sprintf("error performing %s on row", row.Action), "err", err, "value", queryVal.Values)
+		return err
+	}
+
+	// Marshal the rowData map
+	rowData, err := getJSON(row.RowData)
+	if err != nil {
+		db.Log.InfoMsg("error marshaling rowData", "err", err, "value", fmt.Sprintf("%v", row.RowData))
+		return err
+	}
+
+	// Marshal sql values
+	sqlValues, err := getJSONFromValues(queryVal.Pointers)
+	if err != nil {
+		db.Log.InfoMsg("error marshaling rowdata", "err", err, "value", fmt.Sprintf("%v", row.RowData))
+		return err
+	}
+
+	tableName := safe(table.Name)
+	eventName, _ := row.RowData[db.Columns.EventName].(string)
+	// Insert in log
+	db.Log.InfoMsg("INSERT LOG",
+		"log_query", db.DBAdapter.InsertLogQuery(),
+		"chain_id", chainID,
+		"table_name", tableName,
+		"event_name", eventName,
+		"event_filter", row.EventClass.GetFilter(),
+		"block_height", height,
+		"tx_hash", txHash,
+		"row_action", row.Action,
+		"row_data", string(rowData),
+		"sql_query", sqlQuery,
+		"sql_values", string(sqlValues),
+	)
+
+	if _, err = logStmt.Exec(chainID, tableName, eventName, row.EventClass.GetFilter(), height,
+		txHash, row.Action, rowData, sqlQuery, sqlValues); err != nil {
+		db.Log.InfoMsg("Error inserting into log", "err", err)
+		return err
+	}
+	return nil
+}
+
 // GetBlock returns all tables structures and row data for given block
 func (db *SQLDB) GetBlock(chainID string, height uint64) (types.EventData, error) {
 	var data types.EventData
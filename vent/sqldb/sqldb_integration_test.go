@@ -59,6 +59,59 @@ func testCleanDB(t *testing.T, cfg *config.VentConfig) {
 		})
 }
 
+func testMultiChain(t *testing.T, cfg *config.VentConfig) {
+	t.Run(fmt.Sprintf("%s: keeps the rows and heights of multiple chains", cfg.DBAdapter),
+		func(t *testing.T) {
+			const otherChainID = "CHAIN_456"
+
+			db, closeDB := test.NewTestDB(t, cfg)
+			defer closeDB()
+
+			db.MultiChain = true
+			err := db.Init(otherChainID, test.BurrowVersion)
+			require.NoError(t, err)
+
+			str, dat := getBlock()
+			err = db.SetBlock(test.ChainID, str, dat)
+			require.NoError(t, err)
+
+			height, err := db.LastBlockHeight(otherChainID)
+			require.NoError(t, err)
+			assert.Equal(t, uint64(0), height)
+
+			dat.BlockHeight++
+			err = db.SetBlock(otherChainID, str, dat)
+			require.NoError(t, err)
+
+			// Initialising an existing chain leaves the other in place
+			err = db.Init(test.ChainID, test.BurrowVersion)
+			require.NoError(t, err)
+
+			height, err = db.LastBlockHeight(test.ChainID)
+			require.NoError(t, err)
+			assert.Equal(t, dat.BlockHeight-1, height)
+
+			height, err = db.LastBlockHeight(otherChainID)
+			require.NoError(t, err)
+			assert.Equal(t, dat.BlockHeight, height)
+
+			// Each chain keeps its own spec hashes
+			require.NoError(t, db.SetSpecHashes(test.ChainID, "Things", []string{"a", "b"}))
+			require.NoError(t, db.SetSpecHashes(otherChainID, "Things", []string{"c"}))
+			require.NoError(t, db.SetSpecHashes(test.ChainID, "Things", []string{"d"}))
+			specHashes, err := db.SpecHashes()
+			require.NoError(t, err)
+			assert.Equal(t, map[string][]string{"Things": {"d"}}, specHashes[test.ChainID])
+			assert.Equal(t, map[string][]string{"Things": {"c"}}, specHashes[otherChainID])
+
+			require.NoError(t, db.SetSpecHashes(otherChainID, "Things", nil))
+			specHashes, err = db.SpecHashes()
+			require.NoError(t, err)
+			assert.Equal(t, map[string][]string{"Things": {"d"}}, specHashes[test.ChainID])
+			assert.NotContains(t, specHashes, otherChainID)
+		})
+}
+
 func testRestore(t *testing.T, cfg *config.VentConfig) {
 	t.Run(fmt.Sprintf("%s: can restore from vent logging", cfg.DBAdapter),
 		func(t *testing.T) {
@@ -23,3 +23,7 @@ func TestSqliteSetBlock(t *testing.T) {
 func TestSqliteRestore(t *testing.T) {
 	testRestore(t, test.SqliteVentConfig(""))
 }
+
+func TestSqliteMultiChain(t *testing.T) {
+	testMultiChain(t, test.SqliteVentConfig(""))
+}
@@ -5,7 +5,7 @@ import (
 	"github.com/hyperledger/burrow/vent/types"
 )
 
-// getSysTablesDefinition returns log, chain info & dictionary structures
+// getSysTablesDefinition returns log, chain info, spec & dictionary structures
 func (db *SQLDB) systemTablesDefinition() types.EventTables {
 	return types.EventTables{
 		tables.Log: {
@@ -98,6 +98,28 @@ func (db *SQLDB) systemTablesDefinition() types.EventTables {
 				},
 			},
 		},
+		tables.Spec: {
+			Name: tables.Spec,
+			Columns: []*types.SQLTableColumn{
+				{
+					Name:    columns.ChainID,
+					Type:    types.SQLColumnTypeVarchar,
+					Primary: true,
+				},
+				{
+					Name:    columns.TableName,
+					Type:    types.SQLColumnTypeVarchar,
+					Primary: true,
+				},
+				{
+					Name:    columns.SpecHash,
+					Type:    types.SQLColumnTypeVarchar,
+					Primary: true,
+					// Hex encoded SHA-256
+					Length: 64,
+				},
+			},
+		},
 		tables.ChainInfo: {
 			Name: tables.ChainInfo,
 			Columns: []*types.SQLTableColumn{
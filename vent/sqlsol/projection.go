@@ -94,6 +94,11 @@ func NewProjection(spec types.ProjectionSpec) (*Projection, error) {
 			return nil, fmt.Errorf("validation error on %v: %v", eventClass, err)
 		}
 
+		// aggregates are built from the tables they aggregate below
+		if eventClass.Aggregate != nil {
+			continue
+		}
+
 		// build columns mapping
 		var columns []*types.SQLTableColumn
 		channels := make(map[string][]string)
@@ -120,7 +125,7 @@ func NewProjection(spec types.ProjectionSpec) (*Projection, error) {
 
 		i := 0
 		for _, mapping := range eventClass.FieldMappings {
-			sqlType, sqlTypeLength, err := getSQLType(mapping.Type, mapping.BytesToString)
+			sqlType, sqlTypeLength, err := getColumnType(mapping)
 			if err != nil {
 				return nil, err
 			}
@@ -154,6 +159,20 @@ func NewProjection(spec types.ProjectionSpec) (*Projection, error) {
 
 	}
 
+	for _, eventClass := range spec {
+		if eventClass.Aggregate != nil {
+			if _, ok := tables[eventClass.TableName]; ok {
+				return nil, fmt.Errorf("aggregate table %s cannot be shared with another event class",
+					eventClass.TableName)
+			}
+			table, err := aggregateTable(eventClass, tables)
+			if err != nil {
+				return nil, err
+			}
+			tables[eventClass.TableName] = table
+		}
+	}
+
 	// check if there are duplicated duplicated column names (for a given table)
 	colName := make(map[string]int)
 
@@ -172,6 +191,25 @@ func NewProjection(spec types.ProjectionSpec) (*Projection, error) {
 	}, nil
 }
 
+// MergeProjections combines the tables and event classes of projections, tables of the same name are merged provided
+// their columns agree
+func MergeProjections(projections ...*Projection) (*Projection, error) {
+	merged := &Projection{
+		Tables: make(types.EventTables),
+	}
+	for _, projection := range projections {
+		for tableName, table := range projection.Tables {
+			var err error
+			merged.Tables[tableName], err = mergeTables(merged.Tables[tableName], table)
+			if err != nil {
+				return nil, err
+			}
+		}
+		merged.Spec = append(merged.Spec, projection.Spec...)
+	}
+	return merged, nil
+}
+
 // Get the column for a particular table and column name
 func (p *Projection) GetColumn(tableName, columnName string) (*types.SQLTableColumn, error) {
 	if table, ok := p.Tables[tableName]; ok {
@@ -186,6 +224,22 @@ func (p *Projection) GetColumn(tableName, columnName string) (*types.SQLTableCol
 	return nil, fmt.Errorf("GetColumn: table does not exist projection: %s ", tableName)
 }
 
+// SpecHashes returns the (sorted) hashes of the event classes projected into each table
+func (p *Projection) SpecHashes() (map[string][]string, error) {
+	specHashes := make(map[string][]string, len(p.Tables))
+	for _, eventClass := range p.Spec {
+		hash, err := eventClass.Hash()
+		if err != nil {
+			return nil, fmt.Errorf("could not hash event class for table %s: %v", eventClass.TableName, err)
+		}
+		specHashes[eventClass.TableName] = append(specHashes[eventClass.TableName], hash)
+	}
+	for _, hashes := range specHashes {
+		sort.Strings(hashes)
+	}
+	return specHashes, nil
+}
+
 func ValidateJSONSpec(bs []byte) error {
 	schemaLoader := gojsonschema.NewGoLoader(types.ProjectionSpecSchema())
 	specLoader := gojsonschema.NewBytesLoader(bs)
@@ -220,6 +274,59 @@ func readFile(file string) ([]byte, error) {
 	return byteValue, nil
 }
 
+// getColumnType gives the SQL column type for an event field mapping, which is that of the event field type unless the
+// field is transformed or scaled
+func getColumnType(mapping *types.EventFieldMapping) (types.SQLColumnType, int, error) {
+	sqlType, sqlTypeLength, err := getSQLType(mapping.Type, mapping.BytesToString)
+	if err != nil {
+		return sqlType, sqlTypeLength, err
+	}
+
+	evmSignature := strings.ToLower(mapping.Type)
+	isInteger := strings.HasPrefix(evmSignature, types.EventFieldTypeInt) ||
+		strings.HasPrefix(evmSignature, types.EventFieldTypeUInt)
+
+	if mapping.Decimals > 0 {
+		if !isInteger {
+			return -1, 0, fmt.Errorf("cannot scale non-integer field %s of type %s by Decimals", mapping.Field,
+				mapping.Type)
+		}
+		// Stored as a decimal string since not every database can hold an arbitrary precision fixed-point number
+		return types.SQLColumnTypeText, 0, nil
+	}
+
+	switch mapping.Transform {
+	case "":
+		return sqlType, sqlTypeLength, nil
+	case types.TransformHex, types.TransformBase64:
+		if sqlType != types.SQLColumnTypeByteA {
+			return -1, 0, fmt.Errorf("can only encode bytes with '%s' Transform but field %s has type %s",
+				mapping.Transform, mapping.Field, mapping.Type)
+		}
+		return types.SQLColumnTypeText, 0, nil
+	case types.TransformChecksum:
+		if evmSignature != types.EventFieldTypeAddress {
+			return -1, 0, fmt.Errorf("can only checksum an address but field %s has type %s", mapping.Field,
+				mapping.Type)
+		}
+		return sqlType, sqlTypeLength, nil
+	case types.TransformTimestamp:
+		if !isInteger {
+			return -1, 0, fmt.Errorf("can only convert integers to a timestamp but field %s has type %s",
+				mapping.Field, mapping.Type)
+		}
+		return types.SQLColumnTypeTimeStamp, 0, nil
+	case types.TransformJSON:
+		if evmSignature != types.EventFieldTypeString && !mapping.BytesToString {
+			return -1, 0, fmt.Errorf("can only extract JSON from a string but field %s has type %s", mapping.Field,
+				mapping.Type)
+		}
+		return types.SQLColumnTypeText, 0, nil
+	default:
+		return -1, 0, fmt.Errorf("unknown Transform '%s' for field %s", mapping.Transform, mapping.Field)
+	}
+}
+
 // getSQLType maps event input types with corresponding SQL column types
 // takes into account related solidity types info and element indexed or hashed
 func getSQLType(evmSignature string, bytesToString bool) (types.SQLColumnType, int, error) {
@@ -362,6 +469,87 @@ func getGlobalFieldMappingsLogMode() []*types.EventFieldMapping {
 	}
 }
 
+// aggregateTable builds the table of an aggregate, keyed by its group columns which take their types from the source
+// table along with the aggregate columns and the height at which the row last changed
+func aggregateTable(eventClass *types.EventClass, tables types.EventTables) (*types.SQLTable, error) {
+	agg := eventClass.Aggregate
+	source, ok := tables[agg.SourceTable]
+	if !ok || source.Aggregate != nil {
+		return nil, fmt.Errorf("source table %s of aggregate table %s is not the table of an event class",
+			agg.SourceTable, eventClass.TableName)
+	}
+
+	sourceColumn := func(columnName string) (*types.SQLTableColumn, error) {
+		column := source.GetColumn(columnName)
+		if column == nil {
+			return nil, fmt.Errorf("aggregate table %s refers to column %s that is not in source table %s",
+				eventClass.TableName, columnName, agg.SourceTable)
+		}
+		return column, nil
+	}
+
+	table := &types.SQLTable{
+		Name:      eventClass.TableName,
+		Aggregate: agg,
+	}
+
+	for _, columnName := range agg.GroupBy {
+		column, err := sourceColumn(columnName)
+		if err != nil {
+			return nil, err
+		}
+		table.Columns = append(table.Columns, &types.SQLTableColumn{
+			Name:    column.Name,
+			Type:    column.Type,
+			Primary: true,
+			Length:  column.Length,
+		})
+	}
+
+	for _, aggColumn := range agg.Columns {
+		column := &types.SQLTableColumn{
+			Name: aggColumn.ColumnName,
+			Type: types.SQLColumnTypeBigInt,
+		}
+		if aggColumn.Function != types.AggregateCount {
+			from, err := sourceColumn(aggColumn.SourceColumn)
+			if err != nil {
+				return nil, err
+			}
+			column.Type = from.Type
+			column.Length = from.Length
+			if aggColumn.Function == types.AggregateSum {
+				if !from.Type.IsNumeric() {
+					return nil, fmt.Errorf("cannot sum column %s of type %v for aggregate table %s",
+						from.Name, from.Type, eventClass.TableName)
+				}
+				// Sums can outgrow the type of the column being summed
+				column.Type = types.SQLColumnTypeNumeric
+				column.Length = 0
+			}
+		}
+		table.Columns = append(table.Columns, column)
+	}
+
+	height, err := sourceColumn(columns.Height)
+	if err != nil {
+		return nil, err
+	}
+	for _, column := range table.Columns {
+		if column.Name == columns.Height {
+			return nil, fmt.Errorf("column %s of aggregate table %s is reserved for the height at which a row "+
+				"last changed", columns.Height, eventClass.TableName)
+		}
+	}
+	table.Columns = append(table.Columns, &types.SQLTableColumn{
+		Name:   height.Name,
+		Type:   height.Type,
+		Length: height.Length,
+	})
+
+	return table, nil
+}
+
 // Merges tables a and b provided the intersection of their columns (by name) are identical
 func mergeTables(tables ...*types.SQLTable) (*types.SQLTable, error) {
 	table := &types.SQLTable{
@@ -374,6 +562,9 @@ func mergeTables(tables ...*types.SQLTable) (*types.SQLTable, error) {
 	for _, t := range tables {
 		if t != nil {
 			table.Name = t.Name
+			if t.Aggregate != nil {
+				table.Aggregate = t.Aggregate
+			}
 			for _, columnB := range t.Columns {
 				if columnA, ok := columns[columnB.Name]; ok {
 					if !columnA.Equals(columnB) {
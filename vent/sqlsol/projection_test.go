@@ -136,6 +136,163 @@ func TestNewProjectionFromBytes(t *testing.T) {
 	})
 }
 
+func TestSpecHashes(t *testing.T) {
+	projection, err := sqlsol.NewProjectionFromBytes([]byte(test.GoodJSONConfFile(t)))
+	require.NoError(t, err)
+
+	specHashes, err := projection.SpecHashes()
+	require.NoError(t, err)
+	require.Len(t, specHashes, 2)
+	require.Len(t, specHashes["UserAccounts"], 1)
+	require.Len(t, specHashes["TEST_TABLE"], 1)
+
+	t.Run("hashes are stable", func(t *testing.T) {
+		sameProjection, err := sqlsol.NewProjectionFromBytes([]byte(test.GoodJSONConfFile(t)))
+		require.NoError(t, err)
+		sameSpecHashes, err := sameProjection.SpecHashes()
+		require.NoError(t, err)
+		require.Equal(t, specHashes, sameSpecHashes)
+	})
+
+	t.Run("hash changes with event class", func(t *testing.T) {
+		projection.Spec[0].Filter = "LOG0 = 'OtherAccounts'"
+		changedSpecHashes, err := projection.SpecHashes()
+		require.NoError(t, err)
+		require.NotEqual(t, specHashes["UserAccounts"], changedSpecHashes["UserAccounts"])
+		require.Equal(t, specHashes["TEST_TABLE"], changedSpecHashes["TEST_TABLE"])
+	})
+}
+
+func TestMergeProjections(t *testing.T) {
+	projection, err := sqlsol.NewProjectionFromBytes([]byte(test.GoodJSONConfFile(t)))
+	require.NoError(t, err)
+	otherProjection, err := sqlsol.NewProjectionFromBytes([]byte(test.GoodJSONConfFile(t)))
+	require.NoError(t, err)
+
+	merged, err := sqlsol.MergeProjections(projection, otherProjection)
+	require.NoError(t, err)
+	require.Len(t, merged.Tables, 2)
+	require.Len(t, merged.Spec, 4)
+	require.Equal(t, projection.Tables["UserAccounts"].Columns, merged.Tables["UserAccounts"].Columns)
+
+	otherProjection.Tables["UserAccounts"].Columns[0].Primary = !projection.Tables["UserAccounts"].Columns[0].Primary
+	_, err = sqlsol.MergeProjections(projection, otherProjection)
+	require.Error(t, err)
+}
+
+func TestTransforms(t *testing.T) {
+	spec := `[{
+		"TableName": "Transfers",
+		"Filter": "EventName = 'Transfer'",
+		"FieldMappings": [
+			{"Field": "from", "ColumnName": "sender", "Type": "address", "Primary": true, "Transform": "checksum"},
+			{"Field": "amount", "ColumnName": "amount", "Type": "uint256", "Decimals": 18},
+			{"Field": "memo", "ColumnName": "memo", "Type": "bytes", "Transform": "base64"},
+			{"Field": "time", "ColumnName": "time", "Type": "uint64", "Transform": "timestamp"},
+			{"Field": "meta", "ColumnName": "reference", "Type": "string", "Transform": "json", "JSONPath": "ref.id"},
+			{"ColumnName": "network", "Type": "string", "Constant": "main"}
+		]
+	}]`
+
+	projection, err := sqlsol.NewProjectionFromBytes([]byte(spec))
+	require.NoError(t, err)
+
+	for column, sqlType := range map[string]types.SQLColumnType{
+		"sender":    types.SQLColumnTypeVarchar,
+		"amount":    types.SQLColumnTypeText,
+		"memo":      types.SQLColumnTypeText,
+		"time":      types.SQLColumnTypeTimeStamp,
+		"reference": types.SQLColumnTypeText,
+		"network":   types.SQLColumnTypeText,
+	} {
+		col, err := projection.GetColumn("Transfers", column)
+		require.NoError(t, err)
+		require.Equal(t, sqlType, col.Type, column)
+	}
+
+	for _, mapping := range []string{
+		// unknown transform
+		`{"Field": "from", "ColumnName": "sender", "Type": "address", "Transform": "rot13"}`,
+		// transform of the wrong type
+		`{"Field": "from", "ColumnName": "sender", "Type": "string", "Transform": "checksum"}`,
+		`{"Field": "amount", "ColumnName": "amount", "Type": "bytes32", "Decimals": 18}`,
+		// JSONPath without json transform
+		`{"Field": "meta", "ColumnName": "reference", "Type": "string", "JSONPath": "ref.id"}`,
+		// no field or constant
+		`{"ColumnName": "network", "Type": "string"}`,
+	} {
+		_, err = sqlsol.NewProjectionFromBytes([]byte(`[{"TableName": "Transfers", "Filter": "EventName = 'Transfer'",` +
+			`"FieldMappings": [` + mapping + `]}]`))
+		require.Error(t, err, mapping)
+	}
+}
+
+func TestAggregates(t *testing.T) {
+	transfers := `{
+		"TableName": "Transfers",
+		"Filter": "EventName = 'Transfer'",
+		"DeleteMarkerField": "__DELETE__",
+		"FieldMappings": [
+			{"Field": "id", "ColumnName": "id", "Type": "uint64", "Primary": true},
+			{"Field": "to", "ColumnName": "recipient", "Type": "address"},
+			{"Field": "amount", "ColumnName": "amount", "Type": "uint256"},
+			{"Field": "memo", "ColumnName": "memo", "Type": "string"}
+		]
+	}`
+	balances := func(columns string) string {
+		return `[` + transfers + `, {
+			"TableName": "Balances",
+			"Aggregate": {
+				"SourceTable": "Transfers",
+				"GroupBy": ["recipient"],
+				"Columns": [` + columns + `]
+			}
+		}]`
+	}
+
+	projection, err := sqlsol.NewProjectionFromBytes([]byte(balances(`
+		{"ColumnName": "balance", "Function": "sum", "SourceColumn": "amount"},
+		{"ColumnName": "transfers", "Function": "count"},
+		{"ColumnName": "largest", "Function": "max", "SourceColumn": "amount"},
+		{"ColumnName": "memo", "Function": "latest", "SourceColumn": "memo"}`)))
+	require.NoError(t, err)
+
+	table := projection.Tables["Balances"]
+	require.NotNil(t, table.Aggregate)
+	var columnNames []string
+	for _, column := range table.Columns {
+		columnNames = append(columnNames, column.Name)
+	}
+	require.Equal(t, []string{"recipient", "balance", "transfers", "largest", "memo", columns.Height}, columnNames)
+	require.True(t, table.GetColumn("recipient").Primary)
+	require.Equal(t, types.SQLColumnTypeVarchar, table.GetColumn("recipient").Type)
+	require.Equal(t, types.SQLColumnTypeNumeric, table.GetColumn("balance").Type)
+	require.Equal(t, types.SQLColumnTypeBigInt, table.GetColumn("transfers").Type)
+	require.Equal(t, types.SQLColumnTypeBigInt, table.GetColumn("largest").Type)
+	require.Equal(t, types.SQLColumnTypeText, table.GetColumn("memo").Type)
+
+	for _, columns := range []string{
+		// unknown function
+		`{"ColumnName": "balance", "Function": "avg", "SourceColumn": "amount"}`,
+		// sum of a non-numeric column
+		`{"ColumnName": "memos", "Function": "sum", "SourceColumn": "memo"}`,
+		// missing or unexpected source column
+		`{"ColumnName": "largest", "Function": "max"}`,
+		`{"ColumnName": "transfers", "Function": "count", "SourceColumn": "amount"}`,
+		`{"ColumnName": "largest", "Function": "max", "SourceColumn": "nonexistent"}`,
+		// reserved column
+		`{"ColumnName": "_height", "Function": "max", "SourceColumn": "amount"}`,
+	} {
+		_, err = sqlsol.NewProjectionFromBytes([]byte(balances(columns)))
+		require.Error(t, err, columns)
+	}
+
+	// an aggregate of an unknown table
+	_, err = sqlsol.NewProjectionFromBytes([]byte(`[{"TableName": "Balances", "Aggregate": {"SourceTable": "Transfers",
+		"GroupBy": ["recipient"], "Columns": [{"ColumnName": "transfers", "Function": "count"}]}}]`))
+	require.Error(t, err)
+}
+
 func TestProjectionSpec(t *testing.T) {
 	tableName := "BurnNotices"
 	spec := types.ProjectionSpec{
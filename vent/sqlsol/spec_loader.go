@@ -12,6 +12,8 @@ type SpecOpt uint64
 const (
 	Block SpecOpt = 1 << iota
 	Tx
+	// ChainID includes the chain ID in the primary key of each table so that rows from several chains can share it
+	ChainID
 )
 
 const (
@@ -37,6 +39,10 @@ func SpecLoader(specFileOrDirs []string, opts SpecOpt) (*Projection, error) {
 		return nil, fmt.Errorf("error parsing spec: %v", err)
 	}
 
+	if opts.Enabled(ChainID) {
+		chainIDPrimary(projection)
+	}
+
 	// add block & tx to tables definition
 	if opts.Enabled(Block) {
 		for k, v := range blockTables() {
@@ -52,6 +58,23 @@ func SpecLoader(specFileOrDirs []string, opts SpecOpt) (*Projection, error) {
 	return projection, nil
 }
 
+// chainIDPrimary adds the chain ID column to the primary key of each table that has one (the tables of event classes
+// without a primary key are keyed by the position of the event including its chain ID)
+func chainIDPrimary(projection *Projection) {
+	for _, eventClass := range projection.Spec {
+		for _, mapping := range eventClass.FieldMappings {
+			if mapping.ColumnName == columns.ChainID {
+				mapping.Primary = true
+			}
+		}
+	}
+	for _, table := range projection.Tables {
+		if column := table.GetColumn(columns.ChainID); column != nil {
+			column.Primary = true
+		}
+	}
+}
+
 // getBlockTxTablesDefinition returns block & transaction structures
 func blockTables() types.EventTables {
 	return types.EventTables{
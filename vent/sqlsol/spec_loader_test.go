@@ -29,4 +29,16 @@ func TestSpecLoader(t *testing.T) {
 		require.Equal(t, columns.TxHash,
 			projection.Tables[tables.Tx].GetColumn(columns.TxHash).Name)
 	})
+
+	t.Run("successfully add chain ID to primary keys", func(t *testing.T) {
+		projection, err := sqlsol.SpecLoader(specFile, sqlsol.ChainID)
+		require.NoError(t, err)
+
+		for _, table := range projection.Tables {
+			require.True(t, table.GetColumn(columns.ChainID).Primary, table.Name)
+		}
+		for _, eventClass := range projection.Spec {
+			require.True(t, eventClass.GetFieldMapping(types.ChainIDLabel).Primary, eventClass.TableName)
+		}
+	})
 }
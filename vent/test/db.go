@@ -59,7 +59,7 @@ func NewTestDB(t *testing.T, cfg *config.VentConfig) (*sqldb.SQLDB, func()) {
 			os.Remove(connection.DBURL + "-shm")
 			os.Remove(connection.DBURL + "-wal")
 		} else {
-			destroySchema(db, connection.DBSchema)
+			destroySchema(db, cfg.DBAdapter, connection.DBSchema)
 			db.Close()
 		}
 	}
@@ -92,9 +92,23 @@ func PostgresVentConfig(grpcAddress string) *config.VentConfig {
 	return cfg
 }
 
-func destroySchema(db *sqldb.SQLDB, dbSchema string) error {
+func MySQLVentConfig(grpcAddress string) *config.VentConfig {
+	cfg := config.DefaultVentConfig()
+	cfg.DBSchema = fmt.Sprintf("test_%s", randString(10))
+	cfg.DBAdapter = types.MySQLDB
+	cfg.DBURL = config.DefaultMySQLDBURL
+	cfg.GRPCAddr = grpcAddress
+	cfg.AnnounceEvery = time.Millisecond * 100
+	return cfg
+}
+
+func destroySchema(db *sqldb.SQLDB, dbAdapter, dbSchema string) error {
 	db.Log.InfoMsg("Dropping schema")
 	query := fmt.Sprintf("DROP SCHEMA %s CASCADE;", dbSchema)
+	if dbAdapter == types.MySQLDB {
+		// A MySQL schema is a database, which always drops its tables
+		query = fmt.Sprintf("DROP SCHEMA %s;", dbSchema)
+	}
 
 	db.Log.InfoMsg("Drop schema", "query", query)
 
@@ -1,6 +1,12 @@
 package types
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
 	"github.com/alecthomas/jsonschema"
 	validation "github.com/go-ozzo/ozzo-validation"
 	"github.com/hyperledger/burrow/event/query"
@@ -17,20 +23,38 @@ func ProjectionSpecSchema() *jsonschema.Schema {
 type EventClass struct {
 	// Destination table in DB
 	TableName string
-	// Burrow event filter query in query peg grammar
-	Filter string
+	// Burrow event filter query in query peg grammar (not used by an Aggregate)
+	Filter string `json:",omitempty"`
 	// The name of a solidity event field that when present indicates that the rest of the event should be interpreted
 	// as requesting a row deletion (rather than upsert) in the projection table.
 	DeleteMarkerField string `json:",omitempty"`
-	// EventFieldMapping from solidity event field name to EventFieldMapping descriptor
-	FieldMappings []*EventFieldMapping
+	// EventFieldMapping from solidity event field name to EventFieldMapping descriptor (not used by an Aggregate)
+	FieldMappings []*EventFieldMapping `json:",omitempty"`
+	// Aggregate of the rows of another table to keep in the destination table in place of projecting events
+	Aggregate *Aggregate `json:",omitempty"`
 	// Memoised lookup/query
 	query  query.Query
 	fields map[string]*EventFieldMapping
 }
 
+var notUsedByAggregate = validation.By(func(value interface{}) error {
+	if !validation.IsEmpty(value) {
+		return errors.New("cannot be given with an Aggregate")
+	}
+	return nil
+})
+
 // Validate checks the structure of an EventClass
 func (ec *EventClass) Validate() error {
+	if ec.Aggregate != nil {
+		return validation.ValidateStruct(ec,
+			validation.Field(&ec.TableName, validation.Required, validation.Length(1, 60)),
+			validation.Field(&ec.Filter, notUsedByAggregate),
+			validation.Field(&ec.DeleteMarkerField, notUsedByAggregate),
+			validation.Field(&ec.FieldMappings, notUsedByAggregate),
+			validation.Field(&ec.Aggregate),
+		)
+	}
 	return validation.ValidateStruct(ec,
 		validation.Field(&ec.TableName, validation.Required, validation.Length(1, 60)),
 		validation.Field(&ec.Filter, validation.Required),
@@ -50,6 +74,16 @@ func (ec *EventClass) Query() (query.Query, error) {
 	return ec.query, nil
 }
 
+// Hash returns a hex encoded hash of the definition of the EventClass that changes whenever its definition does
+func (ec *EventClass) Hash() (string, error) {
+	bs, err := json.Marshal(ec)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(bs)
+	return hex.EncodeToString(hash[:]), nil
+}
+
 func (ec *EventClass) GetFieldMapping(fieldName string) *EventFieldMapping {
 	if ec.fields == nil {
 		ec.fields = make(map[string]*EventFieldMapping, len(ec.FieldMappings))
@@ -69,8 +103,8 @@ func (ec *EventClass) GetFilter() string {
 
 // EventFieldMapping struct (table column definition)
 type EventFieldMapping struct {
-	// EVM event field name to process
-	Field string
+	// EVM event field name to process (not needed for a Constant)
+	Field string `json:",omitempty"`
 	// EVM type of this field - used to derive SQL type
 	Type string
 	// Destination SQL column name to which to map this event field
@@ -82,11 +116,105 @@ type EventFieldMapping struct {
 	// Notification channels on which submit (via a trigger) a payload that contains this column's new value (upsert) or
 	// old value (delete). The payload will contain all other values with the same channel set as a JSON object.
 	Notify []string `json:",omitempty"`
+	// Transform to apply to the value of this event field before it is stored, one of: 'hex' or 'base64' to encode
+	// bytes, 'checksum' to give an address in mixed-case checksum form, 'timestamp' to convert an integer number of
+	// seconds since the unix epoch to a timestamp, or 'json' to extract the value at JSONPath from a string of JSON
+	Transform string `json:",omitempty" jsonschema:"pattern=^(hex|base64|checksum|timestamp|json)$"`
+	// Number of decimal places by which to scale an integer event field, which is stored as a fixed-point decimal string
+	Decimals uint `json:",omitempty" jsonschema:"maximum=77"`
+	// Path to the value to extract for the 'json' Transform as dot-separated object keys and array indices
+	JSONPath string `json:",omitempty"`
+	// Constant value to store in this column regardless of the event
+	Constant string `json:",omitempty"`
+}
+
+// Aggregate describes a table with a row for each group of the rows of SourceTable that is kept up to date as the rows of
+// SourceTable change
+type Aggregate struct {
+	// Table (of another EventClass) whose rows are aggregated
+	SourceTable string
+	// Columns of SourceTable by which to group its rows, these form the primary key of the aggregate table
+	GroupBy []string
+	// Columns holding the aggregates of each group
+	Columns []*AggregateColumn
+}
+
+// Validate checks the structure of an Aggregate
+func (agg Aggregate) Validate() error {
+	return validation.ValidateStruct(&agg,
+		validation.Field(&agg.SourceTable, validation.Required, validation.Length(1, 60)),
+		validation.Field(&agg.GroupBy, validation.Required, validation.Length(1, 0)),
+		validation.Field(&agg.Columns, validation.Required, validation.Length(1, 0)),
+	)
+}
+
+// AggregateColumn is a column of an aggregate table
+type AggregateColumn struct {
+	// Destination SQL column name
+	ColumnName string
+	// Aggregate function, one of: 'sum', 'count', 'min', 'max', or 'latest' to take the value of the last row projected
+	// in the group
+	Function string `jsonschema:"pattern=^(sum|count|min|max|latest)$"`
+	// Column of the source table to aggregate (not needed for 'count')
+	SourceColumn string `json:",omitempty"`
 }
 
+// Aggregate functions
+const (
+	AggregateSum    = "sum"
+	AggregateCount  = "count"
+	AggregateMin    = "min"
+	AggregateMax    = "max"
+	AggregateLatest = "latest"
+)
+
+// Validate checks the structure of an AggregateColumn
+func (col AggregateColumn) Validate() error {
+	return validation.ValidateStruct(&col,
+		validation.Field(&col.ColumnName, validation.Required, validation.Length(1, 60)),
+		validation.Field(&col.Function, validation.Required, validation.In(AggregateSum, AggregateCount,
+			AggregateMin, AggregateMax, AggregateLatest)),
+		validation.Field(&col.SourceColumn, validation.By(func(interface{}) error {
+			if (col.SourceColumn == "") != (col.Function == AggregateCount) {
+				return fmt.Errorf("must be given unless, and only unless, the Function is '%s'", AggregateCount)
+			}
+			return nil
+		})),
+	)
+}
+
+// Field transforms
+const (
+	TransformHex       = "hex"
+	TransformBase64    = "base64"
+	TransformChecksum  = "checksum"
+	TransformTimestamp = "timestamp"
+	TransformJSON      = "json"
+)
+
 // Validate checks the structure of an EventFieldMapping
 func (evColumn EventFieldMapping) Validate() error {
 	return validation.ValidateStruct(&evColumn,
 		validation.Field(&evColumn.ColumnName, validation.Required, validation.Length(1, 60)),
+		validation.Field(&evColumn.Field, validation.By(func(interface{}) error {
+			if evColumn.Field == "" && evColumn.Constant == "" {
+				return errors.New("must be given unless there is a Constant")
+			}
+			return nil
+		})),
+		validation.Field(&evColumn.Transform, validation.In(TransformHex, TransformBase64, TransformChecksum,
+			TransformTimestamp, TransformJSON)),
+		validation.Field(&evColumn.JSONPath, validation.By(func(interface{}) error {
+			if (evColumn.JSONPath != "") != (evColumn.Transform == TransformJSON) {
+				return fmt.Errorf("must be given with, and only with, the '%s' Transform", TransformJSON)
+			}
+			return nil
+		})),
+		validation.Field(&evColumn.Decimals, validation.By(func(interface{}) error {
+			if evColumn.Decimals > 0 && evColumn.Transform != "" {
+				return errors.New("cannot be combined with a Transform")
+			}
+			return nil
+		})),
 	)
 }
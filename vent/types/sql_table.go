@@ -10,7 +10,9 @@ type SQLTable struct {
 	Columns []*SQLTableColumn
 	// Map of channel name -> columns to be sent as payload on that channel
 	NotifyChannels map[string][]string
-	columns        map[string]*SQLTableColumn
+	// Aggregate kept in this table, if it is an aggregate table
+	Aggregate *Aggregate
+	columns   map[string]*SQLTableColumn
 }
 
 func (table *SQLTable) GetColumn(columnName string) *SQLTableColumn {
@@ -75,6 +77,7 @@ type SQLTableNames struct {
 	Block      string
 	Tx         string
 	ChainInfo  string
+	Spec       string
 }
 
 var DefaultSQLTableNames = SQLTableNames{
@@ -83,6 +86,7 @@ var DefaultSQLTableNames = SQLTableNames{
 	Block:      "_vent_block",
 	Tx:         "_vent_tx",
 	ChainInfo:  "_vent_chain",
+	Spec:       "_vent_spec",
 }
 
 type SQLColumnNames struct {
@@ -119,6 +123,8 @@ type SQLColumnNames struct {
 	Receipt     string
 	Origin      string
 	Exception   string
+	// spec
+	SpecHash string
 }
 
 var DefaultSQLColumnNames = SQLColumnNames{
@@ -155,6 +161,8 @@ var DefaultSQLColumnNames = SQLColumnNames{
 	Receipt:     "_receipt",
 	Origin:      "_origin",
 	Exception:   "_exception",
+	// spec
+	SpecHash: "_spechash",
 }
 
 // labels for column mapping
@@ -168,7 +176,37 @@ const (
 	ChainIDLabel     = "chainID"
 	BlockHeightLabel = "height"
 	TxIndexLabel     = "txIndex"
+	BlockTimeLabel   = "blockTime"
 
 	// transaction related
 	TxTxHashLabel = "txHash"
+	TxCallerLabel = "txCaller"
+
+	// call event related
+	CallTypeLabel   = "callType"
+	OriginLabel     = "origin"
+	CallerLabel     = "caller"
+	CalleeLabel     = "callee"
+	DataLabel       = "data"
+	ValueLabel      = "value"
+	GasLabel        = "gas"
+	StackDepthLabel = "stackDepth"
+	ReturnLabel     = "return"
+
+	// input, output, and govern account event related
+	AddressLabel     = "address"
+	NameLabel        = "name"
+	PublicKeyLabel   = "publicKey"
+	BalancesLabel    = "balances"
+	PermissionsLabel = "permissions"
+	RolesLabel       = "roles"
+	CodeLabel        = "code"
+
+	// name entry related
+	OwnerLabel   = "owner"
+	ExpiresLabel = "expires"
 )
+
+// NameEntryEventType is the EventType given to the name registry entry set by a NameTx, which is matched and
+// projected as if it were an execution event
+const NameEntryEventType = "NameEntryEvent"
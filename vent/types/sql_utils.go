@@ -7,7 +7,9 @@ type SQLConnection struct {
 	DBAdapter string
 	DBURL     string
 	DBSchema  string
-	Log       *logging.Logger
+	// Keep the rows of every chain consumed rather than dropping tables when the chain ID changes
+	MultiChain bool
+	Log        *logging.Logger
 }
 
 // SQLCleanDBQuery stores queries needed to clean the database
@@ -0,0 +1,9 @@
+package types
+
+// supported sinks
+const (
+	SQLSink     = "sql"
+	JSONLSink   = "jsonl"
+	WebhookSink = "webhook"
+	GRPCSink    = "grpc"
+)